@@ -0,0 +1,266 @@
+package eventsv1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	ErrUnknownTopic       = errors.New("unknown event topic")
+	ErrUnsupportedVersion = errors.New("unsupported event version")
+	ErrMissingData        = errors.New("event has no data")
+	ErrTopicMismatch      = errors.New("event type does not match its topic")
+	ErrFieldAlias         = errors.New("event field uses its JSON name instead of its proto name")
+)
+
+// Envelope is the wire format shared by every producer. Data holds the
+// topic's contract message in its JSON form.
+type Envelope struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Version    string          `json:"version"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Producer   string          `json:"producer"`
+	TraceID    string          `json:"trace_id,omitempty"`
+	RequestID  string          `json:"request_id,omitempty"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// legacyEnvelope carries the fields older producers used: location sent its
+// body under payload, named itself in source and stamped time as a string.
+type legacyEnvelope struct {
+	Envelope
+	Payload json.RawMessage `json:"payload"`
+	Source  string          `json:"source"`
+	Time    string          `json:"time"`
+}
+
+// NewEnvelope wraps msg for topic, stamping the contract version.
+func NewEnvelope(topic string, producer string, traceID string, requestID string, msg proto.Message, occurredAt time.Time, id string) (Envelope, error) {
+	contract, ok := Lookup(topic)
+	if !ok {
+		return Envelope{}, fmt.Errorf("%w: %s", ErrUnknownTopic, topic)
+	}
+	if msg.ProtoReflect().Descriptor() != contract.New().ProtoReflect().Descriptor() {
+		return Envelope{}, fmt.Errorf("%w: %s", ErrTopicMismatch, topic)
+	}
+	data, err := MarshalData(msg)
+	if err != nil {
+		return Envelope{}, err
+	}
+	return Envelope{
+		ID:         id,
+		Type:       topic,
+		Version:    contract.Version,
+		OccurredAt: occurredAt.UTC(),
+		Producer:   producer,
+		TraceID:    traceID,
+		RequestID:  requestID,
+		Data:       data,
+	}, nil
+}
+
+// Marshal encodes topic's msg as a complete envelope.
+func Marshal(topic string, producer string, traceID string, requestID string, msg proto.Message, occurredAt time.Time, id string) ([]byte, error) {
+	envelope, err := NewEnvelope(topic, producer, traceID, requestID, msg, occurredAt, id)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(envelope)
+}
+
+// ParseEnvelope reads an envelope in the current shape or the legacy
+// payload/source/time shape.
+func ParseEnvelope(b []byte) (Envelope, error) {
+	var raw legacyEnvelope
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return Envelope{}, err
+	}
+	envelope := raw.Envelope
+	if isEmpty(envelope.Data) {
+		envelope.Data = raw.Payload
+	}
+	if isEmpty(envelope.Data) {
+		return Envelope{}, ErrMissingData
+	}
+	if envelope.Producer == "" {
+		envelope.Producer = raw.Source
+	}
+	if envelope.OccurredAt.IsZero() && raw.Time != "" {
+		if at, err := time.Parse(time.RFC3339Nano, raw.Time); err == nil {
+			envelope.OccurredAt = at
+		}
+	}
+	if envelope.Version == "" {
+		envelope.Version = "v1"
+	}
+	return envelope, nil
+}
+
+// Decode fills msg from the envelope's data. Fields msg does not know are
+// ignored, so consumers keep working while producers add fields.
+func (e Envelope) Decode(msg proto.Message) error {
+	return decodeData(e, msg, false)
+}
+
+// Unmarshal parses an envelope and decodes its data into msg.
+func Unmarshal(b []byte, msg proto.Message) (Envelope, error) {
+	envelope, err := ParseEnvelope(b)
+	if err != nil {
+		return Envelope{}, err
+	}
+	return envelope, envelope.Decode(msg)
+}
+
+// Validate checks b against its topic's contract strictly: the topic must be
+// known, the version must match and the data must decode without unknown or
+// mistyped fields, and every field must be written under its proto name even
+// though protojson would also accept its camelCase JSON name. Producer
+// contract tests run every event through it.
+func Validate(b []byte) (proto.Message, error) {
+	envelope, err := ParseEnvelope(b)
+	if err != nil {
+		return nil, err
+	}
+	contract, ok := Lookup(envelope.Type)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTopic, envelope.Type)
+	}
+	msg := contract.New()
+	if err := decodeData(envelope, msg, true); err != nil {
+		return nil, fmt.Errorf("%s: %w", envelope.Type, err)
+	}
+	return msg, nil
+}
+
+func decodeData(e Envelope, msg proto.Message, strict bool) error {
+	if contract, ok := Lookup(e.Type); ok && e.Version != "" && e.Version != contract.Version {
+		return fmt.Errorf("%w: %s %s", ErrUnsupportedVersion, e.Type, e.Version)
+	}
+	if isEmpty(e.Data) {
+		return ErrMissingData
+	}
+	if strict {
+		if err := checkFieldNames(e.Data, msg.ProtoReflect().Descriptor()); err != nil {
+			return err
+		}
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: !strict}.Unmarshal(e.Data, msg)
+}
+
+// checkFieldNames rejects keys in data that name a field of md only by its
+// JSON name, walking into nested messages. Anything that is not an object, or
+// keys that match no field at all, are left for protojson to report.
+func checkFieldNames(data json.RawMessage, md protoreflect.MessageDescriptor) error {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil
+	}
+	fields := md.Fields()
+	for key, value := range object {
+		fd := fields.ByName(protoreflect.Name(key))
+		if fd == nil {
+			if alias := fields.ByJSONName(key); alias != nil {
+				return fmt.Errorf("%w: %s written as %q", ErrFieldAlias, alias.FullName(), key)
+			}
+			continue
+		}
+		if fd.Message() == nil || fd.IsMap() {
+			continue
+		}
+		if !fd.IsList() {
+			if err := checkFieldNames(value, fd.Message()); err != nil {
+				return err
+			}
+			continue
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(value, &items); err != nil {
+			continue
+		}
+		for _, item := range items {
+			if err := checkFieldNames(item, fd.Message()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// MarshalData encodes msg in the JSON compatibility form consumers already
+// read: proto field names, zero values written out, optional fields only
+// when set, and 64-bit integers as JSON numbers rather than the strings
+// protojson would write.
+func MarshalData(msg proto.Message) (json.RawMessage, error) {
+	value, err := jsonValue(msg.ProtoReflect())
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
+
+func jsonValue(m protoreflect.Message) (map[string]any, error) {
+	out := map[string]any{}
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.HasPresence() && !m.Has(fd) {
+			continue
+		}
+		value, err := fieldValue(fd, m.Get(fd))
+		if err != nil {
+			return nil, err
+		}
+		out[string(fd.Name())] = value
+	}
+	return out, nil
+}
+
+func fieldValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) (any, error) {
+	switch {
+	case fd.IsMap():
+		return nil, fmt.Errorf("map field %s is not supported in events", fd.FullName())
+	case fd.IsList():
+		list := v.List()
+		out := make([]any, 0, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			item, err := scalarValue(fd, list.Get(i))
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, item)
+		}
+		return out, nil
+	default:
+		return scalarValue(fd, v)
+	}
+}
+
+func scalarValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) (any, error) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return jsonValue(v.Message())
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name()), nil
+		}
+		return int32(v.Enum()), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return v.Int(), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return v.Uint(), nil
+	default:
+		return v.Interface(), nil
+	}
+}
+
+func isEmpty(raw json.RawMessage) bool {
+	return len(raw) == 0 || string(raw) == "null"
+}
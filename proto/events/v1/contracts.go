@@ -0,0 +1,93 @@
+package eventsv1
+
+import (
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Contract binds a topic to the message published on it and the schema
+// version producers stamp on the envelope. A change that would break an
+// existing consumer gets a new version rather than an edit in place.
+type Contract struct {
+	Topic   string
+	Version string
+	New     func() proto.Message
+}
+
+const (
+	TopicRideRequested         = "ride.requested"
+	TopicRideScheduled         = "ride.scheduled"
+	TopicRideRescheduled       = "ride.scheduled.updated"
+	TopicRideReminder          = "ride.scheduled.reminder"
+	TopicRideMatchingStarted   = "ride.matching.started"
+	TopicRideDriverAssigned    = "ride.driver.assigned"
+	TopicRideInProgress        = "ride.in_progress"
+	TopicRideCompleted         = "ride.completed"
+	TopicRideCancelled         = "ride.cancelled"
+	TopicRideDriverCancelled   = "ride.driver.cancelled"
+	TopicRideDriverArrived     = "ride.driver.arrived"
+	TopicCancellationStrike    = "ride.cancellation.strike"
+	TopicRideOfferSent         = "ride.offer.sent"
+	TopicRideOfferAccepted     = "ride.offer.accepted"
+	TopicRideOfferDeclined     = "ride.offer.declined"
+	TopicRideOfferExpired      = "ride.offer.expired"
+	TopicRideStopArrived       = "ride.stop.arrived"
+	TopicRideStopDeparted      = "ride.stop.departed"
+	TopicRidePaymentCaptured   = "ride.payment.captured"
+	TopicRidePaymentVoided     = "ride.payment.voided"
+	TopicRidePaymentFailed     = "ride.payment.failed"
+	TopicRidePaymentRefunded   = "ride.payment.refunded"
+	TopicRideRated             = "ride.rated"
+	TopicRidePinIssued         = "ride.pin.issued"
+	TopicRidePinFailed         = "ride.pin.failed"
+	TopicDriverPayoutCreated   = "driver.payout.created"
+	TopicDriverLocationUpdated = "driver.location.updated"
+)
+
+var contracts = map[string]Contract{}
+
+func register(version string, newMsg func() proto.Message, topics ...string) {
+	for _, topic := range topics {
+		contracts[topic] = Contract{Topic: topic, Version: version, New: newMsg}
+	}
+}
+
+func init() {
+	register("v1", func() proto.Message { return &RideRequested{} }, TopicRideRequested)
+	register("v1", func() proto.Message { return &RideScheduled{} }, TopicRideScheduled)
+	register("v1", func() proto.Message { return &RideRescheduled{} }, TopicRideRescheduled)
+	register("v1", func() proto.Message { return &RideReminder{} }, TopicRideReminder)
+	register("v1", func() proto.Message { return &RideStatusChanged{} },
+		TopicRideMatchingStarted, TopicRideDriverAssigned, TopicRideInProgress, TopicRideCompleted)
+	register("v1", func() proto.Message { return &RideCancelled{} }, TopicRideCancelled)
+	register("v1", func() proto.Message { return &RideDriverCancelled{} }, TopicRideDriverCancelled)
+	register("v1", func() proto.Message { return &RideDriverArrived{} }, TopicRideDriverArrived)
+	register("v1", func() proto.Message { return &CancellationStrike{} }, TopicCancellationStrike)
+	register("v1", func() proto.Message { return &RideOfferUpdated{} },
+		TopicRideOfferSent, TopicRideOfferAccepted, TopicRideOfferDeclined, TopicRideOfferExpired)
+	register("v1", func() proto.Message { return &RideStopUpdated{} }, TopicRideStopArrived, TopicRideStopDeparted)
+	register("v1", func() proto.Message { return &RidePaymentUpdated{} },
+		TopicRidePaymentCaptured, TopicRidePaymentVoided, TopicRidePaymentFailed, TopicRidePaymentRefunded)
+	register("v1", func() proto.Message { return &RideRated{} }, TopicRideRated)
+	register("v1", func() proto.Message { return &RidePinIssued{} }, TopicRidePinIssued)
+	register("v1", func() proto.Message { return &RidePinFailed{} }, TopicRidePinFailed)
+	register("v1", func() proto.Message { return &DriverPayoutCreated{} }, TopicDriverPayoutCreated)
+	register("v1", func() proto.Message { return &DriverLocationUpdated{} }, TopicDriverLocationUpdated)
+}
+
+// Lookup returns the contract for topic.
+func Lookup(topic string) (Contract, bool) {
+	contract, ok := contracts[topic]
+	return contract, ok
+}
+
+// Topics lists every topic with a contract, sorted.
+func Topics() []string {
+	topics := make([]string, 0, len(contracts))
+	for topic := range contracts {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+	return topics
+}
@@ -0,0 +1,123 @@
+package eventsv1
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Every topic has a fixture in testdata holding the payload consumers are
+// written against. A contract edit that renames, retypes or drops a field
+// fails here before it reaches a consumer.
+func TestContractFixtures(t *testing.T) {
+	fixtures, err := filepath.Glob("testdata/*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	covered := map[string]bool{}
+	for _, path := range fixtures {
+		topic := strings.TrimSuffix(filepath.Base(path), ".json")
+		covered[topic] = true
+		t.Run(topic, func(t *testing.T) {
+			raw, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			msg, err := Validate(raw)
+			if err != nil {
+				t.Fatalf("fixture does not match its contract: %v", err)
+			}
+			envelope, err := ParseEnvelope(raw)
+			if err != nil || envelope.Type != topic {
+				t.Fatalf("fixture type %q under %s: %v", envelope.Type, topic, err)
+			}
+			encoded, err := MarshalData(msg)
+			if err != nil {
+				t.Fatalf("encode error: %v", err)
+			}
+			var want, got any
+			_ = json.Unmarshal(envelope.Data, &want)
+			_ = json.Unmarshal(encoded, &got)
+			if !reflect.DeepEqual(want, got) {
+				t.Fatalf("producer output drifted from the fixture:\nwant %s\ngot  %s", envelope.Data, encoded)
+			}
+		})
+	}
+	for _, topic := range Topics() {
+		if !covered[topic] {
+			t.Errorf("no fixture for %s", topic)
+		}
+	}
+}
+
+func TestMarshalDataCompatibility(t *testing.T) {
+	data, err := MarshalData(&RideRequested{RideId: "r1", FareAmount: 9007199254740993})
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := string(data)
+	// Money stays a JSON number, zero values are written, unset optionals are not.
+	if !strings.Contains(text, `"fare_amount":9007199254740993`) || !strings.Contains(text, `"pickup_lat":0`) || !strings.Contains(text, `"stops":[]`) || strings.Contains(text, "pickup_at") {
+		t.Fatalf("unexpected encoding: %s", text)
+	}
+
+	at := int64(1767272400)
+	data, _ = MarshalData(&RideRequested{RideId: "r1", PickupAt: &at})
+	if !strings.Contains(string(data), `"pickup_at":1767272400`) {
+		t.Fatalf("expected optional field written when set: %s", data)
+	}
+}
+
+func TestParseLegacyEnvelope(t *testing.T) {
+	legacy := []byte(`{"id":"e1","type":"driver.location.updated","source":"location-service","time":"2026-01-01T12:00:00Z","version":"v1","payload":{"driver_id":"d1","lat":1.5,"lng":2.5,"recorded_at_unix":"1767272400"}}`)
+	var msg DriverLocationUpdated
+	envelope, err := Unmarshal(legacy, &msg)
+	if err != nil {
+		t.Fatalf("legacy envelope rejected: %v", err)
+	}
+	if envelope.Producer != "location-service" || !envelope.OccurredAt.Equal(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Fatalf("legacy fields not carried over: %+v", envelope)
+	}
+	if msg.GetDriverId() != "d1" || msg.GetLat() != 1.5 || msg.GetRecordedAtUnix() != 1767272400 {
+		t.Fatalf("unexpected data: %+v", &msg)
+	}
+}
+
+func TestValidateRejectsBrokenEvents(t *testing.T) {
+	good, err := Marshal(TopicRideOfferSent, "ride-service", "", "", &RideOfferUpdated{OfferId: "o1", RideId: "r1"}, time.Unix(0, 0), "e1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Validate(good); err != nil {
+		t.Fatalf("expected valid event, got %v", err)
+	}
+
+	cases := map[string]struct {
+		payload string
+		want    error
+	}{
+		"unknown topic":  {`{"type":"ride.teleported","version":"v1","data":{}}`, ErrUnknownTopic},
+		"future version": {`{"type":"ride.offer.sent","version":"v2","data":{"offer_id":"o1"}}`, ErrUnsupportedVersion},
+		"missing data":   {`{"type":"ride.offer.sent","version":"v1"}`, ErrMissingData},
+		"unknown field":  {`{"type":"ride.offer.sent","version":"v1","data":{"ride":"r1"}}`, nil},
+		"json name":      {`{"type":"ride.offer.sent","version":"v1","data":{"offerId":"o1"}}`, ErrFieldAlias},
+		"mixed names":    {`{"type":"ride.requested","version":"v1","data":{"ride_id":"r1","pickupLat":1}}`, ErrFieldAlias},
+		"nested unknown": {`{"type":"ride.requested","version":"v1","data":{"ride_id":"r1","stops":[{"lat":1,"lng":2,"place":"p1"}]}}`, nil},
+		"retyped field":  {`{"type":"ride.offer.sent","version":"v1","data":{"offer_id":7}}`, nil},
+	}
+	for name, tc := range cases {
+		_, err := Validate([]byte(tc.payload))
+		if err == nil || (tc.want != nil && !errors.Is(err, tc.want)) {
+			t.Errorf("%s: expected rejection (%v), got %v", name, tc.want, err)
+		}
+	}
+
+	if _, err := Marshal(TopicRideOfferSent, "ride-service", "", "", &RideCancelled{}, time.Unix(0, 0), "e2"); !errors.Is(err, ErrTopicMismatch) {
+		t.Fatalf("expected wrong message for topic rejected, got %v", err)
+	}
+}
@@ -0,0 +1,108 @@
+package eventsv1
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+)
+
+// ErrSealedPIN is returned when a sealed PIN cannot be opened: it is
+// malformed, was sealed for another key or belongs to another ride.
+var ErrSealedPIN = errors.New("sealed pin cannot be opened")
+
+const pinSealInfo = "ride.pin.issued"
+
+// SealPIN encrypts a pickup PIN so that only the holder of the private half
+// of recipient can read it. A fresh X25519 key is agreed with recipient per
+// PIN and the result is bound to rideID, so a sealed PIN copied onto another
+// ride does not open.
+func SealPIN(recipient *ecdh.PublicKey, rideID string, pin string) (string, error) {
+	ephemeral, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return "", err
+	}
+	shared, err := ephemeral.ECDH(recipient)
+	if err != nil {
+		return "", err
+	}
+	aead, err := pinAEAD(shared, ephemeral.PublicKey().Bytes())
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	out := append(ephemeral.PublicKey().Bytes(), nonce...)
+	out = aead.Seal(out, nonce, []byte(pin), []byte(rideID))
+	return base64.RawURLEncoding.EncodeToString(out), nil
+}
+
+// OpenPIN reverses SealPIN with the recipient's private key.
+func OpenPIN(key *ecdh.PrivateKey, rideID string, sealed string) (string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(sealed)
+	if err != nil {
+		return "", ErrSealedPIN
+	}
+	const keySize = 32
+	if len(raw) < keySize {
+		return "", ErrSealedPIN
+	}
+	ephemeral, err := ecdh.X25519().NewPublicKey(raw[:keySize])
+	if err != nil {
+		return "", ErrSealedPIN
+	}
+	shared, err := key.ECDH(ephemeral)
+	if err != nil {
+		return "", ErrSealedPIN
+	}
+	aead, err := pinAEAD(shared, raw[:keySize])
+	if err != nil {
+		return "", err
+	}
+	rest := raw[keySize:]
+	if len(rest) < aead.NonceSize() {
+		return "", ErrSealedPIN
+	}
+	pin, err := aead.Open(nil, rest[:aead.NonceSize()], rest[aead.NonceSize():], []byte(rideID))
+	if err != nil {
+		return "", ErrSealedPIN
+	}
+	return string(pin), nil
+}
+
+// ParsePINPublicKey reads the base64 X25519 public key PINs are sealed to.
+func ParsePINPublicKey(encoded string) (*ecdh.PublicKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return ecdh.X25519().NewPublicKey(raw)
+}
+
+// ParsePINPrivateKey reads the base64 X25519 private key PINs are opened
+// with.
+func ParsePINPrivateKey(encoded string) (*ecdh.PrivateKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return ecdh.X25519().NewPrivateKey(raw)
+}
+
+func pinAEAD(shared []byte, ephemeral []byte) (cipher.AEAD, error) {
+	key, err := hkdf.Key(sha256.New, shared, ephemeral, pinSealInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
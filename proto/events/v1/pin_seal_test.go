@@ -0,0 +1,42 @@
+package eventsv1
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSealPINOpensOnlyForItsKeyAndRide(t *testing.T) {
+	key, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	public, err := ParsePINPublicKey(base64.StdEncoding.EncodeToString(key.PublicKey().Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed, err := SealPIN(public, "ride-1", "4821")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(sealed, "4821") {
+		t.Fatalf("expected the PIN hidden, got %s", sealed)
+	}
+	if pin, err := OpenPIN(key, "ride-1", sealed); err != nil || pin != "4821" {
+		t.Fatalf("expected the PIN back, got %q (%v)", pin, err)
+	}
+
+	if _, err := OpenPIN(key, "ride-2", sealed); !errors.Is(err, ErrSealedPIN) {
+		t.Fatalf("expected a PIN sealed for another ride rejected, got %v", err)
+	}
+	other, _ := ecdh.X25519().GenerateKey(rand.Reader)
+	if _, err := OpenPIN(other, "ride-1", sealed); !errors.Is(err, ErrSealedPIN) {
+		t.Fatalf("expected another key rejected, got %v", err)
+	}
+	if _, err := OpenPIN(key, "ride-1", "not-sealed"); !errors.Is(err, ErrSealedPIN) {
+		t.Fatalf("expected garbage rejected, got %v", err)
+	}
+}
@@ -22,6 +22,7 @@ const (
 	MatchingService_FindCandidates_FullMethodName     = "/matching.v1.MatchingService/FindCandidates"
 	MatchingService_NotifyOfferSent_FullMethodName    = "/matching.v1.MatchingService/NotifyOfferSent"
 	MatchingService_UpdateDriverStatus_FullMethodName = "/matching.v1.MatchingService/UpdateDriverStatus"
+	MatchingService_GetSurge_FullMethodName           = "/matching.v1.MatchingService/GetSurge"
 )
 
 // MatchingServiceClient is the client API for MatchingService service.
@@ -36,6 +37,8 @@ type MatchingServiceClient interface {
 	NotifyOfferSent(ctx context.Context, in *NotifyOfferSentRequest, opts ...grpc.CallOption) (*NotifyOfferSentResponse, error)
 	// UpdateDriverStatus updates a driver's availability status.
 	UpdateDriverStatus(ctx context.Context, in *UpdateDriverStatusRequest, opts ...grpc.CallOption) (*UpdateDriverStatusResponse, error)
+	// GetSurge returns the current surge multiplier for the cell containing a point.
+	GetSurge(ctx context.Context, in *GetSurgeRequest, opts ...grpc.CallOption) (*GetSurgeResponse, error)
 }
 
 type matchingServiceClient struct {
@@ -76,6 +79,16 @@ func (c *matchingServiceClient) UpdateDriverStatus(ctx context.Context, in *Upda
 	return out, nil
 }
 
+func (c *matchingServiceClient) GetSurge(ctx context.Context, in *GetSurgeRequest, opts ...grpc.CallOption) (*GetSurgeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSurgeResponse)
+	err := c.cc.Invoke(ctx, MatchingService_GetSurge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MatchingServiceServer is the server API for MatchingService service.
 // All implementations must embed UnimplementedMatchingServiceServer
 // for forward compatibility
@@ -88,6 +101,8 @@ type MatchingServiceServer interface {
 	NotifyOfferSent(context.Context, *NotifyOfferSentRequest) (*NotifyOfferSentResponse, error)
 	// UpdateDriverStatus updates a driver's availability status.
 	UpdateDriverStatus(context.Context, *UpdateDriverStatusRequest) (*UpdateDriverStatusResponse, error)
+	// GetSurge returns the current surge multiplier for the cell containing a point.
+	GetSurge(context.Context, *GetSurgeRequest) (*GetSurgeResponse, error)
 	mustEmbedUnimplementedMatchingServiceServer()
 }
 
@@ -104,6 +119,9 @@ func (UnimplementedMatchingServiceServer) NotifyOfferSent(context.Context, *Noti
 func (UnimplementedMatchingServiceServer) UpdateDriverStatus(context.Context, *UpdateDriverStatusRequest) (*UpdateDriverStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDriverStatus not implemented")
 }
+func (UnimplementedMatchingServiceServer) GetSurge(context.Context, *GetSurgeRequest) (*GetSurgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSurge not implemented")
+}
 func (UnimplementedMatchingServiceServer) mustEmbedUnimplementedMatchingServiceServer() {}
 
 // UnsafeMatchingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -171,6 +189,24 @@ func _MatchingService_UpdateDriverStatus_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_GetSurge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSurgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).GetSurge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_GetSurge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).GetSurge(ctx, req.(*GetSurgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MatchingService_ServiceDesc is the grpc.ServiceDesc for MatchingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -190,6 +226,10 @@ var MatchingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDriverStatus",
 			Handler:    _MatchingService_UpdateDriverStatus_Handler,
 		},
+		{
+			MethodName: "GetSurge",
+			Handler:    _MatchingService_GetSurge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "matching/v1/matching.proto",
@@ -0,0 +1,318 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: ride/v1/outbox_admin.proto
+
+package ridev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	OutboxAdmin_ListOutbox_FullMethodName       = "/ride.v1.OutboxAdmin/ListOutbox"
+	OutboxAdmin_GetOutboxMessage_FullMethodName = "/ride.v1.OutboxAdmin/GetOutboxMessage"
+	OutboxAdmin_ReplayOutbox_FullMethodName     = "/ride.v1.OutboxAdmin/ReplayOutbox"
+	OutboxAdmin_SkipOutbox_FullMethodName       = "/ride.v1.OutboxAdmin/SkipOutbox"
+	OutboxAdmin_PurgeOutbox_FullMethodName      = "/ride.v1.OutboxAdmin/PurgeOutbox"
+	OutboxAdmin_GetOutboxStats_FullMethodName   = "/ride.v1.OutboxAdmin/GetOutboxStats"
+)
+
+// OutboxAdminClient is the client API for OutboxAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// OutboxAdmin lets operators inspect and repair the ride service's event
+// outbox. Internal use only; calls require the internal token.
+type OutboxAdminClient interface {
+	// ListOutbox returns outbox rows in enqueue order, filtered by status, topic and age.
+	ListOutbox(ctx context.Context, in *ListOutboxRequest, opts ...grpc.CallOption) (*ListOutboxResponse, error)
+	// GetOutboxMessage returns one row with its payload and last error.
+	GetOutboxMessage(ctx context.Context, in *GetOutboxMessageRequest, opts ...grpc.CallOption) (*GetOutboxMessageResponse, error)
+	// ReplayOutbox re-queues rows by ID, or by topic and time range, with a fresh attempt budget.
+	ReplayOutbox(ctx context.Context, in *ReplayOutboxRequest, opts ...grpc.CallOption) (*ReplayOutboxResponse, error)
+	// SkipOutbox retires poison messages without publishing them.
+	SkipOutbox(ctx context.Context, in *SkipOutboxRequest, opts ...grpc.CallOption) (*SkipOutboxResponse, error)
+	// PurgeOutbox deletes rows, keeping their payload in the audit log.
+	PurgeOutbox(ctx context.Context, in *PurgeOutboxRequest, opts ...grpc.CallOption) (*PurgeOutboxResponse, error)
+	// GetOutboxStats reports the backlog by status and topic.
+	GetOutboxStats(ctx context.Context, in *GetOutboxStatsRequest, opts ...grpc.CallOption) (*GetOutboxStatsResponse, error)
+}
+
+type outboxAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOutboxAdminClient(cc grpc.ClientConnInterface) OutboxAdminClient {
+	return &outboxAdminClient{cc}
+}
+
+func (c *outboxAdminClient) ListOutbox(ctx context.Context, in *ListOutboxRequest, opts ...grpc.CallOption) (*ListOutboxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOutboxResponse)
+	err := c.cc.Invoke(ctx, OutboxAdmin_ListOutbox_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *outboxAdminClient) GetOutboxMessage(ctx context.Context, in *GetOutboxMessageRequest, opts ...grpc.CallOption) (*GetOutboxMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOutboxMessageResponse)
+	err := c.cc.Invoke(ctx, OutboxAdmin_GetOutboxMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *outboxAdminClient) ReplayOutbox(ctx context.Context, in *ReplayOutboxRequest, opts ...grpc.CallOption) (*ReplayOutboxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayOutboxResponse)
+	err := c.cc.Invoke(ctx, OutboxAdmin_ReplayOutbox_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *outboxAdminClient) SkipOutbox(ctx context.Context, in *SkipOutboxRequest, opts ...grpc.CallOption) (*SkipOutboxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkipOutboxResponse)
+	err := c.cc.Invoke(ctx, OutboxAdmin_SkipOutbox_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *outboxAdminClient) PurgeOutbox(ctx context.Context, in *PurgeOutboxRequest, opts ...grpc.CallOption) (*PurgeOutboxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeOutboxResponse)
+	err := c.cc.Invoke(ctx, OutboxAdmin_PurgeOutbox_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *outboxAdminClient) GetOutboxStats(ctx context.Context, in *GetOutboxStatsRequest, opts ...grpc.CallOption) (*GetOutboxStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOutboxStatsResponse)
+	err := c.cc.Invoke(ctx, OutboxAdmin_GetOutboxStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OutboxAdminServer is the server API for OutboxAdmin service.
+// All implementations must embed UnimplementedOutboxAdminServer
+// for forward compatibility
+//
+// OutboxAdmin lets operators inspect and repair the ride service's event
+// outbox. Internal use only; calls require the internal token.
+type OutboxAdminServer interface {
+	// ListOutbox returns outbox rows in enqueue order, filtered by status, topic and age.
+	ListOutbox(context.Context, *ListOutboxRequest) (*ListOutboxResponse, error)
+	// GetOutboxMessage returns one row with its payload and last error.
+	GetOutboxMessage(context.Context, *GetOutboxMessageRequest) (*GetOutboxMessageResponse, error)
+	// ReplayOutbox re-queues rows by ID, or by topic and time range, with a fresh attempt budget.
+	ReplayOutbox(context.Context, *ReplayOutboxRequest) (*ReplayOutboxResponse, error)
+	// SkipOutbox retires poison messages without publishing them.
+	SkipOutbox(context.Context, *SkipOutboxRequest) (*SkipOutboxResponse, error)
+	// PurgeOutbox deletes rows, keeping their payload in the audit log.
+	PurgeOutbox(context.Context, *PurgeOutboxRequest) (*PurgeOutboxResponse, error)
+	// GetOutboxStats reports the backlog by status and topic.
+	GetOutboxStats(context.Context, *GetOutboxStatsRequest) (*GetOutboxStatsResponse, error)
+	mustEmbedUnimplementedOutboxAdminServer()
+}
+
+// UnimplementedOutboxAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedOutboxAdminServer struct {
+}
+
+func (UnimplementedOutboxAdminServer) ListOutbox(context.Context, *ListOutboxRequest) (*ListOutboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutbox not implemented")
+}
+func (UnimplementedOutboxAdminServer) GetOutboxMessage(context.Context, *GetOutboxMessageRequest) (*GetOutboxMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutboxMessage not implemented")
+}
+func (UnimplementedOutboxAdminServer) ReplayOutbox(context.Context, *ReplayOutboxRequest) (*ReplayOutboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayOutbox not implemented")
+}
+func (UnimplementedOutboxAdminServer) SkipOutbox(context.Context, *SkipOutboxRequest) (*SkipOutboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkipOutbox not implemented")
+}
+func (UnimplementedOutboxAdminServer) PurgeOutbox(context.Context, *PurgeOutboxRequest) (*PurgeOutboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeOutbox not implemented")
+}
+func (UnimplementedOutboxAdminServer) GetOutboxStats(context.Context, *GetOutboxStatsRequest) (*GetOutboxStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutboxStats not implemented")
+}
+func (UnimplementedOutboxAdminServer) mustEmbedUnimplementedOutboxAdminServer() {}
+
+// UnsafeOutboxAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OutboxAdminServer will
+// result in compilation errors.
+type UnsafeOutboxAdminServer interface {
+	mustEmbedUnimplementedOutboxAdminServer()
+}
+
+func RegisterOutboxAdminServer(s grpc.ServiceRegistrar, srv OutboxAdminServer) {
+	s.RegisterService(&OutboxAdmin_ServiceDesc, srv)
+}
+
+func _OutboxAdmin_ListOutbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OutboxAdminServer).ListOutbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OutboxAdmin_ListOutbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OutboxAdminServer).ListOutbox(ctx, req.(*ListOutboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OutboxAdmin_GetOutboxMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOutboxMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OutboxAdminServer).GetOutboxMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OutboxAdmin_GetOutboxMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OutboxAdminServer).GetOutboxMessage(ctx, req.(*GetOutboxMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OutboxAdmin_ReplayOutbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayOutboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OutboxAdminServer).ReplayOutbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OutboxAdmin_ReplayOutbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OutboxAdminServer).ReplayOutbox(ctx, req.(*ReplayOutboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OutboxAdmin_SkipOutbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkipOutboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OutboxAdminServer).SkipOutbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OutboxAdmin_SkipOutbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OutboxAdminServer).SkipOutbox(ctx, req.(*SkipOutboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OutboxAdmin_PurgeOutbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeOutboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OutboxAdminServer).PurgeOutbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OutboxAdmin_PurgeOutbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OutboxAdminServer).PurgeOutbox(ctx, req.(*PurgeOutboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OutboxAdmin_GetOutboxStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOutboxStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OutboxAdminServer).GetOutboxStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OutboxAdmin_GetOutboxStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OutboxAdminServer).GetOutboxStats(ctx, req.(*GetOutboxStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OutboxAdmin_ServiceDesc is the grpc.ServiceDesc for OutboxAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OutboxAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ride.v1.OutboxAdmin",
+	HandlerType: (*OutboxAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListOutbox",
+			Handler:    _OutboxAdmin_ListOutbox_Handler,
+		},
+		{
+			MethodName: "GetOutboxMessage",
+			Handler:    _OutboxAdmin_GetOutboxMessage_Handler,
+		},
+		{
+			MethodName: "ReplayOutbox",
+			Handler:    _OutboxAdmin_ReplayOutbox_Handler,
+		},
+		{
+			MethodName: "SkipOutbox",
+			Handler:    _OutboxAdmin_SkipOutbox_Handler,
+		},
+		{
+			MethodName: "PurgeOutbox",
+			Handler:    _OutboxAdmin_PurgeOutbox_Handler,
+		},
+		{
+			MethodName: "GetOutboxStats",
+			Handler:    _OutboxAdmin_GetOutboxStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ride/v1/outbox_admin.proto",
+}
@@ -19,14 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	RideService_CreateRide_FullMethodName    = "/ride.v1.RideService/CreateRide"
-	RideService_StartMatching_FullMethodName = "/ride.v1.RideService/StartMatching"
-	RideService_AssignDriver_FullMethodName  = "/ride.v1.RideService/AssignDriver"
-	RideService_CancelRide_FullMethodName    = "/ride.v1.RideService/CancelRide"
-	RideService_CreateOffer_FullMethodName   = "/ride.v1.RideService/CreateOffer"
-	RideService_AcceptOffer_FullMethodName   = "/ride.v1.RideService/AcceptOffer"
-	RideService_DeclineOffer_FullMethodName  = "/ride.v1.RideService/DeclineOffer"
-	RideService_ExpireOffer_FullMethodName   = "/ride.v1.RideService/ExpireOffer"
+	RideService_QuoteFare_FullMethodName         = "/ride.v1.RideService/QuoteFare"
+	RideService_CreateRide_FullMethodName        = "/ride.v1.RideService/CreateRide"
+	RideService_StartMatching_FullMethodName     = "/ride.v1.RideService/StartMatching"
+	RideService_AssignDriver_FullMethodName      = "/ride.v1.RideService/AssignDriver"
+	RideService_MarkDriverArrived_FullMethodName = "/ride.v1.RideService/MarkDriverArrived"
+	RideService_StartRide_FullMethodName         = "/ride.v1.RideService/StartRide"
+	RideService_CompleteRide_FullMethodName      = "/ride.v1.RideService/CompleteRide"
+	RideService_ArriveAtStop_FullMethodName      = "/ride.v1.RideService/ArriveAtStop"
+	RideService_DepartStop_FullMethodName        = "/ride.v1.RideService/DepartStop"
+	RideService_RescheduleRide_FullMethodName    = "/ride.v1.RideService/RescheduleRide"
+	RideService_CancelRide_FullMethodName        = "/ride.v1.RideService/CancelRide"
+	RideService_DriverCancelRide_FullMethodName  = "/ride.v1.RideService/DriverCancelRide"
+	RideService_OverridePickupPin_FullMethodName = "/ride.v1.RideService/OverridePickupPin"
+	RideService_RateRide_FullMethodName          = "/ride.v1.RideService/RateRide"
+	RideService_GetRidePayment_FullMethodName    = "/ride.v1.RideService/GetRidePayment"
+	RideService_RefundRidePayment_FullMethodName = "/ride.v1.RideService/RefundRidePayment"
+	RideService_GetDriverEarnings_FullMethodName = "/ride.v1.RideService/GetDriverEarnings"
+	RideService_GetRide_FullMethodName           = "/ride.v1.RideService/GetRide"
+	RideService_ListRides_FullMethodName         = "/ride.v1.RideService/ListRides"
+	RideService_GetRideTimeline_FullMethodName   = "/ride.v1.RideService/GetRideTimeline"
+	RideService_CreateOffer_FullMethodName       = "/ride.v1.RideService/CreateOffer"
+	RideService_AcceptOffer_FullMethodName       = "/ride.v1.RideService/AcceptOffer"
+	RideService_DeclineOffer_FullMethodName      = "/ride.v1.RideService/DeclineOffer"
+	RideService_ExpireOffer_FullMethodName       = "/ride.v1.RideService/ExpireOffer"
 )
 
 // RideServiceClient is the client API for RideService service.
@@ -35,14 +51,46 @@ const (
 //
 // RideService defines ride lifecycle operations for internal use.
 type RideServiceClient interface {
+	// QuoteFare prices a trip and returns a signed, time-limited quote.
+	QuoteFare(ctx context.Context, in *QuoteFareRequest, opts ...grpc.CallOption) (*QuoteFareResponse, error)
 	// CreateRide creates a new ride request.
 	CreateRide(ctx context.Context, in *CreateRideRequest, opts ...grpc.CallOption) (*CreateRideResponse, error)
 	// StartMatching transitions a ride into matching state.
 	StartMatching(ctx context.Context, in *StartMatchingRequest, opts ...grpc.CallOption) (*StartMatchingResponse, error)
 	// AssignDriver assigns a driver to a ride.
 	AssignDriver(ctx context.Context, in *AssignDriverRequest, opts ...grpc.CallOption) (*AssignDriverResponse, error)
+	// MarkDriverArrived records the assigned driver reaching the pickup and starts the rider's wait.
+	MarkDriverArrived(ctx context.Context, in *MarkDriverArrivedRequest, opts ...grpc.CallOption) (*MarkDriverArrivedResponse, error)
+	// StartRide moves an assigned ride into progress for its driver.
+	StartRide(ctx context.Context, in *StartRideRequest, opts ...grpc.CallOption) (*StartRideResponse, error)
+	// CompleteRide marks an in-progress ride as completed by its driver.
+	CompleteRide(ctx context.Context, in *CompleteRideRequest, opts ...grpc.CallOption) (*CompleteRideResponse, error)
+	// ArriveAtStop records the assigned driver reaching an intermediate stop.
+	ArriveAtStop(ctx context.Context, in *ArriveAtStopRequest, opts ...grpc.CallOption) (*ArriveAtStopResponse, error)
+	// DepartStop records the assigned driver leaving an intermediate stop.
+	DepartStop(ctx context.Context, in *DepartStopRequest, opts ...grpc.CallOption) (*DepartStopResponse, error)
+	// RescheduleRide moves the pickup time of a scheduled ride.
+	RescheduleRide(ctx context.Context, in *RescheduleRideRequest, opts ...grpc.CallOption) (*RescheduleRideResponse, error)
 	// CancelRide cancels a ride with a reason.
 	CancelRide(ctx context.Context, in *CancelRideRequest, opts ...grpc.CallOption) (*CancelRideResponse, error)
+	// DriverCancelRide releases the assigned driver and returns the ride to matching.
+	DriverCancelRide(ctx context.Context, in *DriverCancelRideRequest, opts ...grpc.CallOption) (*DriverCancelRideResponse, error)
+	// OverridePickupPin lets support start a ride without its pickup PIN, lifting any lock.
+	OverridePickupPin(ctx context.Context, in *OverridePickupPinRequest, opts ...grpc.CallOption) (*OverridePickupPinResponse, error)
+	// RateRide records one side's rating of a completed ride.
+	RateRide(ctx context.Context, in *RateRideRequest, opts ...grpc.CallOption) (*RateRideResponse, error)
+	// GetRidePayment returns the payment held for a ride.
+	GetRidePayment(ctx context.Context, in *GetRidePaymentRequest, opts ...grpc.CallOption) (*GetRidePaymentResponse, error)
+	// RefundRidePayment returns part or all of a captured ride payment.
+	RefundRidePayment(ctx context.Context, in *RefundRidePaymentRequest, opts ...grpc.CallOption) (*RefundRidePaymentResponse, error)
+	// GetDriverEarnings summarises a driver's ledger by day or week.
+	GetDriverEarnings(ctx context.Context, in *GetDriverEarningsRequest, opts ...grpc.CallOption) (*GetDriverEarningsResponse, error)
+	// GetRide returns a single ride by identifier.
+	GetRide(ctx context.Context, in *GetRideRequest, opts ...grpc.CallOption) (*GetRideResponse, error)
+	// ListRides returns rides matching a filter, newest first, using cursor pagination.
+	ListRides(ctx context.Context, in *ListRidesRequest, opts ...grpc.CallOption) (*ListRidesResponse, error)
+	// GetRideTimeline returns every status transition of a ride, oldest first.
+	GetRideTimeline(ctx context.Context, in *GetRideTimelineRequest, opts ...grpc.CallOption) (*GetRideTimelineResponse, error)
 	// CreateOffer creates a ride offer for a driver.
 	CreateOffer(ctx context.Context, in *CreateOfferRequest, opts ...grpc.CallOption) (*CreateOfferResponse, error)
 	// AcceptOffer marks a pending offer as accepted.
@@ -61,6 +109,16 @@ func NewRideServiceClient(cc grpc.ClientConnInterface) RideServiceClient {
 	return &rideServiceClient{cc}
 }
 
+func (c *rideServiceClient) QuoteFare(ctx context.Context, in *QuoteFareRequest, opts ...grpc.CallOption) (*QuoteFareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteFareResponse)
+	err := c.cc.Invoke(ctx, RideService_QuoteFare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rideServiceClient) CreateRide(ctx context.Context, in *CreateRideRequest, opts ...grpc.CallOption) (*CreateRideResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateRideResponse)
@@ -91,6 +149,66 @@ func (c *rideServiceClient) AssignDriver(ctx context.Context, in *AssignDriverRe
 	return out, nil
 }
 
+func (c *rideServiceClient) MarkDriverArrived(ctx context.Context, in *MarkDriverArrivedRequest, opts ...grpc.CallOption) (*MarkDriverArrivedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkDriverArrivedResponse)
+	err := c.cc.Invoke(ctx, RideService_MarkDriverArrived_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rideServiceClient) StartRide(ctx context.Context, in *StartRideRequest, opts ...grpc.CallOption) (*StartRideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartRideResponse)
+	err := c.cc.Invoke(ctx, RideService_StartRide_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rideServiceClient) CompleteRide(ctx context.Context, in *CompleteRideRequest, opts ...grpc.CallOption) (*CompleteRideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteRideResponse)
+	err := c.cc.Invoke(ctx, RideService_CompleteRide_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rideServiceClient) ArriveAtStop(ctx context.Context, in *ArriveAtStopRequest, opts ...grpc.CallOption) (*ArriveAtStopResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArriveAtStopResponse)
+	err := c.cc.Invoke(ctx, RideService_ArriveAtStop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rideServiceClient) DepartStop(ctx context.Context, in *DepartStopRequest, opts ...grpc.CallOption) (*DepartStopResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DepartStopResponse)
+	err := c.cc.Invoke(ctx, RideService_DepartStop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rideServiceClient) RescheduleRide(ctx context.Context, in *RescheduleRideRequest, opts ...grpc.CallOption) (*RescheduleRideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RescheduleRideResponse)
+	err := c.cc.Invoke(ctx, RideService_RescheduleRide_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rideServiceClient) CancelRide(ctx context.Context, in *CancelRideRequest, opts ...grpc.CallOption) (*CancelRideResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CancelRideResponse)
@@ -101,6 +219,96 @@ func (c *rideServiceClient) CancelRide(ctx context.Context, in *CancelRideReques
 	return out, nil
 }
 
+func (c *rideServiceClient) DriverCancelRide(ctx context.Context, in *DriverCancelRideRequest, opts ...grpc.CallOption) (*DriverCancelRideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DriverCancelRideResponse)
+	err := c.cc.Invoke(ctx, RideService_DriverCancelRide_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rideServiceClient) OverridePickupPin(ctx context.Context, in *OverridePickupPinRequest, opts ...grpc.CallOption) (*OverridePickupPinResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OverridePickupPinResponse)
+	err := c.cc.Invoke(ctx, RideService_OverridePickupPin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rideServiceClient) RateRide(ctx context.Context, in *RateRideRequest, opts ...grpc.CallOption) (*RateRideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RateRideResponse)
+	err := c.cc.Invoke(ctx, RideService_RateRide_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rideServiceClient) GetRidePayment(ctx context.Context, in *GetRidePaymentRequest, opts ...grpc.CallOption) (*GetRidePaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRidePaymentResponse)
+	err := c.cc.Invoke(ctx, RideService_GetRidePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rideServiceClient) RefundRidePayment(ctx context.Context, in *RefundRidePaymentRequest, opts ...grpc.CallOption) (*RefundRidePaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundRidePaymentResponse)
+	err := c.cc.Invoke(ctx, RideService_RefundRidePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rideServiceClient) GetDriverEarnings(ctx context.Context, in *GetDriverEarningsRequest, opts ...grpc.CallOption) (*GetDriverEarningsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDriverEarningsResponse)
+	err := c.cc.Invoke(ctx, RideService_GetDriverEarnings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rideServiceClient) GetRide(ctx context.Context, in *GetRideRequest, opts ...grpc.CallOption) (*GetRideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRideResponse)
+	err := c.cc.Invoke(ctx, RideService_GetRide_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rideServiceClient) ListRides(ctx context.Context, in *ListRidesRequest, opts ...grpc.CallOption) (*ListRidesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRidesResponse)
+	err := c.cc.Invoke(ctx, RideService_ListRides_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rideServiceClient) GetRideTimeline(ctx context.Context, in *GetRideTimelineRequest, opts ...grpc.CallOption) (*GetRideTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRideTimelineResponse)
+	err := c.cc.Invoke(ctx, RideService_GetRideTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rideServiceClient) CreateOffer(ctx context.Context, in *CreateOfferRequest, opts ...grpc.CallOption) (*CreateOfferResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateOfferResponse)
@@ -147,14 +355,46 @@ func (c *rideServiceClient) ExpireOffer(ctx context.Context, in *ExpireOfferRequ
 //
 // RideService defines ride lifecycle operations for internal use.
 type RideServiceServer interface {
+	// QuoteFare prices a trip and returns a signed, time-limited quote.
+	QuoteFare(context.Context, *QuoteFareRequest) (*QuoteFareResponse, error)
 	// CreateRide creates a new ride request.
 	CreateRide(context.Context, *CreateRideRequest) (*CreateRideResponse, error)
 	// StartMatching transitions a ride into matching state.
 	StartMatching(context.Context, *StartMatchingRequest) (*StartMatchingResponse, error)
 	// AssignDriver assigns a driver to a ride.
 	AssignDriver(context.Context, *AssignDriverRequest) (*AssignDriverResponse, error)
+	// MarkDriverArrived records the assigned driver reaching the pickup and starts the rider's wait.
+	MarkDriverArrived(context.Context, *MarkDriverArrivedRequest) (*MarkDriverArrivedResponse, error)
+	// StartRide moves an assigned ride into progress for its driver.
+	StartRide(context.Context, *StartRideRequest) (*StartRideResponse, error)
+	// CompleteRide marks an in-progress ride as completed by its driver.
+	CompleteRide(context.Context, *CompleteRideRequest) (*CompleteRideResponse, error)
+	// ArriveAtStop records the assigned driver reaching an intermediate stop.
+	ArriveAtStop(context.Context, *ArriveAtStopRequest) (*ArriveAtStopResponse, error)
+	// DepartStop records the assigned driver leaving an intermediate stop.
+	DepartStop(context.Context, *DepartStopRequest) (*DepartStopResponse, error)
+	// RescheduleRide moves the pickup time of a scheduled ride.
+	RescheduleRide(context.Context, *RescheduleRideRequest) (*RescheduleRideResponse, error)
 	// CancelRide cancels a ride with a reason.
 	CancelRide(context.Context, *CancelRideRequest) (*CancelRideResponse, error)
+	// DriverCancelRide releases the assigned driver and returns the ride to matching.
+	DriverCancelRide(context.Context, *DriverCancelRideRequest) (*DriverCancelRideResponse, error)
+	// OverridePickupPin lets support start a ride without its pickup PIN, lifting any lock.
+	OverridePickupPin(context.Context, *OverridePickupPinRequest) (*OverridePickupPinResponse, error)
+	// RateRide records one side's rating of a completed ride.
+	RateRide(context.Context, *RateRideRequest) (*RateRideResponse, error)
+	// GetRidePayment returns the payment held for a ride.
+	GetRidePayment(context.Context, *GetRidePaymentRequest) (*GetRidePaymentResponse, error)
+	// RefundRidePayment returns part or all of a captured ride payment.
+	RefundRidePayment(context.Context, *RefundRidePaymentRequest) (*RefundRidePaymentResponse, error)
+	// GetDriverEarnings summarises a driver's ledger by day or week.
+	GetDriverEarnings(context.Context, *GetDriverEarningsRequest) (*GetDriverEarningsResponse, error)
+	// GetRide returns a single ride by identifier.
+	GetRide(context.Context, *GetRideRequest) (*GetRideResponse, error)
+	// ListRides returns rides matching a filter, newest first, using cursor pagination.
+	ListRides(context.Context, *ListRidesRequest) (*ListRidesResponse, error)
+	// GetRideTimeline returns every status transition of a ride, oldest first.
+	GetRideTimeline(context.Context, *GetRideTimelineRequest) (*GetRideTimelineResponse, error)
 	// CreateOffer creates a ride offer for a driver.
 	CreateOffer(context.Context, *CreateOfferRequest) (*CreateOfferResponse, error)
 	// AcceptOffer marks a pending offer as accepted.
@@ -170,6 +410,9 @@ type RideServiceServer interface {
 type UnimplementedRideServiceServer struct {
 }
 
+func (UnimplementedRideServiceServer) QuoteFare(context.Context, *QuoteFareRequest) (*QuoteFareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteFare not implemented")
+}
 func (UnimplementedRideServiceServer) CreateRide(context.Context, *CreateRideRequest) (*CreateRideResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRide not implemented")
 }
@@ -179,9 +422,54 @@ func (UnimplementedRideServiceServer) StartMatching(context.Context, *StartMatch
 func (UnimplementedRideServiceServer) AssignDriver(context.Context, *AssignDriverRequest) (*AssignDriverResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AssignDriver not implemented")
 }
+func (UnimplementedRideServiceServer) MarkDriverArrived(context.Context, *MarkDriverArrivedRequest) (*MarkDriverArrivedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkDriverArrived not implemented")
+}
+func (UnimplementedRideServiceServer) StartRide(context.Context, *StartRideRequest) (*StartRideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRide not implemented")
+}
+func (UnimplementedRideServiceServer) CompleteRide(context.Context, *CompleteRideRequest) (*CompleteRideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteRide not implemented")
+}
+func (UnimplementedRideServiceServer) ArriveAtStop(context.Context, *ArriveAtStopRequest) (*ArriveAtStopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArriveAtStop not implemented")
+}
+func (UnimplementedRideServiceServer) DepartStop(context.Context, *DepartStopRequest) (*DepartStopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepartStop not implemented")
+}
+func (UnimplementedRideServiceServer) RescheduleRide(context.Context, *RescheduleRideRequest) (*RescheduleRideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleRide not implemented")
+}
 func (UnimplementedRideServiceServer) CancelRide(context.Context, *CancelRideRequest) (*CancelRideResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelRide not implemented")
 }
+func (UnimplementedRideServiceServer) DriverCancelRide(context.Context, *DriverCancelRideRequest) (*DriverCancelRideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DriverCancelRide not implemented")
+}
+func (UnimplementedRideServiceServer) OverridePickupPin(context.Context, *OverridePickupPinRequest) (*OverridePickupPinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OverridePickupPin not implemented")
+}
+func (UnimplementedRideServiceServer) RateRide(context.Context, *RateRideRequest) (*RateRideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateRide not implemented")
+}
+func (UnimplementedRideServiceServer) GetRidePayment(context.Context, *GetRidePaymentRequest) (*GetRidePaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRidePayment not implemented")
+}
+func (UnimplementedRideServiceServer) RefundRidePayment(context.Context, *RefundRidePaymentRequest) (*RefundRidePaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundRidePayment not implemented")
+}
+func (UnimplementedRideServiceServer) GetDriverEarnings(context.Context, *GetDriverEarningsRequest) (*GetDriverEarningsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDriverEarnings not implemented")
+}
+func (UnimplementedRideServiceServer) GetRide(context.Context, *GetRideRequest) (*GetRideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRide not implemented")
+}
+func (UnimplementedRideServiceServer) ListRides(context.Context, *ListRidesRequest) (*ListRidesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRides not implemented")
+}
+func (UnimplementedRideServiceServer) GetRideTimeline(context.Context, *GetRideTimelineRequest) (*GetRideTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRideTimeline not implemented")
+}
 func (UnimplementedRideServiceServer) CreateOffer(context.Context, *CreateOfferRequest) (*CreateOfferResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateOffer not implemented")
 }
@@ -207,6 +495,24 @@ func RegisterRideServiceServer(s grpc.ServiceRegistrar, srv RideServiceServer) {
 	s.RegisterService(&RideService_ServiceDesc, srv)
 }
 
+func _RideService_QuoteFare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteFareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).QuoteFare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_QuoteFare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).QuoteFare(ctx, req.(*QuoteFareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RideService_CreateRide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateRideRequest)
 	if err := dec(in); err != nil {
@@ -261,6 +567,114 @@ func _RideService_AssignDriver_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RideService_MarkDriverArrived_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkDriverArrivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).MarkDriverArrived(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_MarkDriverArrived_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).MarkDriverArrived(ctx, req.(*MarkDriverArrivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RideService_StartRide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartRideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).StartRide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_StartRide_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).StartRide(ctx, req.(*StartRideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RideService_CompleteRide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteRideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).CompleteRide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_CompleteRide_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).CompleteRide(ctx, req.(*CompleteRideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RideService_ArriveAtStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArriveAtStopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).ArriveAtStop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_ArriveAtStop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).ArriveAtStop(ctx, req.(*ArriveAtStopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RideService_DepartStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepartStopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).DepartStop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_DepartStop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).DepartStop(ctx, req.(*DepartStopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RideService_RescheduleRide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleRideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).RescheduleRide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_RescheduleRide_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).RescheduleRide(ctx, req.(*RescheduleRideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RideService_CancelRide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CancelRideRequest)
 	if err := dec(in); err != nil {
@@ -279,6 +693,168 @@ func _RideService_CancelRide_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RideService_DriverCancelRide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DriverCancelRideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).DriverCancelRide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_DriverCancelRide_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).DriverCancelRide(ctx, req.(*DriverCancelRideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RideService_OverridePickupPin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OverridePickupPinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).OverridePickupPin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_OverridePickupPin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).OverridePickupPin(ctx, req.(*OverridePickupPinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RideService_RateRide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateRideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).RateRide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_RateRide_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).RateRide(ctx, req.(*RateRideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RideService_GetRidePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRidePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).GetRidePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_GetRidePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).GetRidePayment(ctx, req.(*GetRidePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RideService_RefundRidePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundRidePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).RefundRidePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_RefundRidePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).RefundRidePayment(ctx, req.(*RefundRidePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RideService_GetDriverEarnings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDriverEarningsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).GetDriverEarnings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_GetDriverEarnings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).GetDriverEarnings(ctx, req.(*GetDriverEarningsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RideService_GetRide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).GetRide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_GetRide_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).GetRide(ctx, req.(*GetRideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RideService_ListRides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRidesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).ListRides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_ListRides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).ListRides(ctx, req.(*ListRidesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RideService_GetRideTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRideTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).GetRideTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_GetRideTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).GetRideTimeline(ctx, req.(*GetRideTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RideService_CreateOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateOfferRequest)
 	if err := dec(in); err != nil {
@@ -358,6 +934,10 @@ var RideService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "ride.v1.RideService",
 	HandlerType: (*RideServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QuoteFare",
+			Handler:    _RideService_QuoteFare_Handler,
+		},
 		{
 			MethodName: "CreateRide",
 			Handler:    _RideService_CreateRide_Handler,
@@ -370,10 +950,70 @@ var RideService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AssignDriver",
 			Handler:    _RideService_AssignDriver_Handler,
 		},
+		{
+			MethodName: "MarkDriverArrived",
+			Handler:    _RideService_MarkDriverArrived_Handler,
+		},
+		{
+			MethodName: "StartRide",
+			Handler:    _RideService_StartRide_Handler,
+		},
+		{
+			MethodName: "CompleteRide",
+			Handler:    _RideService_CompleteRide_Handler,
+		},
+		{
+			MethodName: "ArriveAtStop",
+			Handler:    _RideService_ArriveAtStop_Handler,
+		},
+		{
+			MethodName: "DepartStop",
+			Handler:    _RideService_DepartStop_Handler,
+		},
+		{
+			MethodName: "RescheduleRide",
+			Handler:    _RideService_RescheduleRide_Handler,
+		},
 		{
 			MethodName: "CancelRide",
 			Handler:    _RideService_CancelRide_Handler,
 		},
+		{
+			MethodName: "DriverCancelRide",
+			Handler:    _RideService_DriverCancelRide_Handler,
+		},
+		{
+			MethodName: "OverridePickupPin",
+			Handler:    _RideService_OverridePickupPin_Handler,
+		},
+		{
+			MethodName: "RateRide",
+			Handler:    _RideService_RateRide_Handler,
+		},
+		{
+			MethodName: "GetRidePayment",
+			Handler:    _RideService_GetRidePayment_Handler,
+		},
+		{
+			MethodName: "RefundRidePayment",
+			Handler:    _RideService_RefundRidePayment_Handler,
+		},
+		{
+			MethodName: "GetDriverEarnings",
+			Handler:    _RideService_GetDriverEarnings_Handler,
+		},
+		{
+			MethodName: "GetRide",
+			Handler:    _RideService_GetRide_Handler,
+		},
+		{
+			MethodName: "ListRides",
+			Handler:    _RideService_ListRides_Handler,
+		},
+		{
+			MethodName: "GetRideTimeline",
+			Handler:    _RideService_GetRideTimeline_Handler,
+		},
 		{
 			MethodName: "CreateOffer",
 			Handler:    _RideService_CreateOffer_Handler,
@@ -1,11 +1,14 @@
 package handlers
 
 import (
+	"strings"
+
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
 	locationv1 "github.com/daffahilmyf/ride-hailing/proto/location/v1"
 	matchingv1 "github.com/daffahilmyf/ride-hailing/proto/matching/v1"
+	ridev1 "github.com/daffahilmyf/ride-hailing/proto/ride/v1"
 	grpcadapter "github.com/daffahilmyf/ride-hailing/services/gateway/internal/adapters/grpc"
 	"github.com/daffahilmyf/ride-hailing/services/gateway/internal/app/contextdata"
 	"github.com/daffahilmyf/ride-hailing/services/gateway/internal/app/handlers/requests"
@@ -231,3 +234,63 @@ func ListNearbyDrivers(locationClient outbound.LocationService, internalToken st
 		responses.RespondOK(c, 200, map[string]any{"drivers": out})
 	}
 }
+
+func GetDriverEarnings(rideClient outbound.RideService, internalToken string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var query requests.DriverEarningsQuery
+		if !validators.BindQueryAndValidate(c, &query) {
+			responses.RespondErrorCode(c, responses.CodeValidationError, nil)
+			return
+		}
+
+		driverID := contextdata.GetUserID(c)
+		if driverID == "" {
+			responses.RespondErrorCode(c, responses.CodeUnauthorized, map[string]string{"reason": "MISSING_USER"})
+			return
+		}
+
+		ctx := grpcadapter.WithRequestMetadata(
+			c.Request.Context(),
+			contextdata.GetTraceID(c),
+			contextdata.GetRequestID(c),
+		)
+		ctx = grpcadapter.WithInternalToken(ctx, internalToken)
+		ctx = grpcadapter.WithTraceContext(ctx)
+		WithGRPCMeta(c, "ride-service")
+
+		resp, err := rideClient.GetDriverEarnings(ctx, &ridev1.GetDriverEarningsRequest{
+			DriverId:  driverID,
+			Period:    strings.ToUpper(query.Period),
+			From:      query.From,
+			To:        query.To,
+			TraceId:   contextdata.GetTraceID(c),
+			RequestId: contextdata.GetRequestID(c),
+		})
+		if err != nil {
+			code, details := responses.MapGRPCError(err)
+			responses.RespondErrorCode(c, code, details)
+			return
+		}
+
+		summaries := make([]map[string]interface{}, 0, len(resp.GetSummaries()))
+		for _, summary := range resp.GetSummaries() {
+			summaries = append(summaries, map[string]interface{}{
+				"period_start": summary.GetPeriodStart(),
+				"currency":     summary.GetCurrency(),
+				"earnings":     summary.GetEarnings(),
+				"tips":         summary.GetTips(),
+				"fees":         summary.GetFees(),
+				"payouts":      summary.GetPayouts(),
+				"net":          summary.GetNet(),
+				"rides":        summary.GetRides(),
+			})
+		}
+		responses.RespondOK(c, 200, map[string]interface{}{
+			"driver_id": resp.GetDriverId(),
+			"period":    strings.ToLower(resp.GetPeriod()),
+			"from":      resp.GetFrom(),
+			"to":        resp.GetTo(),
+			"summaries": summaries,
+		})
+	}
+}
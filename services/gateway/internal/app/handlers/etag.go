@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/daffahilmyf/ride-hailing/services/gateway/internal/app/responses"
+)
+
+// setRideETag exposes the ride version as a strong ETag so clients can send it
+// back in If-Match on their next write.
+func setRideETag(c *gin.Context, version int64) {
+	if version > 0 {
+		c.Header("ETag", strconv.Quote(strconv.FormatInt(version, 10)))
+	}
+}
+
+// ifMatchVersion reads the ride version a write is conditional on. A missing
+// header or "*" means no check (zero); anything that is not a single ride ETag
+// is rejected with a validation error and ok=false.
+func ifMatchVersion(c *gin.Context) (version int64, ok bool) {
+	value := strings.TrimSpace(c.GetHeader("If-Match"))
+	if value == "" || value == "*" {
+		return 0, true
+	}
+	value = strings.TrimPrefix(value, "W/")
+	unquoted, err := strconv.Unquote(value)
+	if err == nil {
+		version, err = strconv.ParseInt(unquoted, 10, 64)
+	}
+	if err != nil || version <= 0 {
+		responses.RespondErrorCode(c, responses.CodeValidationError, map[string]string{"field": "If-Match"})
+		return 0, false
+	}
+	return version, true
+}
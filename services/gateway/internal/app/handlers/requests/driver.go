@@ -16,3 +16,9 @@ type NearbyDriversRequest struct {
 	RadiusM float64 `json:"radius_m" binding:"required"`
 	Limit   int32   `json:"limit"`
 }
+
+type DriverEarningsQuery struct {
+	Period string `form:"period" binding:"omitempty,oneof=daily weekly"`
+	From   int64  `form:"from" binding:"omitempty,min=0"`
+	To     int64  `form:"to" binding:"omitempty,min=0"`
+}
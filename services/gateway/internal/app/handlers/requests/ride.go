@@ -1,17 +1,72 @@
 package requests
 
 type CreateRideRequest struct {
-	PickupLat  float64 `json:"pickup_lat" binding:"required"`
-	PickupLng  float64 `json:"pickup_lng" binding:"required"`
-	DropoffLat float64 `json:"dropoff_lat" binding:"required"`
-	DropoffLng float64 `json:"dropoff_lng" binding:"required"`
+	PickupLat  float64        `json:"pickup_lat" binding:"required"`
+	PickupLng  float64        `json:"pickup_lng" binding:"required"`
+	DropoffLat float64        `json:"dropoff_lat" binding:"required"`
+	DropoffLng float64        `json:"dropoff_lng" binding:"required"`
+	Product    string         `json:"product" binding:"omitempty,max=32"`
+	QuoteID    string         `json:"quote_id" binding:"omitempty,max=2048"`
+	PickupAt   int64          `json:"pickup_at" binding:"omitempty,min=0"`
+	Stops      []StopLocation `json:"stops" binding:"omitempty,max=3,dive"`
+}
+
+type StopLocation struct {
+	Lat float64 `json:"lat" binding:"required,latitude"`
+	Lng float64 `json:"lng" binding:"required,longitude"`
+}
+
+type RescheduleRideRequest struct {
+	PickupAt int64 `json:"pickup_at" binding:"required,min=1"`
+}
+
+type QuoteFareRequest struct {
+	PickupLat  float64        `json:"pickup_lat" binding:"required"`
+	PickupLng  float64        `json:"pickup_lng" binding:"required"`
+	DropoffLat float64        `json:"dropoff_lat" binding:"required"`
+	DropoffLng float64        `json:"dropoff_lng" binding:"required"`
+	Product    string         `json:"product" binding:"omitempty,max=32"`
+	Stops      []StopLocation `json:"stops" binding:"omitempty,max=3,dive"`
 }
 
 type CancelRideRequest struct {
-	Reason string `json:"reason" binding:"required"`
+	Reason       string `json:"reason" binding:"required"`
+	ReasonCode   string `json:"reason_code" binding:"omitempty,oneof=RIDER_CHANGED_MIND RIDER_DRIVER_TOO_FAR RIDER_WAIT_TOO_LONG OTHER"`
+	ConfirmToken string `json:"confirm_token" binding:"omitempty,max=2048"`
+}
+
+type DriverCancelRideRequest struct {
+	Reason     string `json:"reason" binding:"required"`
+	ReasonCode string `json:"reason_code" binding:"omitempty,oneof=DRIVER_VEHICLE_ISSUE DRIVER_RIDER_UNREACHABLE RIDER_NO_SHOW OTHER"`
+}
+
+// StartRideRequest is optional; rides assigned before pickup PINs were issued
+// start without one.
+type StartRideRequest struct {
+	PIN string `json:"pin" binding:"omitempty,numeric,min=4,max=8"`
+}
+
+type OverridePickupPINRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+type RateRideRequest struct {
+	Stars   int      `json:"stars" binding:"required,min=1,max=5"`
+	Tags    []string `json:"tags" binding:"omitempty,max=5,dive,max=32"`
+	Comment string   `json:"comment" binding:"omitempty,max=500"`
 }
 
 type CreateOfferRequest struct {
 	DriverID        string `json:"driver_id" binding:"required"`
 	OfferTTLSeconds int64  `json:"offer_ttl_seconds" binding:"omitempty,min=1"`
 }
+
+type ListRidesQuery struct {
+	RiderID     string `form:"rider_id" binding:"omitempty,uuid"`
+	DriverID    string `form:"driver_id" binding:"omitempty,uuid"`
+	Status      string `form:"status"`
+	CreatedFrom int64  `form:"created_from" binding:"omitempty,min=0"`
+	CreatedTo   int64  `form:"created_to" binding:"omitempty,min=0"`
+	Limit       int32  `form:"limit" binding:"omitempty,min=1,max=100"`
+	Cursor      string `form:"cursor"`
+}
@@ -1,6 +1,9 @@
 package handlers
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
@@ -9,6 +12,7 @@ import (
 	"github.com/daffahilmyf/ride-hailing/services/gateway/internal/app/contextdata"
 	"github.com/daffahilmyf/ride-hailing/services/gateway/internal/app/handlers/requests"
 	"github.com/daffahilmyf/ride-hailing/services/gateway/internal/app/handlers/validators"
+	"github.com/daffahilmyf/ride-hailing/services/gateway/internal/app/middleware"
 	"github.com/daffahilmyf/ride-hailing/services/gateway/internal/app/responses"
 	"github.com/daffahilmyf/ride-hailing/services/gateway/internal/ports/outbound"
 )
@@ -43,6 +47,10 @@ func CreateRide(rideClient outbound.RideService, internalToken string) gin.Handl
 			PickupLng:      req.PickupLng,
 			DropoffLat:     req.DropoffLat,
 			DropoffLng:     req.DropoffLng,
+			Product:        req.Product,
+			QuoteId:        req.QuoteID,
+			PickupAt:       req.PickupAt,
+			Stops:          toProtoLocations(req.Stops),
 			IdempotencyKey: idempotencyKey,
 			TraceId:        contextdata.GetTraceID(c),
 			RequestId:      contextdata.GetRequestID(c),
@@ -53,9 +61,141 @@ func CreateRide(rideClient outbound.RideService, internalToken string) gin.Handl
 			return
 		}
 
+		setRideETag(c, resp.GetVersion())
 		responses.RespondOK(c, 200, map[string]interface{}{
-			"ride_id": resp.GetRideId(),
-			"status":  resp.GetStatus(),
+			"ride_id":     resp.GetRideId(),
+			"status":      resp.GetStatus(),
+			"fare_amount": resp.GetFareAmount(),
+			"currency":    resp.GetCurrency(),
+			"pickup_at":   resp.GetPickupAt(),
+			"version":     resp.GetVersion(),
+		})
+	}
+}
+
+func RescheduleRide(rideClient outbound.RideService, internalToken string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req requests.RescheduleRideRequest
+		if !validators.BindAndValidate(c, &req) {
+			responses.RespondErrorCode(c, responses.CodeValidationError, nil)
+			return
+		}
+
+		rideID := c.Param("ride_id")
+		if _, err := uuid.Parse(rideID); err != nil {
+			responses.RespondErrorCode(c, responses.CodeValidationError, map[string]string{"field": "ride_id"})
+			return
+		}
+		expectedVersion, ok := ifMatchVersion(c)
+		if !ok {
+			return
+		}
+
+		userID := contextdata.GetUserID(c)
+		if userID == "" {
+			responses.RespondErrorCode(c, responses.CodeUnauthorized, map[string]string{"reason": "MISSING_USER"})
+			return
+		}
+
+		ctx := grpcadapter.WithRequestMetadata(
+			c.Request.Context(),
+			contextdata.GetTraceID(c),
+			contextdata.GetRequestID(c),
+		)
+		ctx = grpcadapter.WithInternalToken(ctx, internalToken)
+		ctx = grpcadapter.WithTraceContext(ctx)
+		WithGRPCMeta(c, "ride-service")
+
+		resp, err := rideClient.RescheduleRide(ctx, &ridev1.RescheduleRideRequest{
+			RideId:          rideID,
+			RiderId:         userID,
+			PickupAt:        req.PickupAt,
+			IdempotencyKey:  c.GetHeader("Idempotency-Key"),
+			TraceId:         contextdata.GetTraceID(c),
+			RequestId:       contextdata.GetRequestID(c),
+			ExpectedVersion: expectedVersion,
+		})
+		if err != nil {
+			code, details := responses.MapGRPCError(err)
+			responses.RespondErrorCode(c, code, details)
+			return
+		}
+
+		setRideETag(c, resp.GetVersion())
+		responses.RespondOK(c, 200, map[string]interface{}{
+			"ride_id":   resp.GetRideId(),
+			"status":    resp.GetStatus(),
+			"pickup_at": resp.GetPickupAt(),
+			"version":   resp.GetVersion(),
+		})
+	}
+}
+
+func QuoteFare(rideClient outbound.RideService, internalToken string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req requests.QuoteFareRequest
+		if !validators.BindAndValidate(c, &req) {
+			responses.RespondErrorCode(c, responses.CodeValidationError, nil)
+			return
+		}
+
+		userID := contextdata.GetUserID(c)
+		if userID == "" {
+			responses.RespondErrorCode(c, responses.CodeUnauthorized, map[string]string{"reason": "MISSING_USER"})
+			return
+		}
+
+		ctx := grpcadapter.WithRequestMetadata(
+			c.Request.Context(),
+			contextdata.GetTraceID(c),
+			contextdata.GetRequestID(c),
+		)
+		ctx = grpcadapter.WithInternalToken(ctx, internalToken)
+		ctx = grpcadapter.WithTraceContext(ctx)
+		WithGRPCMeta(c, "ride-service")
+
+		resp, err := rideClient.QuoteFare(ctx, &ridev1.QuoteFareRequest{
+			RiderId:    userID,
+			Product:    req.Product,
+			PickupLat:  req.PickupLat,
+			PickupLng:  req.PickupLng,
+			DropoffLat: req.DropoffLat,
+			DropoffLng: req.DropoffLng,
+			Stops:      toProtoLocations(req.Stops),
+			TraceId:    contextdata.GetTraceID(c),
+			RequestId:  contextdata.GetRequestID(c),
+		})
+		if err != nil {
+			code, details := responses.MapGRPCError(err)
+			responses.RespondErrorCode(c, code, details)
+			return
+		}
+
+		fare := resp.GetFare()
+		legs := make([]map[string]interface{}, 0, len(resp.GetLegs()))
+		for _, leg := range resp.GetLegs() {
+			legs = append(legs, map[string]interface{}{
+				"distance_meters":  leg.GetDistanceMeters(),
+				"duration_seconds": leg.GetDurationSeconds(),
+			})
+		}
+		responses.RespondOK(c, 200, map[string]interface{}{
+			"quote_id":   resp.GetQuoteId(),
+			"expires_at": resp.GetExpiresAt(),
+			"legs":       legs,
+			"fare": map[string]interface{}{
+				"product":          fare.GetProduct(),
+				"currency":         fare.GetCurrency(),
+				"base_fare":        fare.GetBaseFare(),
+				"distance_fare":    fare.GetDistanceFare(),
+				"time_fare":        fare.GetTimeFare(),
+				"booking_fee":      fare.GetBookingFee(),
+				"total":            fare.GetTotal(),
+				"distance_meters":  fare.GetDistanceMeters(),
+				"duration_seconds": fare.GetDurationSeconds(),
+				"surge_multiplier": fare.GetSurgeMultiplier(),
+				"surge_fare":       fare.GetSurgeFare(),
+			},
 		})
 	}
 }
@@ -73,6 +213,10 @@ func CancelRide(rideClient outbound.RideService, internalToken string) gin.Handl
 			responses.RespondErrorCode(c, responses.CodeValidationError, map[string]string{"field": "ride_id"})
 			return
 		}
+		expectedVersion, ok := ifMatchVersion(c)
+		if !ok {
+			return
+		}
 
 		ctx := grpcadapter.WithRequestMetadata(
 			c.Request.Context(),
@@ -84,8 +228,187 @@ func CancelRide(rideClient outbound.RideService, internalToken string) gin.Handl
 		WithGRPCMeta(c, "ride-service")
 
 		resp, err := rideClient.CancelRide(ctx, &ridev1.CancelRideRequest{
+			RideId:          rideID,
+			Reason:          req.Reason,
+			ReasonCode:      toProtoCancelReason(req.ReasonCode),
+			ConfirmToken:    req.ConfirmToken,
+			Actor:           "rider",
+			ActorId:         contextdata.GetUserID(c),
+			TraceId:         contextdata.GetTraceID(c),
+			RequestId:       contextdata.GetRequestID(c),
+			ExpectedVersion: expectedVersion,
+		})
+		if err != nil {
+			// A late cancellation comes back as CANCELLATION_FEE_REQUIRED with
+			// the fee and a confirm_token to resend once the rider accepts it.
+			code, details := responses.MapGRPCError(err)
+			responses.RespondErrorCode(c, code, details)
+			return
+		}
+
+		setRideETag(c, resp.GetVersion())
+		responses.RespondOK(c, 200, map[string]interface{}{
+			"ride_id":     resp.GetRideId(),
+			"status":      resp.GetStatus(),
+			"reason_code": fromProtoCancelReason(resp.GetReasonCode()),
+			"fee_amount":  resp.GetFeeAmount(),
+			"currency":    resp.GetCurrency(),
+			"version":     resp.GetVersion(),
+		})
+	}
+}
+
+// DriverCancelRide lets the assigned driver back out; the ride goes back to
+// matching instead of being cancelled for the rider.
+func DriverCancelRide(rideClient outbound.RideService, internalToken string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req requests.DriverCancelRideRequest
+		if !validators.BindAndValidate(c, &req) {
+			responses.RespondErrorCode(c, responses.CodeValidationError, nil)
+			return
+		}
+
+		rideID := c.Param("ride_id")
+		if _, err := uuid.Parse(rideID); err != nil {
+			responses.RespondErrorCode(c, responses.CodeValidationError, map[string]string{"field": "ride_id"})
+			return
+		}
+		expectedVersion, ok := ifMatchVersion(c)
+		if !ok {
+			return
+		}
+
+		driverID := contextdata.GetUserID(c)
+		if driverID == "" {
+			responses.RespondErrorCode(c, responses.CodeUnauthorized, map[string]string{"reason": "MISSING_USER"})
+			return
+		}
+
+		ctx := grpcadapter.WithRequestMetadata(
+			c.Request.Context(),
+			contextdata.GetTraceID(c),
+			contextdata.GetRequestID(c),
+		)
+		ctx = grpcadapter.WithInternalToken(ctx, internalToken)
+		ctx = grpcadapter.WithTraceContext(ctx)
+		WithGRPCMeta(c, "ride-service")
+
+		resp, err := rideClient.DriverCancelRide(ctx, &ridev1.DriverCancelRideRequest{
+			RideId:          rideID,
+			DriverId:        driverID,
+			Reason:          req.Reason,
+			ReasonCode:      toProtoCancelReason(req.ReasonCode),
+			IdempotencyKey:  c.GetHeader("Idempotency-Key"),
+			TraceId:         contextdata.GetTraceID(c),
+			RequestId:       contextdata.GetRequestID(c),
+			ExpectedVersion: expectedVersion,
+		})
+		if err != nil {
+			code, details := responses.MapGRPCError(err)
+			responses.RespondErrorCode(c, code, details)
+			return
+		}
+
+		setRideETag(c, resp.GetVersion())
+		responses.RespondOK(c, 200, map[string]interface{}{
+			"ride_id": resp.GetRideId(),
+			"status":  resp.GetStatus(),
+			"version": resp.GetVersion(),
+		})
+	}
+}
+
+// OverridePickupPIN lets support start a ride without the rider's pickup PIN,
+// for example when the PIN has locked after wrong entries. The ride service
+// records the override and reason on the ride timeline.
+func OverridePickupPIN(rideClient outbound.RideService, internalToken string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req requests.OverridePickupPINRequest
+		if !validators.BindAndValidate(c, &req) {
+			responses.RespondErrorCode(c, responses.CodeValidationError, nil)
+			return
+		}
+
+		rideID := c.Param("ride_id")
+		if _, err := uuid.Parse(rideID); err != nil {
+			responses.RespondErrorCode(c, responses.CodeValidationError, map[string]string{"field": "ride_id"})
+			return
+		}
+
+		adminID := contextdata.GetUserID(c)
+		if adminID == "" {
+			responses.RespondErrorCode(c, responses.CodeUnauthorized, map[string]string{"reason": "MISSING_USER"})
+			return
+		}
+
+		ctx := grpcadapter.WithRequestMetadata(
+			c.Request.Context(),
+			contextdata.GetTraceID(c),
+			contextdata.GetRequestID(c),
+		)
+		ctx = grpcadapter.WithInternalToken(ctx, internalToken)
+		ctx = grpcadapter.WithTraceContext(ctx)
+		WithGRPCMeta(c, "ride-service")
+
+		resp, err := rideClient.OverridePickupPin(ctx, &ridev1.OverridePickupPinRequest{
+			RideId:         rideID,
+			ActorId:        adminID,
+			Reason:         req.Reason,
+			IdempotencyKey: c.GetHeader("Idempotency-Key"),
+			TraceId:        contextdata.GetTraceID(c),
+			RequestId:      contextdata.GetRequestID(c),
+		})
+		if err != nil {
+			code, details := responses.MapGRPCError(err)
+			responses.RespondErrorCode(c, code, details)
+			return
+		}
+
+		responses.RespondOK(c, 200, map[string]interface{}{
+			"ride_id": resp.GetRideId(),
+			"status":  resp.GetStatus(),
+		})
+	}
+}
+
+// RateRide lets either party rate a completed ride; the caller's role decides
+// which side of the ride they are rating from.
+func RateRide(rideClient outbound.RideService, internalToken string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req requests.RateRideRequest
+		if !validators.BindAndValidate(c, &req) {
+			responses.RespondErrorCode(c, responses.CodeValidationError, nil)
+			return
+		}
+
+		rideID := c.Param("ride_id")
+		if _, err := uuid.Parse(rideID); err != nil {
+			responses.RespondErrorCode(c, responses.CodeValidationError, map[string]string{"field": "ride_id"})
+			return
+		}
+
+		userID := contextdata.GetUserID(c)
+		if userID == "" {
+			responses.RespondErrorCode(c, responses.CodeUnauthorized, map[string]string{"reason": "MISSING_USER"})
+			return
+		}
+
+		ctx := grpcadapter.WithRequestMetadata(
+			c.Request.Context(),
+			contextdata.GetTraceID(c),
+			contextdata.GetRequestID(c),
+		)
+		ctx = grpcadapter.WithInternalToken(ctx, internalToken)
+		ctx = grpcadapter.WithTraceContext(ctx)
+		WithGRPCMeta(c, "ride-service")
+
+		resp, err := rideClient.RateRide(ctx, &ridev1.RateRideRequest{
 			RideId:    rideID,
-			Reason:    req.Reason,
+			RaterId:   userID,
+			RaterRole: contextdata.GetRole(c),
+			Stars:     int32(req.Stars),
+			Tags:      req.Tags,
+			Comment:   req.Comment,
 			TraceId:   contextdata.GetTraceID(c),
 			RequestId: contextdata.GetRequestID(c),
 		})
@@ -95,9 +418,507 @@ func CancelRide(rideClient outbound.RideService, internalToken string) gin.Handl
 			return
 		}
 
+		tags := resp.GetTags()
+		if tags == nil {
+			tags = []string{}
+		}
+		responses.RespondOK(c, 200, map[string]interface{}{
+			"ride_id":    resp.GetRideId(),
+			"rater_role": resp.GetRaterRole(),
+			"stars":      resp.GetStars(),
+			"tags":       tags,
+			"created_at": resp.GetCreatedAt(),
+		})
+	}
+}
+
+const cancelReasonPrefix = "CANCELLATION_REASON_"
+
+func toProtoCancelReason(code string) ridev1.CancellationReason {
+	if code == "" {
+		return ridev1.CancellationReason_CANCELLATION_REASON_UNSPECIFIED
+	}
+	return ridev1.CancellationReason(ridev1.CancellationReason_value[cancelReasonPrefix+code])
+}
+
+func fromProtoCancelReason(code ridev1.CancellationReason) string {
+	if code == ridev1.CancellationReason_CANCELLATION_REASON_UNSPECIFIED {
+		return ""
+	}
+	return strings.TrimPrefix(code.String(), cancelReasonPrefix)
+}
+
+func GetRide(rideClient outbound.RideService, internalToken string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rideID := c.Param("ride_id")
+		if _, err := uuid.Parse(rideID); err != nil {
+			responses.RespondErrorCode(c, responses.CodeValidationError, map[string]string{"field": "ride_id"})
+			return
+		}
+
+		userID := contextdata.GetUserID(c)
+		if userID == "" {
+			responses.RespondErrorCode(c, responses.CodeUnauthorized, map[string]string{"reason": "MISSING_USER"})
+			return
+		}
+
+		ctx := grpcadapter.WithRequestMetadata(
+			c.Request.Context(),
+			contextdata.GetTraceID(c),
+			contextdata.GetRequestID(c),
+		)
+		ctx = grpcadapter.WithInternalToken(ctx, internalToken)
+		ctx = grpcadapter.WithTraceContext(ctx)
+		WithGRPCMeta(c, "ride-service")
+
+		resp, err := rideClient.GetRide(ctx, &ridev1.GetRideRequest{
+			RideId:    rideID,
+			TraceId:   contextdata.GetTraceID(c),
+			RequestId: contextdata.GetRequestID(c),
+		})
+		if err != nil {
+			code, details := responses.MapGRPCError(err)
+			responses.RespondErrorCode(c, code, details)
+			return
+		}
+
+		// Rides outside the caller's scope are reported as missing so ride IDs cannot be probed.
+		if !canViewRide(contextdata.GetRole(c), userID, resp.GetRide()) {
+			responses.RespondErrorCode(c, responses.CodeNotFound, nil)
+			return
+		}
+
+		setRideETag(c, resp.GetRide().GetVersion())
+		responses.RespondOK(c, 200, rideView(resp.GetRide()))
+	}
+}
+
+func GetRideTimeline(rideClient outbound.RideService, internalToken string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rideID := c.Param("ride_id")
+		if _, err := uuid.Parse(rideID); err != nil {
+			responses.RespondErrorCode(c, responses.CodeValidationError, map[string]string{"field": "ride_id"})
+			return
+		}
+
+		userID := contextdata.GetUserID(c)
+		if userID == "" {
+			responses.RespondErrorCode(c, responses.CodeUnauthorized, map[string]string{"reason": "MISSING_USER"})
+			return
+		}
+
+		ctx := grpcadapter.WithRequestMetadata(
+			c.Request.Context(),
+			contextdata.GetTraceID(c),
+			contextdata.GetRequestID(c),
+		)
+		ctx = grpcadapter.WithInternalToken(ctx, internalToken)
+		ctx = grpcadapter.WithTraceContext(ctx)
+		WithGRPCMeta(c, "ride-service")
+
+		rideResp, err := rideClient.GetRide(ctx, &ridev1.GetRideRequest{
+			RideId:    rideID,
+			TraceId:   contextdata.GetTraceID(c),
+			RequestId: contextdata.GetRequestID(c),
+		})
+		if err != nil {
+			code, details := responses.MapGRPCError(err)
+			responses.RespondErrorCode(c, code, details)
+			return
+		}
+		if !canViewRide(contextdata.GetRole(c), userID, rideResp.GetRide()) {
+			responses.RespondErrorCode(c, responses.CodeNotFound, nil)
+			return
+		}
+
+		resp, err := rideClient.GetRideTimeline(ctx, &ridev1.GetRideTimelineRequest{
+			RideId:    rideID,
+			TraceId:   contextdata.GetTraceID(c),
+			RequestId: contextdata.GetRequestID(c),
+		})
+		if err != nil {
+			code, details := responses.MapGRPCError(err)
+			responses.RespondErrorCode(c, code, details)
+			return
+		}
+
+		events := make([]map[string]interface{}, 0, len(resp.GetEvents()))
+		for _, event := range resp.GetEvents() {
+			events = append(events, map[string]interface{}{
+				"from_status": event.GetFromStatus(),
+				"to_status":   event.GetToStatus(),
+				"actor":       event.GetActor(),
+				"actor_id":    event.GetActorId(),
+				"reason":      event.GetReason(),
+				"created_at":  event.GetCreatedAt(),
+			})
+		}
 		responses.RespondOK(c, 200, map[string]interface{}{
 			"ride_id": resp.GetRideId(),
-			"status":  resp.GetStatus(),
+			"events":  events,
+		})
+	}
+}
+
+func ListRides(rideClient outbound.RideService, internalToken string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var query requests.ListRidesQuery
+		if !validators.BindQueryAndValidate(c, &query) {
+			responses.RespondErrorCode(c, responses.CodeValidationError, nil)
+			return
+		}
+
+		userID := contextdata.GetUserID(c)
+		if userID == "" {
+			responses.RespondErrorCode(c, responses.CodeUnauthorized, map[string]string{"reason": "MISSING_USER"})
+			return
+		}
+
+		req := &ridev1.ListRidesRequest{
+			RiderId:     query.RiderID,
+			DriverId:    query.DriverID,
+			Status:      query.Status,
+			CreatedFrom: query.CreatedFrom,
+			CreatedTo:   query.CreatedTo,
+			PageSize:    query.Limit,
+			Cursor:      query.Cursor,
+			TraceId:     contextdata.GetTraceID(c),
+			RequestId:   contextdata.GetRequestID(c),
+		}
+		switch contextdata.GetRole(c) {
+		case middleware.RoleAdmin:
+		case middleware.RoleRider:
+			req.RiderId = userID
+		case middleware.RoleDriver:
+			req.DriverId = userID
+		default:
+			responses.RespondErrorCode(c, responses.CodeForbidden, map[string]string{"reason": "ROLE_NOT_ALLOWED"})
+			return
+		}
+
+		ctx := grpcadapter.WithRequestMetadata(
+			c.Request.Context(),
+			contextdata.GetTraceID(c),
+			contextdata.GetRequestID(c),
+		)
+		ctx = grpcadapter.WithInternalToken(ctx, internalToken)
+		ctx = grpcadapter.WithTraceContext(ctx)
+		WithGRPCMeta(c, "ride-service")
+
+		resp, err := rideClient.ListRides(ctx, req)
+		if err != nil {
+			code, details := responses.MapGRPCError(err)
+			responses.RespondErrorCode(c, code, details)
+			return
+		}
+
+		rides := make([]map[string]interface{}, 0, len(resp.GetRides()))
+		for _, ride := range resp.GetRides() {
+			rides = append(rides, rideView(ride))
+		}
+		responses.RespondOK(c, 200, map[string]interface{}{
+			"rides":       rides,
+			"next_cursor": resp.GetNextCursor(),
+		})
+	}
+}
+
+func canViewRide(role, userID string, ride *ridev1.Ride) bool {
+	switch role {
+	case middleware.RoleAdmin:
+		return true
+	case middleware.RoleRider:
+		return ride.GetRiderId() == userID
+	case middleware.RoleDriver:
+		return ride.GetDriverId() == userID
+	default:
+		return false
+	}
+}
+
+func rideView(ride *ridev1.Ride) map[string]interface{} {
+	return map[string]interface{}{
+		"ride_id":            ride.GetRideId(),
+		"rider_id":           ride.GetRiderId(),
+		"driver_id":          ride.GetDriverId(),
+		"status":             ride.GetStatus(),
+		"pickup_lat":         ride.GetPickupLat(),
+		"pickup_lng":         ride.GetPickupLng(),
+		"dropoff_lat":        ride.GetDropoffLat(),
+		"dropoff_lng":        ride.GetDropoffLng(),
+		"product":            ride.GetProduct(),
+		"fare_amount":        ride.GetFareAmount(),
+		"currency":           ride.GetCurrency(),
+		"pickup_at":          ride.GetPickupAt(),
+		"arrived_at":         ride.GetArrivedAt(),
+		"stops":              stopViews(ride.GetStops()),
+		"created_at":         ride.GetCreatedAt(),
+		"updated_at":         ride.GetUpdatedAt(),
+		"cancellation_fee":   ride.GetCancellationFee(),
+		"cancel_reason_code": fromProtoCancelReason(ride.GetCancelReasonCode()),
+		"version":            ride.GetVersion(),
+	}
+}
+
+func stopViews(stops []*ridev1.RideStop) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(stops))
+	for _, stop := range stops {
+		out = append(out, stopView(stop))
+	}
+	return out
+}
+
+func stopView(stop *ridev1.RideStop) map[string]interface{} {
+	return map[string]interface{}{
+		"seq":         stop.GetSeq(),
+		"lat":         stop.GetLat(),
+		"lng":         stop.GetLng(),
+		"arrived_at":  stop.GetArrivedAt(),
+		"departed_at": stop.GetDepartedAt(),
+	}
+}
+
+func toProtoLocations(stops []requests.StopLocation) []*ridev1.Location {
+	out := make([]*ridev1.Location, 0, len(stops))
+	for _, stop := range stops {
+		out = append(out, &ridev1.Location{Lat: stop.Lat, Lng: stop.Lng})
+	}
+	return out
+}
+
+func StartRide(rideClient outbound.RideService, internalToken string) gin.HandlerFunc {
+	return driverRideAction(rideClient, internalToken, "start")
+}
+
+func CompleteRide(rideClient outbound.RideService, internalToken string) gin.HandlerFunc {
+	return driverRideAction(rideClient, internalToken, "complete")
+}
+
+// MarkDriverArrived is the driver's manual arrival at the pickup, for when the
+// geofence has not picked it up.
+func MarkDriverArrived(rideClient outbound.RideService, internalToken string) gin.HandlerFunc {
+	return driverRideAction(rideClient, internalToken, "arrive")
+}
+
+func driverRideAction(rideClient outbound.RideService, internalToken string, action string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rideID := c.Param("ride_id")
+		if _, err := uuid.Parse(rideID); err != nil {
+			responses.RespondErrorCode(c, responses.CodeValidationError, map[string]string{"field": "ride_id"})
+			return
+		}
+		expectedVersion, ok := ifMatchVersion(c)
+		if !ok {
+			return
+		}
+		var body requests.StartRideRequest
+		if action == "start" && c.Request.ContentLength != 0 {
+			if !validators.BindAndValidate(c, &body) {
+				responses.RespondErrorCode(c, responses.CodeValidationError, map[string]string{"field": "pin"})
+				return
+			}
+		}
+
+		driverID := contextdata.GetUserID(c)
+		if driverID == "" {
+			responses.RespondErrorCode(c, responses.CodeUnauthorized, map[string]string{"reason": "MISSING_USER"})
+			return
+		}
+
+		ctx := grpcadapter.WithRequestMetadata(
+			c.Request.Context(),
+			contextdata.GetTraceID(c),
+			contextdata.GetRequestID(c),
+		)
+		ctx = grpcadapter.WithInternalToken(ctx, internalToken)
+		ctx = grpcadapter.WithTraceContext(ctx)
+		WithGRPCMeta(c, "ride-service")
+
+		idempotencyKey := c.GetHeader("Idempotency-Key")
+		traceID := contextdata.GetTraceID(c)
+		requestID := contextdata.GetRequestID(c)
+		var err error
+		var rideIDResp string
+		var driverIDResp string
+		var statusResp string
+		var versionResp int64
+		var arrival map[string]interface{}
+		switch action {
+		case "arrive":
+			resp, callErr := rideClient.MarkDriverArrived(ctx, &ridev1.MarkDriverArrivedRequest{
+				RideId:          rideID,
+				DriverId:        driverID,
+				IdempotencyKey:  idempotencyKey,
+				TraceId:         traceID,
+				RequestId:       requestID,
+				ExpectedVersion: expectedVersion,
+			})
+			err = callErr
+			if err == nil {
+				rideIDResp = resp.GetRideId()
+				driverIDResp = resp.GetDriverId()
+				statusResp = resp.GetStatus()
+				versionResp = resp.GetVersion()
+				arrival = map[string]interface{}{
+					"arrived_at": resp.GetArrivedAt(),
+					"no_show_at": resp.GetNoShowAt(),
+				}
+			}
+		case "start":
+			resp, callErr := rideClient.StartRide(ctx, &ridev1.StartRideRequest{
+				RideId:          rideID,
+				DriverId:        driverID,
+				IdempotencyKey:  idempotencyKey,
+				TraceId:         traceID,
+				RequestId:       requestID,
+				ExpectedVersion: expectedVersion,
+				Pin:             body.PIN,
+			})
+			err = callErr
+			if err == nil {
+				rideIDResp = resp.GetRideId()
+				driverIDResp = resp.GetDriverId()
+				statusResp = resp.GetStatus()
+				versionResp = resp.GetVersion()
+			}
+		case "complete":
+			resp, callErr := rideClient.CompleteRide(ctx, &ridev1.CompleteRideRequest{
+				RideId:          rideID,
+				DriverId:        driverID,
+				IdempotencyKey:  idempotencyKey,
+				TraceId:         traceID,
+				RequestId:       requestID,
+				ExpectedVersion: expectedVersion,
+			})
+			err = callErr
+			if err == nil {
+				rideIDResp = resp.GetRideId()
+				driverIDResp = resp.GetDriverId()
+				statusResp = resp.GetStatus()
+				versionResp = resp.GetVersion()
+			}
+		default:
+			responses.RespondErrorCode(c, responses.CodeInternal, map[string]string{"reason": "INVALID_ACTION"})
+			return
+		}
+		if err != nil {
+			code, details := responses.MapGRPCError(err)
+			responses.RespondErrorCode(c, code, details)
+			return
+		}
+
+		setRideETag(c, versionResp)
+		data := map[string]interface{}{
+			"ride_id":   rideIDResp,
+			"driver_id": driverIDResp,
+			"status":    statusResp,
+			"version":   versionResp,
+		}
+		for key, value := range arrival {
+			data[key] = value
+		}
+		responses.RespondOK(c, 200, data)
+	}
+}
+
+func ArriveAtStop(rideClient outbound.RideService, internalToken string) gin.HandlerFunc {
+	return driverStopAction(rideClient, internalToken, "arrive")
+}
+
+func DepartStop(rideClient outbound.RideService, internalToken string) gin.HandlerFunc {
+	return driverStopAction(rideClient, internalToken, "depart")
+}
+
+func driverStopAction(rideClient outbound.RideService, internalToken string, action string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rideID := c.Param("ride_id")
+		if _, err := uuid.Parse(rideID); err != nil {
+			responses.RespondErrorCode(c, responses.CodeValidationError, map[string]string{"field": "ride_id"})
+			return
+		}
+		seq, err := strconv.Atoi(c.Param("seq"))
+		if err != nil || seq < 1 {
+			responses.RespondErrorCode(c, responses.CodeValidationError, map[string]string{"field": "seq"})
+			return
+		}
+		expectedVersion, ok := ifMatchVersion(c)
+		if !ok {
+			return
+		}
+
+		driverID := contextdata.GetUserID(c)
+		if driverID == "" {
+			responses.RespondErrorCode(c, responses.CodeUnauthorized, map[string]string{"reason": "MISSING_USER"})
+			return
+		}
+
+		ctx := grpcadapter.WithRequestMetadata(
+			c.Request.Context(),
+			contextdata.GetTraceID(c),
+			contextdata.GetRequestID(c),
+		)
+		ctx = grpcadapter.WithInternalToken(ctx, internalToken)
+		ctx = grpcadapter.WithTraceContext(ctx)
+		WithGRPCMeta(c, "ride-service")
+
+		idempotencyKey := c.GetHeader("Idempotency-Key")
+		traceID := contextdata.GetTraceID(c)
+		requestID := contextdata.GetRequestID(c)
+		var rideIDResp string
+		var statusResp string
+		var stopResp *ridev1.RideStop
+		var versionResp int64
+		switch action {
+		case "arrive":
+			resp, callErr := rideClient.ArriveAtStop(ctx, &ridev1.ArriveAtStopRequest{
+				RideId:          rideID,
+				DriverId:        driverID,
+				Seq:             int32(seq),
+				IdempotencyKey:  idempotencyKey,
+				TraceId:         traceID,
+				RequestId:       requestID,
+				ExpectedVersion: expectedVersion,
+			})
+			err = callErr
+			if err == nil {
+				rideIDResp = resp.GetRideId()
+				statusResp = resp.GetStatus()
+				stopResp = resp.GetStop()
+				versionResp = resp.GetVersion()
+			}
+		case "depart":
+			resp, callErr := rideClient.DepartStop(ctx, &ridev1.DepartStopRequest{
+				RideId:          rideID,
+				DriverId:        driverID,
+				Seq:             int32(seq),
+				IdempotencyKey:  idempotencyKey,
+				TraceId:         traceID,
+				RequestId:       requestID,
+				ExpectedVersion: expectedVersion,
+			})
+			err = callErr
+			if err == nil {
+				rideIDResp = resp.GetRideId()
+				statusResp = resp.GetStatus()
+				stopResp = resp.GetStop()
+				versionResp = resp.GetVersion()
+			}
+		default:
+			responses.RespondErrorCode(c, responses.CodeInternal, map[string]string{"reason": "INVALID_ACTION"})
+			return
+		}
+		if err != nil {
+			code, details := responses.MapGRPCError(err)
+			responses.RespondErrorCode(c, code, details)
+			return
+		}
+
+		setRideETag(c, versionResp)
+		responses.RespondOK(c, 200, map[string]interface{}{
+			"ride_id": rideIDResp,
+			"status":  statusResp,
+			"stop":    stopView(stopResp),
+			"version": versionResp,
 		})
 	}
 }
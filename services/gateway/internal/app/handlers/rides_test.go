@@ -3,12 +3,16 @@ package handlers
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	ridev1 "github.com/daffahilmyf/ride-hailing/proto/ride/v1"
 	"github.com/daffahilmyf/ride-hailing/services/gateway/internal/app/contextdata"
@@ -17,12 +21,26 @@ import (
 type fakeRideClient struct{}
 
 type captureRideClient struct {
-	lastCreate  *ridev1.CreateRideRequest
-	lastCancel  *ridev1.CancelRideRequest
-	lastOffer   *ridev1.CreateOfferRequest
-	lastAccept  *ridev1.AcceptOfferRequest
-	lastDecline *ridev1.DeclineOfferRequest
-	lastExpire  *ridev1.ExpireOfferRequest
+	lastCreate    *ridev1.CreateRideRequest
+	lastQuote     *ridev1.QuoteFareRequest
+	lastCancel    *ridev1.CancelRideRequest
+	lastDrvCancel *ridev1.DriverCancelRideRequest
+	lastOverride  *ridev1.OverridePickupPinRequest
+	lastRate      *ridev1.RateRideRequest
+	lastEarnings  *ridev1.GetDriverEarningsRequest
+	lastResched   *ridev1.RescheduleRideRequest
+	lastArrived   *ridev1.MarkDriverArrivedRequest
+	lastStart     *ridev1.StartRideRequest
+	lastDone      *ridev1.CompleteRideRequest
+	lastArrive    *ridev1.ArriveAtStopRequest
+	lastDepart    *ridev1.DepartStopRequest
+	lastList      *ridev1.ListRidesRequest
+	ride          *ridev1.Ride
+	lastOffer     *ridev1.CreateOfferRequest
+	lastAccept    *ridev1.AcceptOfferRequest
+	lastDecline   *ridev1.DeclineOfferRequest
+	lastExpire    *ridev1.ExpireOfferRequest
+	cancelErr     error
 }
 
 func (f *captureRideClient) CreateRide(ctx context.Context, in *ridev1.CreateRideRequest, opts ...grpc.CallOption) (*ridev1.CreateRideResponse, error) {
@@ -30,9 +48,92 @@ func (f *captureRideClient) CreateRide(ctx context.Context, in *ridev1.CreateRid
 	return &ridev1.CreateRideResponse{RideId: "r1", Status: "MATCHING"}, nil
 }
 
+func (f *captureRideClient) QuoteFare(ctx context.Context, in *ridev1.QuoteFareRequest, opts ...grpc.CallOption) (*ridev1.QuoteFareResponse, error) {
+	f.lastQuote = in
+	return &ridev1.QuoteFareResponse{QuoteId: "q1", Fare: &ridev1.FareBreakdown{Product: "standard", Currency: "IDR", Total: 25000}, ExpiresAt: 1}, nil
+}
+
 func (f *captureRideClient) CancelRide(ctx context.Context, in *ridev1.CancelRideRequest, opts ...grpc.CallOption) (*ridev1.CancelRideResponse, error) {
 	f.lastCancel = in
-	return &ridev1.CancelRideResponse{RideId: in.RideId, Status: "CANCELLED"}, nil
+	if f.cancelErr != nil {
+		return nil, f.cancelErr
+	}
+	return &ridev1.CancelRideResponse{RideId: in.RideId, Status: "CANCELLED", ReasonCode: in.ReasonCode}, nil
+}
+
+func (f *captureRideClient) DriverCancelRide(ctx context.Context, in *ridev1.DriverCancelRideRequest, opts ...grpc.CallOption) (*ridev1.DriverCancelRideResponse, error) {
+	f.lastDrvCancel = in
+	return &ridev1.DriverCancelRideResponse{RideId: in.RideId, Status: "MATCHING"}, nil
+}
+
+func (f *captureRideClient) OverridePickupPin(ctx context.Context, in *ridev1.OverridePickupPinRequest, opts ...grpc.CallOption) (*ridev1.OverridePickupPinResponse, error) {
+	f.lastOverride = in
+	return &ridev1.OverridePickupPinResponse{RideId: in.RideId, Status: "DRIVER_ASSIGNED"}, nil
+}
+
+func (f *captureRideClient) RateRide(ctx context.Context, in *ridev1.RateRideRequest, opts ...grpc.CallOption) (*ridev1.RateRideResponse, error) {
+	f.lastRate = in
+	return &ridev1.RateRideResponse{RideId: in.RideId, RaterRole: in.RaterRole, Stars: in.Stars, Tags: in.Tags, CreatedAt: 1}, nil
+}
+
+func (f *captureRideClient) GetDriverEarnings(ctx context.Context, in *ridev1.GetDriverEarningsRequest, opts ...grpc.CallOption) (*ridev1.GetDriverEarningsResponse, error) {
+	f.lastEarnings = in
+	return &ridev1.GetDriverEarningsResponse{
+		DriverId:  in.DriverId,
+		Period:    "WEEKLY",
+		From:      in.From,
+		To:        in.To,
+		Summaries: []*ridev1.EarningsSummary{{PeriodStart: in.From, Currency: "IDR", Earnings: 80000, Tips: 5000, Net: 85000, Rides: 4}},
+	}, nil
+}
+
+func (f *captureRideClient) RescheduleRide(ctx context.Context, in *ridev1.RescheduleRideRequest, opts ...grpc.CallOption) (*ridev1.RescheduleRideResponse, error) {
+	f.lastResched = in
+	return &ridev1.RescheduleRideResponse{RideId: in.RideId, Status: "SCHEDULED", PickupAt: in.PickupAt}, nil
+}
+
+func (f *captureRideClient) StartRide(ctx context.Context, in *ridev1.StartRideRequest, opts ...grpc.CallOption) (*ridev1.StartRideResponse, error) {
+	f.lastStart = in
+	return &ridev1.StartRideResponse{RideId: in.RideId, DriverId: in.DriverId, Status: "IN_PROGRESS", Version: in.ExpectedVersion + 1}, nil
+}
+
+func (f *captureRideClient) MarkDriverArrived(ctx context.Context, in *ridev1.MarkDriverArrivedRequest, opts ...grpc.CallOption) (*ridev1.MarkDriverArrivedResponse, error) {
+	f.lastArrived = in
+	return &ridev1.MarkDriverArrivedResponse{RideId: in.RideId, DriverId: in.DriverId, Status: "DRIVER_ARRIVED", Version: in.ExpectedVersion + 1, ArrivedAt: 1767268800, NoShowAt: 1767269100}, nil
+}
+
+func (f *captureRideClient) CompleteRide(ctx context.Context, in *ridev1.CompleteRideRequest, opts ...grpc.CallOption) (*ridev1.CompleteRideResponse, error) {
+	f.lastDone = in
+	return &ridev1.CompleteRideResponse{RideId: in.RideId, DriverId: in.DriverId, Status: "COMPLETED"}, nil
+}
+
+func (f *captureRideClient) ArriveAtStop(ctx context.Context, in *ridev1.ArriveAtStopRequest, opts ...grpc.CallOption) (*ridev1.ArriveAtStopResponse, error) {
+	f.lastArrive = in
+	return &ridev1.ArriveAtStopResponse{RideId: in.RideId, Status: "IN_PROGRESS", Stop: &ridev1.RideStop{Seq: in.Seq, ArrivedAt: 1}}, nil
+}
+
+func (f *captureRideClient) DepartStop(ctx context.Context, in *ridev1.DepartStopRequest, opts ...grpc.CallOption) (*ridev1.DepartStopResponse, error) {
+	f.lastDepart = in
+	return &ridev1.DepartStopResponse{RideId: in.RideId, Status: "IN_PROGRESS", Stop: &ridev1.RideStop{Seq: in.Seq, ArrivedAt: 1, DepartedAt: 2}}, nil
+}
+
+func (f *captureRideClient) GetRide(ctx context.Context, in *ridev1.GetRideRequest, opts ...grpc.CallOption) (*ridev1.GetRideResponse, error) {
+	if f.ride != nil {
+		return &ridev1.GetRideResponse{Ride: f.ride}, nil
+	}
+	return &ridev1.GetRideResponse{Ride: &ridev1.Ride{RideId: in.RideId, RiderId: "11111111-1111-1111-1111-111111111111", Status: "REQUESTED"}}, nil
+}
+
+func (f *captureRideClient) GetRideTimeline(ctx context.Context, in *ridev1.GetRideTimelineRequest, opts ...grpc.CallOption) (*ridev1.GetRideTimelineResponse, error) {
+	return &ridev1.GetRideTimelineResponse{RideId: in.RideId, Events: []*ridev1.RideTimelineEvent{
+		{ToStatus: "REQUESTED", Actor: "rider"},
+		{FromStatus: "REQUESTED", ToStatus: "CANCELLED", Actor: "rider", Reason: "changed_mind"},
+	}}, nil
+}
+
+func (f *captureRideClient) ListRides(ctx context.Context, in *ridev1.ListRidesRequest, opts ...grpc.CallOption) (*ridev1.ListRidesResponse, error) {
+	f.lastList = in
+	return &ridev1.ListRidesResponse{Rides: []*ridev1.Ride{{RideId: "r1", Status: "COMPLETED"}}, NextCursor: "next"}, nil
 }
 
 func (f *captureRideClient) CreateOffer(ctx context.Context, in *ridev1.CreateOfferRequest, opts ...grpc.CallOption) (*ridev1.CreateOfferResponse, error) {
@@ -74,8 +175,19 @@ func setupRideRouter(client *captureRideClient, withUser bool) *gin.Engine {
 		})
 	}
 	r.POST("/rides", CreateRide(client, ""))
+	r.POST("/rides/quote", QuoteFare(client, ""))
 	r.POST("/rides/:ride_id/cancel", CancelRide(client, ""))
+	r.PATCH("/rides/:ride_id/schedule", RescheduleRide(client, ""))
 	r.POST("/rides/:ride_id/offers", CreateOffer(client, ""))
+	r.POST("/rides/:ride_id/arrive", MarkDriverArrived(client, ""))
+	r.POST("/rides/:ride_id/start", StartRide(client, ""))
+	r.POST("/rides/:ride_id/complete", CompleteRide(client, ""))
+	r.POST("/rides/:ride_id/driver-cancel", DriverCancelRide(client, ""))
+	r.POST("/rides/:ride_id/rating", RateRide(client, ""))
+	r.POST("/admin/rides/:ride_id/pickup-pin/override", OverridePickupPIN(client, ""))
+	r.POST("/rides/:ride_id/stops/:seq/arrive", ArriveAtStop(client, ""))
+	r.POST("/rides/:ride_id/stops/:seq/depart", DepartStop(client, ""))
+	r.GET("/drivers/me/earnings", GetDriverEarnings(client, ""))
 	r.POST("/offers/:offer_id/accept", AcceptOffer(client, ""))
 	r.POST("/offers/:offer_id/decline", DeclineOffer(client, ""))
 	r.POST("/offers/:offer_id/expire", ExpireOffer(client, ""))
@@ -158,6 +270,7 @@ func TestCancelRideValidation(t *testing.T) {
 	}{
 		{"bad_id", "/rides/123/cancel", `{"reason":"r"}`, http.StatusBadRequest},
 		{"missing_reason", "/rides/11111111-1111-1111-1111-111111111111/cancel", `{"reason":""}`, http.StatusBadRequest},
+		{"driver_reason_code", "/rides/11111111-1111-1111-1111-111111111111/cancel", `{"reason":"r","reason_code":"DRIVER_VEHICLE_ISSUE"}`, http.StatusBadRequest},
 		{"ok", "/rides/11111111-1111-1111-1111-111111111111/cancel", `{"reason":"r"}`, http.StatusOK},
 	}
 
@@ -174,6 +287,57 @@ func TestCancelRideValidation(t *testing.T) {
 			}
 		})
 	}
+	if client.lastCancel.GetActor() != "rider" || client.lastCancel.GetActorId() != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("expected caller recorded as cancelling rider, got %q/%q", client.lastCancel.GetActor(), client.lastCancel.GetActorId())
+	}
+}
+
+func TestCancelRideFeeConfirmation(t *testing.T) {
+	st, _ := status.New(codes.FailedPrecondition, "cancellation fee required").WithDetails(&errdetails.ErrorInfo{
+		Reason:   "CANCELLATION_FEE_REQUIRED",
+		Metadata: map[string]string{"fee_amount": "5000", "currency": "IDR", "confirm_token": "tok", "expires_at": "1"},
+	})
+	client := &captureRideClient{cancelErr: st.Err()}
+	r := setupRideRouter(client, true)
+	path := "/rides/11111111-1111-1111-1111-111111111111/cancel"
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", path, bytes.NewBufferString(`{"reason":"plans changed","reason_code":"RIDER_CHANGED_MIND"}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusConflict {
+		t.Fatalf("expected 409, got %d", w.Code)
+	}
+	var body struct {
+		Error struct {
+			Code    string `json:"code"`
+			Details struct {
+				FeeAmount    int64  `json:"fee_amount"`
+				ConfirmToken string `json:"confirm_token"`
+			} `json:"details"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	if body.Error.Code != "CANCELLATION_FEE_REQUIRED" || body.Error.Details.FeeAmount != 5000 || body.Error.Details.ConfirmToken != "tok" {
+		t.Fatalf("unexpected fee error body: %s", w.Body.String())
+	}
+	if client.lastCancel.GetReasonCode() != ridev1.CancellationReason_CANCELLATION_REASON_RIDER_CHANGED_MIND {
+		t.Fatalf("expected reason code forwarded, got %v", client.lastCancel.GetReasonCode())
+	}
+
+	client.cancelErr = nil
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", path, bytes.NewBufferString(`{"reason":"plans changed","reason_code":"RIDER_CHANGED_MIND","confirm_token":"tok"}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	if client.lastCancel.GetConfirmToken() != "tok" {
+		t.Fatalf("expected confirm token forwarded, got %q", client.lastCancel.GetConfirmToken())
+	}
 }
 
 func TestCreateOfferValidation(t *testing.T) {
@@ -228,3 +392,563 @@ func TestOfferActionValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestDriverRideActionValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		withUser bool
+		status   int
+	}{
+		{"bad_id", "/rides/123/start", true, http.StatusBadRequest},
+		{"missing_user", "/rides/11111111-1111-1111-1111-111111111111/start", false, http.StatusUnauthorized},
+		{"start_ok", "/rides/11111111-1111-1111-1111-111111111111/start", true, http.StatusOK},
+		{"complete_ok", "/rides/11111111-1111-1111-1111-111111111111/complete", true, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &captureRideClient{}
+			r := setupRideRouter(client, tt.withUser)
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", tt.path, nil)
+			r.ServeHTTP(w, req)
+			if w.Code != tt.status {
+				t.Fatalf("expected %d, got %d", tt.status, w.Code)
+			}
+		})
+	}
+}
+
+func TestStartRidePassesCallerAsDriver(t *testing.T) {
+	client := &captureRideClient{}
+	r := setupRideRouter(client, true)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/rides/22222222-2222-2222-2222-222222222222/start", nil)
+	req.Header.Set("Idempotency-Key", "idem-1")
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected %d, got %d", http.StatusOK, w.Code)
+	}
+	if client.lastStart == nil {
+		t.Fatalf("expected start request")
+	}
+	if client.lastStart.GetDriverId() != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("expected caller as driver id, got %q", client.lastStart.GetDriverId())
+	}
+	if client.lastStart.GetIdempotencyKey() != "idem-1" {
+		t.Fatalf("expected idempotency key")
+	}
+}
+
+func TestStartRideIfMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		ifMatch  string
+		status   int
+		expected int64
+		etag     string
+	}{
+		{"none", "", http.StatusOK, 0, `"1"`},
+		{"any", "*", http.StatusOK, 0, `"1"`},
+		{"strong", `"4"`, http.StatusOK, 4, `"5"`},
+		{"weak", `W/"4"`, http.StatusOK, 4, `"5"`},
+		{"unquoted", "4", http.StatusBadRequest, 0, ""},
+		{"not_a_version", `"abc"`, http.StatusBadRequest, 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &captureRideClient{}
+			r := setupRideRouter(client, true)
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/rides/22222222-2222-2222-2222-222222222222/start", nil)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			r.ServeHTTP(w, req)
+			if w.Code != tt.status {
+				t.Fatalf("expected %d, got %d", tt.status, w.Code)
+			}
+			if tt.status != http.StatusOK {
+				if client.lastStart != nil {
+					t.Fatalf("expected no upstream call")
+				}
+				return
+			}
+			if client.lastStart.GetExpectedVersion() != tt.expected {
+				t.Fatalf("expected version %d, got %d", tt.expected, client.lastStart.GetExpectedVersion())
+			}
+			if got := w.Header().Get("ETag"); got != tt.etag {
+				t.Fatalf("expected ETag %s, got %s", tt.etag, got)
+			}
+		})
+	}
+}
+
+func TestStartRideForwardsPickupPIN(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		status int
+		pin    string
+	}{
+		{"pin", `{"pin":"0427"}`, http.StatusOK, "0427"},
+		{"empty_body", "", http.StatusOK, ""},
+		{"not_digits", `{"pin":"12ab"}`, http.StatusBadRequest, ""},
+		{"too_short", `{"pin":"12"}`, http.StatusBadRequest, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &captureRideClient{}
+			r := setupRideRouter(client, true)
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/rides/22222222-2222-2222-2222-222222222222/start", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			r.ServeHTTP(w, req)
+			if w.Code != tt.status {
+				t.Fatalf("expected %d, got %d", tt.status, w.Code)
+			}
+			if tt.status != http.StatusOK {
+				if client.lastStart != nil {
+					t.Fatalf("expected no upstream call")
+				}
+				return
+			}
+			if client.lastStart.GetPin() != tt.pin {
+				t.Fatalf("expected pin %q, got %q", tt.pin, client.lastStart.GetPin())
+			}
+		})
+	}
+}
+
+func TestMarkDriverArrived(t *testing.T) {
+	client := &captureRideClient{}
+	r := setupRideRouter(client, true)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/rides/22222222-2222-2222-2222-222222222222/arrive", nil)
+	req.Header.Set("If-Match", `"3"`)
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	got := client.lastArrived
+	if got == nil || got.GetDriverId() != "11111111-1111-1111-1111-111111111111" || got.GetExpectedVersion() != 3 {
+		t.Fatalf("expected caller and version forwarded, got %v", got)
+	}
+	if etag := w.Header().Get("ETag"); etag != `"4"` {
+		t.Fatalf("expected ETag \"4\", got %q", etag)
+	}
+	var body struct {
+		Data struct {
+			Status   string `json:"status"`
+			NoShowAt int64  `json:"no_show_at"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if body.Data.Status != "DRIVER_ARRIVED" || body.Data.NoShowAt != 1767269100 {
+		t.Fatalf("expected arrival and no-show time, got %s", w.Body.String())
+	}
+}
+
+func TestOverridePickupPINPassesCallerAndReason(t *testing.T) {
+	client := &captureRideClient{}
+	r := setupRideRouter(client, true)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/admin/rides/22222222-2222-2222-2222-222222222222/pickup-pin/override", bytes.NewBufferString(`{"reason":"rider phone dead"}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected %d, got %d", http.StatusOK, w.Code)
+	}
+	if client.lastOverride.GetActorId() != "11111111-1111-1111-1111-111111111111" || client.lastOverride.GetReason() != "rider phone dead" {
+		t.Fatalf("unexpected override request: %+v", client.lastOverride)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/admin/rides/22222222-2222-2222-2222-222222222222/pickup-pin/override", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected a missing reason to be rejected, got %d", w.Code)
+	}
+}
+
+func setupRideReadRouter(client *captureRideClient, userID, role string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		contextdata.SetUserContext(c, userID, role)
+		c.Next()
+	})
+	r.GET("/rides", ListRides(client, ""))
+	r.GET("/rides/:ride_id", GetRide(client, ""))
+	r.GET("/rides/:ride_id/timeline", GetRideTimeline(client, ""))
+	return r
+}
+
+func TestGetRideScopedByRole(t *testing.T) {
+	const (
+		riderID  = "11111111-1111-1111-1111-111111111111"
+		driverID = "22222222-2222-2222-2222-222222222222"
+		otherID  = "33333333-3333-3333-3333-333333333333"
+	)
+	ride := &ridev1.Ride{RideId: "44444444-4444-4444-4444-444444444444", RiderId: riderID, DriverId: driverID, Status: "IN_PROGRESS"}
+	tests := []struct {
+		name   string
+		path   string
+		userID string
+		role   string
+		status int
+	}{
+		{"bad_id", "/rides/123", riderID, "rider", http.StatusBadRequest},
+		{"own_rider", "/rides/" + ride.RideId, riderID, "rider", http.StatusOK},
+		{"other_rider", "/rides/" + ride.RideId, otherID, "rider", http.StatusNotFound},
+		{"assigned_driver", "/rides/" + ride.RideId, driverID, "driver", http.StatusOK},
+		{"other_driver", "/rides/" + ride.RideId, otherID, "driver", http.StatusNotFound},
+		{"admin", "/rides/" + ride.RideId, otherID, "admin", http.StatusOK},
+		{"timeline_own_rider", "/rides/" + ride.RideId + "/timeline", riderID, "rider", http.StatusOK},
+		{"timeline_other_rider", "/rides/" + ride.RideId + "/timeline", otherID, "rider", http.StatusNotFound},
+		{"timeline_assigned_driver", "/rides/" + ride.RideId + "/timeline", driverID, "driver", http.StatusOK},
+		{"timeline_bad_id", "/rides/123/timeline", riderID, "rider", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := setupRideReadRouter(&captureRideClient{ride: ride}, tt.userID, tt.role)
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			r.ServeHTTP(w, req)
+			if w.Code != tt.status {
+				t.Fatalf("expected %d, got %d", tt.status, w.Code)
+			}
+		})
+	}
+}
+
+func TestListRidesScopedByRole(t *testing.T) {
+	const (
+		userID  = "11111111-1111-1111-1111-111111111111"
+		otherID = "33333333-3333-3333-3333-333333333333"
+	)
+	tests := []struct {
+		name      string
+		role      string
+		query     string
+		status    int
+		wantRider string
+		wantDrv   string
+	}{
+		{"rider_forced_to_self", "rider", "?rider_id=" + otherID, http.StatusOK, userID, ""},
+		{"driver_forced_to_self", "driver", "", http.StatusOK, "", userID},
+		{"admin_filters", "admin", "?rider_id=" + otherID + "&status=COMPLETED", http.StatusOK, otherID, ""},
+		{"bad_limit", "rider", "?limit=1000", http.StatusBadRequest, "", ""},
+		{"bad_rider_id", "admin", "?rider_id=bad", http.StatusBadRequest, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &captureRideClient{}
+			r := setupRideReadRouter(client, userID, tt.role)
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/rides"+tt.query, nil)
+			r.ServeHTTP(w, req)
+			if w.Code != tt.status {
+				t.Fatalf("expected %d, got %d", tt.status, w.Code)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			if client.lastList.GetRiderId() != tt.wantRider {
+				t.Fatalf("expected rider filter %q, got %q", tt.wantRider, client.lastList.GetRiderId())
+			}
+			if client.lastList.GetDriverId() != tt.wantDrv {
+				t.Fatalf("expected driver filter %q, got %q", tt.wantDrv, client.lastList.GetDriverId())
+			}
+		})
+	}
+}
+
+func TestQuoteFare(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		status int
+	}{
+		{"missing_fields", `{"pickup_lat":1}`, http.StatusBadRequest},
+		{"ok", `{"pickup_lat":1,"pickup_lng":2,"dropoff_lat":3,"dropoff_lng":4,"product":"standard"}`, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &captureRideClient{}
+			r := setupRideRouter(client, true)
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/rides/quote", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			r.ServeHTTP(w, req)
+			if w.Code != tt.status {
+				t.Fatalf("expected %d, got %d", tt.status, w.Code)
+			}
+			if tt.status == http.StatusOK && client.lastQuote.GetRiderId() != "11111111-1111-1111-1111-111111111111" {
+				t.Fatalf("expected caller as rider id")
+			}
+		})
+	}
+}
+
+func TestCreateRidePassesQuote(t *testing.T) {
+	client := &captureRideClient{}
+	r := setupRideRouter(client, true)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/rides", bytes.NewBufferString(`{"pickup_lat":1,"pickup_lng":2,"dropoff_lat":3,"dropoff_lng":4,"quote_id":"q1"}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected %d, got %d", http.StatusOK, w.Code)
+	}
+	if client.lastCreate.GetQuoteId() != "q1" {
+		t.Fatalf("expected quote id forwarded")
+	}
+}
+
+func TestCreateScheduledRide(t *testing.T) {
+	client := &captureRideClient{}
+	r := setupRideRouter(client, true)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/rides", bytes.NewBufferString(`{"pickup_lat":1,"pickup_lng":2,"dropoff_lat":3,"dropoff_lng":4,"pickup_at":1767261600}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected %d, got %d", http.StatusOK, w.Code)
+	}
+	if client.lastCreate.GetPickupAt() != 1767261600 {
+		t.Fatalf("expected pickup_at forwarded, got %d", client.lastCreate.GetPickupAt())
+	}
+}
+
+func TestRescheduleRideValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   string
+		body   string
+		status int
+	}{
+		{"bad_id", "/rides/123/schedule", `{"pickup_at":1767261600}`, http.StatusBadRequest},
+		{"missing_pickup_at", "/rides/11111111-1111-1111-1111-111111111111/schedule", `{}`, http.StatusBadRequest},
+		{"ok", "/rides/11111111-1111-1111-1111-111111111111/schedule", `{"pickup_at":1767261600}`, http.StatusOK},
+	}
+
+	client := &captureRideClient{}
+	r := setupRideRouter(client, true)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("PATCH", tt.path, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			r.ServeHTTP(w, req)
+			if w.Code != tt.status {
+				t.Fatalf("expected %d, got %d", tt.status, w.Code)
+			}
+		})
+	}
+	if client.lastResched.GetRiderId() != "11111111-1111-1111-1111-111111111111" || client.lastResched.GetPickupAt() != 1767261600 {
+		t.Fatalf("expected caller and pickup forwarded, got %q/%d", client.lastResched.GetRiderId(), client.lastResched.GetPickupAt())
+	}
+}
+
+func TestCreateRideForwardsStops(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		status int
+	}{
+		{"too_many", `{"pickup_lat":1,"pickup_lng":2,"dropoff_lat":3,"dropoff_lng":4,"stops":[{"lat":1,"lng":1},{"lat":2,"lng":2},{"lat":3,"lng":3},{"lat":4,"lng":4}]}`, http.StatusBadRequest},
+		{"bad_stop", `{"pickup_lat":1,"pickup_lng":2,"dropoff_lat":3,"dropoff_lng":4,"stops":[{"lat":95,"lng":1}]}`, http.StatusBadRequest},
+		{"ok", `{"pickup_lat":1,"pickup_lng":2,"dropoff_lat":3,"dropoff_lng":4,"stops":[{"lat":1.5,"lng":2.5},{"lat":2,"lng":3}]}`, http.StatusOK},
+	}
+
+	client := &captureRideClient{}
+	r := setupRideRouter(client, true)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/rides", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			r.ServeHTTP(w, req)
+			if w.Code != tt.status {
+				t.Fatalf("expected %d, got %d", tt.status, w.Code)
+			}
+		})
+	}
+	stops := client.lastCreate.GetStops()
+	if len(stops) != 2 || stops[1].GetLat() != 2 || stops[1].GetLng() != 3 {
+		t.Fatalf("expected stops forwarded in order, got %v", stops)
+	}
+}
+
+func TestDriverStopActionValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   string
+		status int
+	}{
+		{"bad_id", "/rides/123/stops/1/arrive", http.StatusBadRequest},
+		{"bad_seq", "/rides/11111111-1111-1111-1111-111111111111/stops/x/arrive", http.StatusBadRequest},
+		{"zero_seq", "/rides/11111111-1111-1111-1111-111111111111/stops/0/depart", http.StatusBadRequest},
+		{"arrive_ok", "/rides/11111111-1111-1111-1111-111111111111/stops/2/arrive", http.StatusOK},
+		{"depart_ok", "/rides/11111111-1111-1111-1111-111111111111/stops/2/depart", http.StatusOK},
+	}
+
+	client := &captureRideClient{}
+	r := setupRideRouter(client, true)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", tt.path, nil)
+			r.ServeHTTP(w, req)
+			if w.Code != tt.status {
+				t.Fatalf("expected %d, got %d", tt.status, w.Code)
+			}
+		})
+	}
+	if client.lastArrive.GetSeq() != 2 || client.lastDepart.GetDriverId() != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("expected seq and caller forwarded, got %v / %v", client.lastArrive, client.lastDepart)
+	}
+}
+
+func TestDriverCancelRide(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		body     string
+		withUser bool
+		status   int
+	}{
+		{"bad_id", "/rides/123/driver-cancel", `{"reason":"flat_tire"}`, true, http.StatusBadRequest},
+		{"missing_reason", "/rides/11111111-1111-1111-1111-111111111111/driver-cancel", `{}`, true, http.StatusBadRequest},
+		{"rider_reason_code", "/rides/11111111-1111-1111-1111-111111111111/driver-cancel", `{"reason":"x","reason_code":"RIDER_CHANGED_MIND"}`, true, http.StatusBadRequest},
+		{"rider_no_show", "/rides/11111111-1111-1111-1111-111111111111/driver-cancel", `{"reason":"flat_tire","reason_code":"RIDER_NO_SHOW"}`, true, http.StatusOK},
+		{"missing_user", "/rides/11111111-1111-1111-1111-111111111111/driver-cancel", `{"reason":"flat_tire"}`, false, http.StatusUnauthorized},
+		{"ok", "/rides/11111111-1111-1111-1111-111111111111/driver-cancel", `{"reason":"flat_tire"}`, true, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &captureRideClient{}
+			r := setupRideRouter(client, tt.withUser)
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", tt.path, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Idempotency-Key", "idem-1")
+			r.ServeHTTP(w, req)
+			if w.Code != tt.status {
+				t.Fatalf("expected %d, got %d", tt.status, w.Code)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			got := client.lastDrvCancel
+			if got.GetDriverId() != "11111111-1111-1111-1111-111111111111" || got.GetReason() != "flat_tire" || got.GetIdempotencyKey() != "idem-1" {
+				t.Fatalf("expected caller, reason and key forwarded, got %v", got)
+			}
+		})
+	}
+}
+
+func TestRateRide(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		body     string
+		withUser bool
+		status   int
+	}{
+		{"bad_id", "/rides/123/rating", `{"stars":5}`, true, http.StatusBadRequest},
+		{"missing_stars", "/rides/11111111-1111-1111-1111-111111111111/rating", `{}`, true, http.StatusBadRequest},
+		{"too_many_stars", "/rides/11111111-1111-1111-1111-111111111111/rating", `{"stars":6}`, true, http.StatusBadRequest},
+		{"too_many_tags", "/rides/11111111-1111-1111-1111-111111111111/rating", `{"stars":4,"tags":["a","b","c","d","e","f"]}`, true, http.StatusBadRequest},
+		{"missing_user", "/rides/11111111-1111-1111-1111-111111111111/rating", `{"stars":5}`, false, http.StatusUnauthorized},
+		{"ok", "/rides/11111111-1111-1111-1111-111111111111/rating", `{"stars":4,"tags":["friendly"],"comment":"thanks"}`, true, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &captureRideClient{}
+			r := setupRideRouter(client, tt.withUser)
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", tt.path, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			r.ServeHTTP(w, req)
+			if w.Code != tt.status {
+				t.Fatalf("expected %d, got %d", tt.status, w.Code)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			got := client.lastRate
+			if got.GetRaterId() != "11111111-1111-1111-1111-111111111111" || got.GetRaterRole() != "rider" || got.GetStars() != 4 || got.GetComment() != "thanks" {
+				t.Fatalf("expected caller, role and rating forwarded, got %v", got)
+			}
+		})
+	}
+}
+
+func TestGetDriverEarnings(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		withUser bool
+		status   int
+	}{
+		{"bad_period", "/drivers/me/earnings?period=monthly", true, http.StatusBadRequest},
+		{"bad_from", "/drivers/me/earnings?from=-1", true, http.StatusBadRequest},
+		{"missing_user", "/drivers/me/earnings", false, http.StatusUnauthorized},
+		{"ok", "/drivers/me/earnings?period=weekly&from=1767225600&to=1769644800", true, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &captureRideClient{}
+			r := setupRideRouter(client, tt.withUser)
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			r.ServeHTTP(w, req)
+			if w.Code != tt.status {
+				t.Fatalf("expected %d, got %d", tt.status, w.Code)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			got := client.lastEarnings
+			if got.GetDriverId() != "11111111-1111-1111-1111-111111111111" || got.GetPeriod() != "WEEKLY" || got.GetFrom() != 1767225600 {
+				t.Fatalf("expected caller and window forwarded, got %v", got)
+			}
+			var body struct {
+				Data struct {
+					Period    string `json:"period"`
+					Summaries []struct {
+						Net int64 `json:"net"`
+					} `json:"summaries"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if body.Data.Period != "weekly" || len(body.Data.Summaries) != 1 || body.Data.Summaries[0].Net != 85000 {
+				t.Fatalf("unexpected earnings body: %s", w.Body.String())
+			}
+		})
+	}
+}
@@ -17,3 +17,14 @@ func BindAndValidate(c *gin.Context, dst interface{}) bool {
 	}
 	return true
 }
+
+func BindQueryAndValidate(c *gin.Context, dst interface{}) bool {
+	validate.SetTagName("binding")
+	if err := c.ShouldBindQuery(dst); err != nil {
+		return false
+	}
+	if err := validate.Struct(dst); err != nil {
+		return false
+	}
+	return true
+}
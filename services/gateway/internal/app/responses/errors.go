@@ -8,13 +8,20 @@ const (
 	CodeValidationError ErrorCode = "VALIDATION_ERROR"
 	CodeConflict        ErrorCode = "CONFLICT"
 	CodeOfferExpired    ErrorCode = "OFFER_EXPIRED"
+	CodeQuoteExpired    ErrorCode = "QUOTE_EXPIRED"
 	CodeRideNotActive   ErrorCode = "RIDE_NOT_ACTIVE"
+	CodeCancellationFee ErrorCode = "CANCELLATION_FEE_REQUIRED"
+	CodeActiveRide      ErrorCode = "ACTIVE_RIDE_EXISTS"
 	CodeNoDriver        ErrorCode = "NO_DRIVER"
+	CodePaymentDeclined ErrorCode = "PAYMENT_DECLINED"
+	CodeInvalidPIN      ErrorCode = "INVALID_PICKUP_PIN"
+	CodePINLocked       ErrorCode = "PICKUP_PIN_LOCKED"
 	CodeRateLimited     ErrorCode = "RATE_LIMITED"
 	CodeUnauthorized    ErrorCode = "UNAUTHORIZED"
 	CodeForbidden       ErrorCode = "FORBIDDEN"
 	CodeNotFound        ErrorCode = "NOT_FOUND"
 	CodeUpstream        ErrorCode = "UPSTREAM_UNAVAILABLE"
+	CodeInProgress      ErrorCode = "REQUEST_IN_PROGRESS"
 	CodeInternal        ErrorCode = "INTERNAL_ERROR"
 	CodeNotImplemented  ErrorCode = "NOT_IMPLEMENTED"
 )
@@ -34,8 +41,20 @@ func ErrorByCode(code ErrorCode) ErrorDef {
 		return ErrorDef{Type: "CONFLICT", Code: string(code), Message: "state conflict", HTTPStatus: http.StatusConflict}
 	case CodeOfferExpired:
 		return ErrorDef{Type: "CONFLICT", Code: string(code), Message: "offer expired", HTTPStatus: http.StatusConflict}
+	case CodeQuoteExpired:
+		return ErrorDef{Type: "CONFLICT", Code: string(code), Message: "quote expired", HTTPStatus: http.StatusConflict}
+	case CodeCancellationFee:
+		return ErrorDef{Type: "CONFLICT", Code: string(code), Message: "cancellation fee required", HTTPStatus: http.StatusConflict}
+	case CodeActiveRide:
+		return ErrorDef{Type: "CONFLICT", Code: string(code), Message: "active ride exists", HTTPStatus: http.StatusConflict}
 	case CodeRideNotActive:
 		return ErrorDef{Type: "CONFLICT", Code: string(code), Message: "ride not active", HTTPStatus: http.StatusConflict}
+	case CodePaymentDeclined:
+		return ErrorDef{Type: "PAYMENT_REQUIRED", Code: string(code), Message: "payment declined", HTTPStatus: http.StatusPaymentRequired}
+	case CodeInvalidPIN:
+		return ErrorDef{Type: "UNPROCESSABLE_ENTITY", Code: string(code), Message: "invalid pickup pin", HTTPStatus: http.StatusUnprocessableEntity}
+	case CodePINLocked:
+		return ErrorDef{Type: "FORBIDDEN", Code: string(code), Message: "pickup pin locked", HTTPStatus: http.StatusForbidden}
 	case CodeNoDriver:
 		return ErrorDef{Type: "NOT_FOUND", Code: string(code), Message: "no driver available", HTTPStatus: http.StatusNotFound}
 	case CodeRateLimited:
@@ -48,6 +67,8 @@ func ErrorByCode(code ErrorCode) ErrorDef {
 		return ErrorDef{Type: "NOT_FOUND", Code: string(code), Message: "not found", HTTPStatus: http.StatusNotFound}
 	case CodeUpstream:
 		return ErrorDef{Type: "UNAVAILABLE", Code: string(code), Message: "upstream unavailable", HTTPStatus: http.StatusServiceUnavailable}
+	case CodeInProgress:
+		return ErrorDef{Type: "UNAVAILABLE", Code: string(code), Message: "request in progress, retry later", HTTPStatus: http.StatusServiceUnavailable}
 	case CodeNotImplemented:
 		return ErrorDef{Type: "NOT_IMPLEMENTED", Code: string(code), Message: "endpoint not implemented", HTTPStatus: http.StatusNotImplemented}
 	default:
@@ -1,16 +1,47 @@
 package responses
 
 import (
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// MapGRPCError turns an upstream status into an API error. Errors carrying an
+// ErrorInfo are told apart by its reason; the rest fall back to their code.
 func MapGRPCError(err error) (ErrorCode, interface{}) {
 	st, ok := status.FromError(err)
 	if !ok {
 		return CodeInternal, nil
 	}
 
+	switch reason := errorReason(st); reason {
+	case "OFFER_EXPIRED":
+		return CodeOfferExpired, nil
+	case "QUOTE_EXPIRED":
+		return CodeQuoteExpired, nil
+	case "PAYMENT_DECLINED":
+		return CodePaymentDeclined, nil
+	case "CANCELLATION_FEE_REQUIRED":
+		return CodeCancellationFee, cancellationFeeDetails(st)
+	case "ACTIVE_RIDE_EXISTS":
+		return CodeActiveRide, activeRideDetails(st)
+	case "REQUEST_IN_PROGRESS":
+		return CodeInProgress, retryDetails(st)
+	case "VERSION_CONFLICT":
+		return CodeConflict, versionConflictDetails(st)
+	case "PICKUP_PIN_REQUIRED":
+		return CodeValidationError, map[string]string{"field": "pin"}
+	case "INVALID_PICKUP_PIN":
+		return CodeInvalidPIN, nil
+	case "PICKUP_PIN_LOCKED":
+		return CodePINLocked, nil
+	case "RATING_WINDOW_CLOSED", "RATING_NOT_PERMITTED", "IDEMPOTENCY_KEY_REUSED",
+		"NO_PICKUP_PIN", "NO_SHOW_TOO_EARLY":
+		return CodeConflict, map[string]string{"reason": reason}
+	}
+
 	switch st.Code() {
 	case codes.InvalidArgument:
 		return CodeValidationError, nil
@@ -24,6 +55,8 @@ func MapGRPCError(err error) (ErrorCode, interface{}) {
 		return CodeConflict, nil
 	case codes.FailedPrecondition:
 		return CodeConflict, map[string]string{"reason": "FAILED_PRECONDITION"}
+	case codes.Aborted:
+		return CodeConflict, map[string]string{"reason": "ABORTED"}
 	case codes.ResourceExhausted:
 		return CodeRateLimited, nil
 	case codes.Unavailable:
@@ -32,3 +65,84 @@ func MapGRPCError(err error) (ErrorCode, interface{}) {
 		return CodeInternal, nil
 	}
 }
+
+// errorReason returns the reason of the status's ErrorInfo detail, or "" when
+// it has none.
+func errorReason(st *status.Status) string {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info.GetReason()
+		}
+	}
+	return ""
+}
+
+// cancellationFeeDetails lifts the fee quote out of the ErrorInfo detail so the
+// client can show the fee and confirm it by resending confirm_token.
+func cancellationFeeDetails(st *status.Status) map[string]interface{} {
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok {
+			continue
+		}
+		meta := info.GetMetadata()
+		fee, _ := strconv.ParseInt(meta["fee_amount"], 10, 64)
+		expiresAt, _ := strconv.ParseInt(meta["expires_at"], 10, 64)
+		return map[string]interface{}{
+			"fee_amount":    fee,
+			"currency":      meta["currency"],
+			"confirm_token": meta["confirm_token"],
+			"expires_at":    expiresAt,
+		}
+	}
+	return nil
+}
+
+// activeRideDetails returns the ride the rider or driver is already on so the
+// client can resume it. active_ride_id is empty if that ride ended meanwhile.
+func activeRideDetails(st *status.Status) map[string]string {
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.GetReason() != "ACTIVE_RIDE_EXISTS" {
+			continue
+		}
+		meta := info.GetMetadata()
+		return map[string]string{
+			"role":           meta["role"],
+			"active_ride_id": meta["active_ride_id"],
+		}
+	}
+	return nil
+}
+
+// retryDetails passes on how long a duplicate of a request that is still
+// running should wait before retrying it.
+func retryDetails(st *status.Status) map[string]interface{} {
+	details := map[string]interface{}{}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			details["retry_after_ms"] = info.GetRetryDelay().AsDuration().Milliseconds()
+		}
+	}
+	return details
+}
+
+// versionConflictDetails returns the ride's current version from a
+// VERSION_CONFLICT detail so the client can re-read the ride and retry with a
+// fresh If-Match.
+func versionConflictDetails(st *status.Status) map[string]interface{} {
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.GetReason() != "VERSION_CONFLICT" {
+			continue
+		}
+		meta := info.GetMetadata()
+		current, _ := strconv.ParseInt(meta["current_version"], 10, 64)
+		return map[string]interface{}{
+			"reason":          "VERSION_CONFLICT",
+			"ride_id":         meta["ride_id"],
+			"current_version": current,
+		}
+	}
+	return nil
+}
@@ -1,12 +1,27 @@
 package responses
 
 import (
+	"net/http"
 	"testing"
+	"time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
+// withReason is a status carrying an ErrorInfo with reason, as the ride
+// service returns its domain errors.
+func withReason(t *testing.T, code codes.Code, reason string) error {
+	t.Helper()
+	st, err := status.New(code, "upstream message").WithDetails(&errdetails.ErrorInfo{Reason: reason})
+	if err != nil {
+		t.Fatalf("details: %v", err)
+	}
+	return st.Err()
+}
+
 func TestMapGRPCError(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -19,6 +34,17 @@ func TestMapGRPCError(t *testing.T) {
 		{"not_found", status.Error(codes.NotFound, "missing"), CodeNotFound},
 		{"already_exists", status.Error(codes.AlreadyExists, "dup"), CodeConflict},
 		{"failed_precondition", status.Error(codes.FailedPrecondition, "pre"), CodeConflict},
+		{"offer_expired", withReason(t, codes.FailedPrecondition, "OFFER_EXPIRED"), CodeOfferExpired},
+		{"quote_expired", withReason(t, codes.FailedPrecondition, "QUOTE_EXPIRED"), CodeQuoteExpired},
+		{"payment_declined", withReason(t, codes.FailedPrecondition, "PAYMENT_DECLINED"), CodePaymentDeclined},
+		{"rating_window_closed", withReason(t, codes.FailedPrecondition, "RATING_WINDOW_CLOSED"), CodeConflict},
+		{"idempotency_key_reused", withReason(t, codes.FailedPrecondition, "IDEMPOTENCY_KEY_REUSED"), CodeConflict},
+		{"invalid_pickup_pin", withReason(t, codes.InvalidArgument, "INVALID_PICKUP_PIN"), CodeInvalidPIN},
+		{"pickup_pin_locked", withReason(t, codes.PermissionDenied, "PICKUP_PIN_LOCKED"), CodePINLocked},
+		{"no_show_too_early", withReason(t, codes.FailedPrecondition, "NO_SHOW_TOO_EARLY"), CodeConflict},
+		{"pickup_pin_required", withReason(t, codes.InvalidArgument, "PICKUP_PIN_REQUIRED"), CodeValidationError},
+		{"message_without_reason", status.Error(codes.FailedPrecondition, "offer expired"), CodeConflict},
+		{"aborted", status.Error(codes.Aborted, "state conflict"), CodeConflict},
 		{"unavailable", status.Error(codes.Unavailable, "down"), CodeInternal},
 	}
 
@@ -31,3 +57,119 @@ func TestMapGRPCError(t *testing.T) {
 		})
 	}
 }
+
+func TestMapGRPCErrorCancellationFee(t *testing.T) {
+	st, err := status.New(codes.FailedPrecondition, "cancellation fee required").WithDetails(&errdetails.ErrorInfo{
+		Reason: "CANCELLATION_FEE_REQUIRED",
+		Metadata: map[string]string{
+			"fee_amount":    "5000",
+			"currency":      "IDR",
+			"confirm_token": "token",
+			"expires_at":    "1767225600",
+		},
+	})
+	if err != nil {
+		t.Fatalf("details: %v", err)
+	}
+
+	code, details := MapGRPCError(st.Err())
+	if code != CodeCancellationFee {
+		t.Fatalf("expected %s, got %s", CodeCancellationFee, code)
+	}
+	got, ok := details.(map[string]interface{})
+	if !ok || got["fee_amount"] != int64(5000) || got["currency"] != "IDR" || got["confirm_token"] != "token" || got["expires_at"] != int64(1767225600) {
+		t.Fatalf("unexpected details: %#v", details)
+	}
+}
+
+func TestMapGRPCErrorVersionConflict(t *testing.T) {
+	st, err := status.New(codes.Aborted, "ride version conflict").WithDetails(&errdetails.ErrorInfo{
+		Reason: "VERSION_CONFLICT",
+		Metadata: map[string]string{
+			"ride_id":         "ride-1",
+			"current_version": "7",
+		},
+	})
+	if err != nil {
+		t.Fatalf("details: %v", err)
+	}
+
+	code, details := MapGRPCError(st.Err())
+	if code != CodeConflict {
+		t.Fatalf("expected %s, got %s", CodeConflict, code)
+	}
+	got, ok := details.(map[string]interface{})
+	if !ok || got["reason"] != "VERSION_CONFLICT" || got["ride_id"] != "ride-1" || got["current_version"] != int64(7) {
+		t.Fatalf("unexpected details: %#v", details)
+	}
+}
+
+func TestMapGRPCErrorActiveRide(t *testing.T) {
+	st, err := status.New(codes.FailedPrecondition, "active ride exists").WithDetails(&errdetails.ErrorInfo{
+		Reason: "ACTIVE_RIDE_EXISTS",
+		Metadata: map[string]string{
+			"role":           "rider",
+			"active_ride_id": "ride-1",
+		},
+	})
+	if err != nil {
+		t.Fatalf("details: %v", err)
+	}
+
+	code, details := MapGRPCError(st.Err())
+	if code != CodeActiveRide {
+		t.Fatalf("expected %s, got %s", CodeActiveRide, code)
+	}
+	got, ok := details.(map[string]string)
+	if !ok || got["role"] != "rider" || got["active_ride_id"] != "ride-1" {
+		t.Fatalf("unexpected details: %#v", details)
+	}
+	if def := ErrorByCode(code); def.HTTPStatus != http.StatusConflict {
+		t.Fatalf("expected 409, got %d", def.HTTPStatus)
+	}
+}
+
+func TestMapGRPCErrorRequestInProgress(t *testing.T) {
+	st, err := status.New(codes.Aborted, "request in progress").WithDetails(
+		&errdetails.ErrorInfo{Reason: "REQUEST_IN_PROGRESS"},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Second)},
+	)
+	if err != nil {
+		t.Fatalf("details: %v", err)
+	}
+
+	code, details := MapGRPCError(st.Err())
+	if code != CodeInProgress {
+		t.Fatalf("expected %s, got %s", CodeInProgress, code)
+	}
+	got, ok := details.(map[string]interface{})
+	if !ok || got["retry_after_ms"] != int64(1000) {
+		t.Fatalf("unexpected details: %#v", details)
+	}
+	if def := ErrorByCode(code); def.HTTPStatus != http.StatusServiceUnavailable {
+		t.Fatalf("expected a retryable 503, got %d", def.HTTPStatus)
+	}
+}
+
+func TestMapGRPCErrorPickupPIN(t *testing.T) {
+	tests := []struct {
+		reason     string
+		wantCode   ErrorCode
+		wantStatus int
+	}{
+		{"INVALID_PICKUP_PIN", CodeInvalidPIN, http.StatusUnprocessableEntity},
+		{"PICKUP_PIN_LOCKED", CodePINLocked, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reason, func(t *testing.T) {
+			code, _ := MapGRPCError(withReason(t, codes.InvalidArgument, tt.reason))
+			if code != tt.wantCode {
+				t.Fatalf("expected %s, got %s", tt.wantCode, code)
+			}
+			if def := ErrorByCode(code); def.HTTPStatus != tt.wantStatus || def.Code != tt.reason {
+				t.Fatalf("expected %d %s, got %d %s", tt.wantStatus, tt.reason, def.HTTPStatus, def.Code)
+			}
+		})
+	}
+}
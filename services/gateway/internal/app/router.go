@@ -112,7 +112,9 @@ func NewRouter(cfg infra.Config, logger *zap.Logger, deps Deps, redisClient *red
 		riderGroup.Use(middleware.RequireScope("rides:write"))
 		riderGroup.Use(middleware.AuditLogger(logger, "rides:write"))
 		riderGroup.POST("/rides", handlers.CreateRide(deps.RideClient, cfg.GRPC.InternalToken))
+		riderGroup.POST("/rides/quote", handlers.QuoteFare(deps.RideClient, cfg.GRPC.InternalToken))
 		riderGroup.POST("/rides/:ride_id/cancel", handlers.CancelRide(deps.RideClient, cfg.GRPC.InternalToken))
+		riderGroup.PATCH("/rides/:ride_id/schedule", handlers.RescheduleRide(deps.RideClient, cfg.GRPC.InternalToken))
 		riderGroup.POST("/rides/:ride_id/offers",
 			middleware.RateLimitMiddleware(offerLimiter, cfg.RateLimit.OfferRequests),
 			handlers.CreateOffer(deps.RideClient, cfg.GRPC.InternalToken),
@@ -131,6 +133,12 @@ func NewRouter(cfg infra.Config, logger *zap.Logger, deps Deps, redisClient *red
 			middleware.RateLimitMiddleware(nearbyLimiter, cfg.RateLimit.NearbyRequests),
 			handlers.ListNearbyDrivers(deps.LocationClient, cfg.GRPC.InternalToken),
 		)
+		driverGroup.POST("/rides/:ride_id/arrive", handlers.MarkDriverArrived(deps.RideClient, cfg.GRPC.InternalToken))
+		driverGroup.POST("/rides/:ride_id/start", handlers.StartRide(deps.RideClient, cfg.GRPC.InternalToken))
+		driverGroup.POST("/rides/:ride_id/stops/:seq/arrive", handlers.ArriveAtStop(deps.RideClient, cfg.GRPC.InternalToken))
+		driverGroup.POST("/rides/:ride_id/stops/:seq/depart", handlers.DepartStop(deps.RideClient, cfg.GRPC.InternalToken))
+		driverGroup.POST("/rides/:ride_id/complete", handlers.CompleteRide(deps.RideClient, cfg.GRPC.InternalToken))
+		driverGroup.POST("/rides/:ride_id/driver-cancel", handlers.DriverCancelRide(deps.RideClient, cfg.GRPC.InternalToken))
 		driverGroup.POST("/offers/:offer_id/accept",
 			middleware.RateLimitMiddleware(offerLimiter, cfg.RateLimit.OfferRequests),
 			handlers.AcceptOffer(deps.RideClient, cfg.GRPC.InternalToken),
@@ -144,6 +152,11 @@ func NewRouter(cfg infra.Config, logger *zap.Logger, deps Deps, redisClient *red
 			handlers.ExpireOffer(deps.RideClient, cfg.GRPC.InternalToken),
 		)
 
+		driverReadGroup := authGroup.Group("/")
+		driverReadGroup.Use(middleware.RequireRole(middleware.RoleDriver))
+		driverReadGroup.Use(middleware.RequireScope("rides:read"))
+		driverReadGroup.GET("/drivers/me/earnings", handlers.GetDriverEarnings(deps.RideClient, cfg.GRPC.InternalToken))
+
 		adminGroup := authGroup.Group("/admin")
 		adminGroup.Use(middleware.RequireRole(middleware.RoleAdmin))
 		adminGroup.Use(middleware.RequireScope("admin:drivers:write"))
@@ -154,6 +167,25 @@ func NewRouter(cfg infra.Config, logger *zap.Logger, deps Deps, redisClient *red
 			handlers.UpdateDriverLocationFor(deps.LocationClient, cfg.GRPC.InternalToken),
 		)
 
+		adminRideGroup := authGroup.Group("/admin")
+		adminRideGroup.Use(middleware.RequireRole(middleware.RoleAdmin))
+		adminRideGroup.Use(middleware.RequireScope("admin:rides:write"))
+		adminRideGroup.Use(middleware.AuditLogger(logger, "admin:rides:write"))
+		adminRideGroup.POST("/rides/:ride_id/pickup-pin/override", handlers.OverridePickupPIN(deps.RideClient, cfg.GRPC.InternalToken))
+
+		rideFeedbackGroup := authGroup.Group("/")
+		rideFeedbackGroup.Use(middleware.RequireRole(middleware.RoleRider, middleware.RoleDriver))
+		rideFeedbackGroup.Use(middleware.RequireScope("rides:read"))
+		rideFeedbackGroup.Use(middleware.AuditLogger(logger, "rides:rate"))
+		rideFeedbackGroup.POST("/rides/:ride_id/rating", handlers.RateRide(deps.RideClient, cfg.GRPC.InternalToken))
+
+		rideReadGroup := authGroup.Group("/")
+		rideReadGroup.Use(middleware.RequireRole(middleware.RoleRider, middleware.RoleDriver, middleware.RoleAdmin))
+		rideReadGroup.Use(middleware.RequireScope("rides:read"))
+		rideReadGroup.GET("/rides", handlers.ListRides(deps.RideClient, cfg.GRPC.InternalToken))
+		rideReadGroup.GET("/rides/:ride_id", handlers.GetRide(deps.RideClient, cfg.GRPC.InternalToken))
+		rideReadGroup.GET("/rides/:ride_id/timeline", handlers.GetRideTimeline(deps.RideClient, cfg.GRPC.InternalToken))
+
 		userGroup := authGroup.Group("/")
 		userGroup.Use(middleware.RequireRole(middleware.RoleRider, middleware.RoleDriver))
 		userGroup.GET("/users/me", handlers.MeAuth(deps.AuthClient, cfg.GRPC.InternalToken))
@@ -8,8 +8,22 @@ import (
 )
 
 type RideService interface {
+	QuoteFare(ctx context.Context, in *ridev1.QuoteFareRequest, opts ...grpc.CallOption) (*ridev1.QuoteFareResponse, error)
+	GetRideTimeline(ctx context.Context, in *ridev1.GetRideTimelineRequest, opts ...grpc.CallOption) (*ridev1.GetRideTimelineResponse, error)
 	CreateRide(ctx context.Context, in *ridev1.CreateRideRequest, opts ...grpc.CallOption) (*ridev1.CreateRideResponse, error)
+	MarkDriverArrived(ctx context.Context, in *ridev1.MarkDriverArrivedRequest, opts ...grpc.CallOption) (*ridev1.MarkDriverArrivedResponse, error)
+	StartRide(ctx context.Context, in *ridev1.StartRideRequest, opts ...grpc.CallOption) (*ridev1.StartRideResponse, error)
+	CompleteRide(ctx context.Context, in *ridev1.CompleteRideRequest, opts ...grpc.CallOption) (*ridev1.CompleteRideResponse, error)
+	ArriveAtStop(ctx context.Context, in *ridev1.ArriveAtStopRequest, opts ...grpc.CallOption) (*ridev1.ArriveAtStopResponse, error)
+	DepartStop(ctx context.Context, in *ridev1.DepartStopRequest, opts ...grpc.CallOption) (*ridev1.DepartStopResponse, error)
+	RescheduleRide(ctx context.Context, in *ridev1.RescheduleRideRequest, opts ...grpc.CallOption) (*ridev1.RescheduleRideResponse, error)
 	CancelRide(ctx context.Context, in *ridev1.CancelRideRequest, opts ...grpc.CallOption) (*ridev1.CancelRideResponse, error)
+	DriverCancelRide(ctx context.Context, in *ridev1.DriverCancelRideRequest, opts ...grpc.CallOption) (*ridev1.DriverCancelRideResponse, error)
+	OverridePickupPin(ctx context.Context, in *ridev1.OverridePickupPinRequest, opts ...grpc.CallOption) (*ridev1.OverridePickupPinResponse, error)
+	RateRide(ctx context.Context, in *ridev1.RateRideRequest, opts ...grpc.CallOption) (*ridev1.RateRideResponse, error)
+	GetDriverEarnings(ctx context.Context, in *ridev1.GetDriverEarningsRequest, opts ...grpc.CallOption) (*ridev1.GetDriverEarningsResponse, error)
+	GetRide(ctx context.Context, in *ridev1.GetRideRequest, opts ...grpc.CallOption) (*ridev1.GetRideResponse, error)
+	ListRides(ctx context.Context, in *ridev1.ListRidesRequest, opts ...grpc.CallOption) (*ridev1.ListRidesResponse, error)
 	CreateOffer(ctx context.Context, in *ridev1.CreateOfferRequest, opts ...grpc.CallOption) (*ridev1.CreateOfferResponse, error)
 	AcceptOffer(ctx context.Context, in *ridev1.AcceptOfferRequest, opts ...grpc.CallOption) (*ridev1.AcceptOfferResponse, error)
 	DeclineOffer(ctx context.Context, in *ridev1.DeclineOfferRequest, opts ...grpc.CallOption) (*ridev1.DeclineOfferResponse, error)
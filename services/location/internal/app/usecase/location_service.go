@@ -2,11 +2,11 @@ package usecase
 
 import (
 	"context"
-	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
 	"github.com/daffahilmyf/ride-hailing/services/location/internal/domain"
 	"github.com/daffahilmyf/ride-hailing/services/location/internal/ports/outbound"
 )
@@ -56,18 +56,17 @@ func (s *LocationService) UpdateDriverLocation(ctx context.Context, driverID str
 	if s.PublishEnabled && s.Publisher != nil {
 		traceID := getStringFromContext(ctx, "trace_id")
 		requestID := getStringFromContext(ctx, "request_id")
-		envelope := domain.NewEventEnvelopeWith("driver.location.updated", "location-service", traceID, requestID, map[string]any{
-			"driver_id":        location.DriverID,
-			"lat":              location.Lat,
-			"lng":              location.Lng,
-			"accuracy_m":       location.AccuracyM,
-			"recorded_at_unix": location.RecordedAt.Unix(),
+		payload, err := eventsv1.Marshal(eventsv1.TopicDriverLocationUpdated, "location-service", traceID, requestID, &eventsv1.DriverLocationUpdated{
+			DriverId:       location.DriverID,
+			Lat:            location.Lat,
+			Lng:            location.Lng,
+			AccuracyM:      location.AccuracyM,
+			RecordedAtUnix: location.RecordedAt.Unix(),
 		}, s.now(), s.newID())
-		payload, err := json.Marshal(envelope)
 		if err != nil {
 			return domain.DriverLocation{}, err
 		}
-		if err := s.Publisher.Publish(ctx, "driver.location.updated", payload); err != nil {
+		if err := s.Publisher.Publish(ctx, eventsv1.TopicDriverLocationUpdated, payload); err != nil {
 			return domain.DriverLocation{}, err
 		}
 	}
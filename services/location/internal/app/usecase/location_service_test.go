@@ -2,12 +2,11 @@ package usecase
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"testing"
 	"time"
 
-	"github.com/daffahilmyf/ride-hailing/services/location/internal/domain"
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
 	"github.com/daffahilmyf/ride-hailing/services/location/internal/ports/outbound"
 )
 
@@ -110,12 +109,12 @@ func TestUpdateDriverLocation(t *testing.T) {
 				if publisher.subject != "driver.location.updated" {
 					t.Fatalf("unexpected subject: %s", publisher.subject)
 				}
-				var envelope domain.EventEnvelope
-				if err := json.Unmarshal(publisher.payload, &envelope); err != nil {
-					t.Fatalf("invalid payload: %v", err)
+				msg, err := eventsv1.Validate(publisher.payload)
+				if err != nil {
+					t.Fatalf("event breaks its contract: %v", err)
 				}
-				if envelope.Type != "driver.location.updated" {
-					t.Fatalf("unexpected event type: %s", envelope.Type)
+				if event, ok := msg.(*eventsv1.DriverLocationUpdated); !ok || event.GetDriverId() != "driver-1" {
+					t.Fatalf("unexpected event: %v", msg)
 				}
 			}
 		})
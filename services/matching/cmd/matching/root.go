@@ -51,6 +51,15 @@ func init() {
 	rootCmd.PersistentFlags().Int("matching.max_offers", 5, "max offers before cancel")
 	rootCmd.PersistentFlags().Float64("matching.avg_speed_kmh", 24, "assumed average speed for ETA")
 	rootCmd.PersistentFlags().Int("matching.eta_jitter_ms", 200, "ETA jitter in ms for tie-breaking")
+	rootCmd.PersistentFlags().Bool("surge.enabled", true, "enable surge pricing")
+	rootCmd.PersistentFlags().String("surge.key_prefix", "surge:", "surge redis key prefix")
+	rootCmd.PersistentFlags().Int("surge.window_seconds", 300, "surge sliding window seconds")
+	rootCmd.PersistentFlags().Int("surge.geohash_precision", 6, "surge cell geohash length")
+	rootCmd.PersistentFlags().Float64("surge.threshold", 1, "demand/supply ratio where surge starts")
+	rootCmd.PersistentFlags().Float64("surge.sensitivity", 0.5, "multiplier added per unit of ratio above threshold")
+	rootCmd.PersistentFlags().Float64("surge.step", 0.1, "multiplier rounding step")
+	rootCmd.PersistentFlags().Float64("surge.max_multiplier", 3, "surge multiplier cap")
+	rootCmd.PersistentFlags().Float64("surge.hysteresis", 0.2, "min change before the published multiplier moves")
 	rootCmd.PersistentFlags().String("nats.url", "", "NATS URL")
 	rootCmd.PersistentFlags().Bool("events.enabled", true, "enable event consumption")
 	rootCmd.PersistentFlags().String("events.ride_requested_subject", "ride.requested", "ride requested subject")
@@ -85,6 +94,15 @@ func init() {
 	_ = viper.BindPFlag("matching.max_offers", rootCmd.PersistentFlags().Lookup("matching.max_offers"))
 	_ = viper.BindPFlag("matching.avg_speed_kmh", rootCmd.PersistentFlags().Lookup("matching.avg_speed_kmh"))
 	_ = viper.BindPFlag("matching.eta_jitter_ms", rootCmd.PersistentFlags().Lookup("matching.eta_jitter_ms"))
+	_ = viper.BindPFlag("surge.enabled", rootCmd.PersistentFlags().Lookup("surge.enabled"))
+	_ = viper.BindPFlag("surge.key_prefix", rootCmd.PersistentFlags().Lookup("surge.key_prefix"))
+	_ = viper.BindPFlag("surge.window_seconds", rootCmd.PersistentFlags().Lookup("surge.window_seconds"))
+	_ = viper.BindPFlag("surge.geohash_precision", rootCmd.PersistentFlags().Lookup("surge.geohash_precision"))
+	_ = viper.BindPFlag("surge.threshold", rootCmd.PersistentFlags().Lookup("surge.threshold"))
+	_ = viper.BindPFlag("surge.sensitivity", rootCmd.PersistentFlags().Lookup("surge.sensitivity"))
+	_ = viper.BindPFlag("surge.step", rootCmd.PersistentFlags().Lookup("surge.step"))
+	_ = viper.BindPFlag("surge.max_multiplier", rootCmd.PersistentFlags().Lookup("surge.max_multiplier"))
+	_ = viper.BindPFlag("surge.hysteresis", rootCmd.PersistentFlags().Lookup("surge.hysteresis"))
 	_ = viper.BindPFlag("nats.url", rootCmd.PersistentFlags().Lookup("nats.url"))
 	_ = viper.BindPFlag("events.enabled", rootCmd.PersistentFlags().Lookup("events.enabled"))
 	_ = viper.BindPFlag("events.ride_requested_subject", rootCmd.PersistentFlags().Lookup("events.ride_requested_subject"))
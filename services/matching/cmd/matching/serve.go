@@ -17,6 +17,7 @@ import (
 	"github.com/daffahilmyf/ride-hailing/services/matching/internal/app/metrics"
 	"github.com/daffahilmyf/ride-hailing/services/matching/internal/app/usecase"
 	"github.com/daffahilmyf/ride-hailing/services/matching/internal/app/workers"
+	"github.com/daffahilmyf/ride-hailing/services/matching/internal/domain"
 	"github.com/daffahilmyf/ride-hailing/services/matching/internal/infra"
 	"github.com/nats-io/nats.go"
 	"github.com/prometheus/client_golang/prometheus"
@@ -85,8 +86,21 @@ var serveCmd = &cobra.Command{
 			MaxOffers:       cfg.MaxOffers,
 			AvgSpeedKmh:     cfg.AvgSpeedKmh,
 			EtaJitterMs:     cfg.EtaJitterMs,
-			Sleep:           time.Sleep,
-			Rand:            rand.New(rand.NewSource(time.Now().UnixNano())),
+			SurgePolicy: domain.SurgePolicy{
+				Window:      time.Duration(cfg.Surge.WindowSeconds) * time.Second,
+				Precision:   cfg.Surge.GeohashPrecision,
+				Threshold:   cfg.Surge.Threshold,
+				Sensitivity: cfg.Surge.Sensitivity,
+				Step:        cfg.Surge.Step,
+				Max:         cfg.Surge.MaxMultiplier,
+				Hysteresis:  cfg.Surge.Hysteresis,
+			},
+			Clock: usecase.SystemClock{},
+			Sleep: time.Sleep,
+			Rand:  rand.New(rand.NewSource(time.Now().UnixNano())),
+		}
+		if cfg.Surge.Enabled {
+			uc.Surge = redisadapter.NewSurgeRepo(redisClient, cfg.Surge.KeyPrefix)
 		}
 
 		grpcMetrics := grpcadapter.NewMetrics()
@@ -191,6 +205,20 @@ var serveCmd = &cobra.Command{
 				}
 			}()
 
+			driverCancelledConsumer := &workers.EventConsumer{
+				Consumer: consumer,
+				Subject:  "ride.driver.cancelled",
+				Durable:  "matching-driver-cancelled",
+				Batch:    10,
+				Logger:   logger,
+				Handler:  uc.HandleRideDriverCancelled,
+			}
+			go func() {
+				if err := driverCancelledConsumer.Run(ctx); err != nil {
+					logger.Warn("event.consumer_stopped", zap.String("subject", "ride.driver.cancelled"), zap.Error(err))
+				}
+			}()
+
 			offerAcceptedConsumer := &workers.EventConsumer{
 				Consumer: consumer,
 				Subject:  "ride.offer.accepted",
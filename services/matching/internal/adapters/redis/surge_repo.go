@@ -0,0 +1,92 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// SurgeRepo keeps per-cell sliding windows as sorted sets scored by unix
+// millis. Members are ride or driver IDs, so a driver reporting many
+// locations in the same cell is only counted once.
+type SurgeRepo struct {
+	client           *redis.Client
+	demandPrefix     string
+	supplyPrefix     string
+	multiplierPrefix string
+}
+
+func NewSurgeRepo(client *redis.Client, prefix string) *SurgeRepo {
+	if prefix == "" {
+		prefix = "surge:"
+	}
+	return &SurgeRepo{
+		client:           client,
+		demandPrefix:     prefix + "demand:",
+		supplyPrefix:     prefix + "supply:",
+		multiplierPrefix: prefix + "multiplier:",
+	}
+}
+
+func (r *SurgeRepo) RecordDemand(ctx context.Context, cell string, rideID string, at time.Time, window time.Duration) error {
+	return r.record(ctx, r.demandPrefix+cell, rideID, at, window)
+}
+
+func (r *SurgeRepo) RecordSupply(ctx context.Context, cell string, driverID string, at time.Time, window time.Duration) error {
+	return r.record(ctx, r.supplyPrefix+cell, driverID, at, window)
+}
+
+func (r *SurgeRepo) CountSince(ctx context.Context, cell string, since time.Time) (int, int, error) {
+	if r == nil || r.client == nil {
+		return 0, 0, nil
+	}
+	min := strconv.FormatInt(since.UnixMilli(), 10)
+	pipe := r.client.Pipeline()
+	demand := pipe.ZCount(ctx, r.demandPrefix+cell, min, "+inf")
+	supply := pipe.ZCount(ctx, r.supplyPrefix+cell, min, "+inf")
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, 0, err
+	}
+	return int(demand.Val()), int(supply.Val()), nil
+}
+
+func (r *SurgeRepo) GetMultiplier(ctx context.Context, cell string) (float64, bool, error) {
+	if r == nil || r.client == nil {
+		return 0, false, nil
+	}
+	val, err := r.client.Get(ctx, r.multiplierPrefix+cell).Float64()
+	if err == redis.Nil {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return val, true, nil
+}
+
+func (r *SurgeRepo) SetMultiplier(ctx context.Context, cell string, multiplier float64, ttl time.Duration) error {
+	if r == nil || r.client == nil {
+		return nil
+	}
+	return r.client.Set(ctx, r.multiplierPrefix+cell, strconv.FormatFloat(multiplier, 'f', -1, 64), ttl).Err()
+}
+
+func (r *SurgeRepo) record(ctx context.Context, key string, member string, at time.Time, window time.Duration) error {
+	if r == nil || r.client == nil {
+		return nil
+	}
+	if member == "" {
+		return nil
+	}
+	cutoff := at.Add(-window).UnixMilli()
+	pipe := r.client.TxPipeline()
+	pipe.ZAdd(ctx, key, redis.Z{Score: float64(at.UnixMilli()), Member: member})
+	pipe.ZRemRangeByScore(ctx, key, "-inf", "("+strconv.FormatInt(cutoff, 10))
+	if window > 0 {
+		pipe.Expire(ctx, key, window)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
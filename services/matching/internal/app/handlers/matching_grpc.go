@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"errors"
+	"time"
 
 	matchingv1 "github.com/daffahilmyf/ride-hailing/proto/matching/v1"
 	"github.com/daffahilmyf/ride-hailing/services/matching/internal/app/usecase"
@@ -58,6 +59,21 @@ func (s *MatchingServer) NotifyOfferSent(ctx context.Context, req *matchingv1.No
 	return &matchingv1.NotifyOfferSentResponse{Status: "OK"}, nil
 }
 
+func (s *MatchingServer) GetSurge(ctx context.Context, req *matchingv1.GetSurgeRequest) (*matchingv1.GetSurgeResponse, error) {
+	surge, err := s.usecase.GetSurge(ctx, req.GetLat(), req.GetLng())
+	if err != nil {
+		return nil, mapError(err, "failed to get surge")
+	}
+	return &matchingv1.GetSurgeResponse{
+		Cell:          surge.Cell,
+		Multiplier:    surge.Multiplier,
+		Demand:        int32(surge.Demand),
+		Supply:        int32(surge.Supply),
+		WindowSeconds: int64(s.usecase.SurgePolicy.Window / time.Second),
+		ComputedAt:    surge.ComputedAt.Unix(),
+	}, nil
+}
+
 func mapError(err error, msg string) error {
 	switch {
 	case errors.Is(err, domain.ErrInvalidStatus):
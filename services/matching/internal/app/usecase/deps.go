@@ -11,6 +11,16 @@ type Rand interface {
 
 type Sleeper func(time.Duration)
 
+type Clock interface {
+	Now() time.Time
+}
+
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now().UTC()
+}
+
 func (s *MatchingService) randIntn(n int) int {
 	if s != nil && s.Rand != nil {
 		return s.Rand.Intn(n)
@@ -28,3 +38,10 @@ func (s *MatchingService) sleep(d time.Duration) {
 	}
 	time.Sleep(d)
 }
+
+func (s *MatchingService) now() time.Time {
+	if s != nil && s.Clock != nil {
+		return s.Clock.Now()
+	}
+	return time.Now().UTC()
+}
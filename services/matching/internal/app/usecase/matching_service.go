@@ -2,12 +2,12 @@ package usecase
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
 	"math"
 	"sort"
+	"strings"
 	"time"
 
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
 	ridev1 "github.com/daffahilmyf/ride-hailing/proto/ride/v1"
 	"github.com/daffahilmyf/ride-hailing/services/matching/internal/app/metrics"
 	"github.com/daffahilmyf/ride-hailing/services/matching/internal/domain"
@@ -35,6 +35,9 @@ type MatchingService struct {
 	AvgSpeedKmh     float64
 	EtaJitterMs     int
 	Metrics         *metrics.MatchingMetrics
+	Surge           outbound.SurgeRepo
+	SurgePolicy     domain.SurgePolicy
+	Clock           Clock
 	Rand            Rand
 	Sleep           Sleeper
 }
@@ -47,6 +50,12 @@ func (s *MatchingService) UpdateDriverStatus(ctx context.Context, driverID strin
 }
 
 func (s *MatchingService) FindCandidates(ctx context.Context, lat float64, lng float64, radiusMeters float64, limit int) ([]outbound.Candidate, error) {
+	return s.findCandidates(ctx, lat, lng, radiusMeters, limit, nil)
+}
+
+// findCandidates widens the search radius until it finds available drivers
+// outside excluded.
+func (s *MatchingService) findCandidates(ctx context.Context, lat float64, lng float64, radiusMeters float64, limit int, excluded map[string]bool) ([]outbound.Candidate, error) {
 	if radiusMeters <= 0 {
 		radiusMeters = s.MatchRadius
 	}
@@ -68,6 +77,9 @@ func (s *MatchingService) FindCandidates(ctx context.Context, lat float64, lng f
 		}
 		available := make([]outbound.Candidate, 0, len(candidates))
 		for _, candidate := range candidates {
+			if excluded[candidate.DriverID] {
+				continue
+			}
 			ok, err := s.Repo.IsAvailable(ctx, candidate.DriverID)
 			if err != nil {
 				return nil, err
@@ -100,27 +112,19 @@ func (s *MatchingService) NotifyOfferSent(ctx context.Context, driverID string,
 }
 
 func (s *MatchingService) HandleRideRequested(ctx context.Context, payload []byte) error {
-	var envelope domain.EventEnvelope
-	if err := json.Unmarshal(payload, &envelope); err != nil {
+	var event eventsv1.RideRequested
+	envelope, err := eventsv1.Unmarshal(payload, &event)
+	if err != nil {
 		return err
 	}
-
-	data, ok := envelope.Payload.(map[string]any)
-	if !ok {
-		data, ok = envelope.Data.(map[string]any)
-	}
-	if !ok {
-		return errors.New("invalid payload")
-	}
-
-	rideID, _ := data["ride_id"].(string)
-	pickupLat, okLat := getFloat(data, "pickup_lat")
-	pickupLng, okLng := getFloat(data, "pickup_lng")
-	if rideID == "" || !okLat || !okLng {
+	rideID := event.GetRideId()
+	pickupLat, pickupLng := event.GetPickupLat(), event.GetPickupLng()
+	if rideID == "" {
 		return nil
 	}
 
 	ctx = withTrace(ctx, envelope.TraceID, envelope.RequestID)
+	s.recordDemand(ctx, rideID, pickupLat, pickupLng)
 	active, ok, err := s.Repo.GetActiveOffer(ctx, rideID)
 	if err != nil {
 		return err
@@ -135,6 +139,50 @@ func (s *MatchingService) HandleRideRequested(ctx context.Context, payload []byt
 	if hasCandidates {
 		return nil
 	}
+	return s.dispatch(ctx, rideID, pickupLat, pickupLng, nil, envelope.RequestID)
+}
+
+// HandleRideDriverCancelled re-dispatches a ride whose assigned driver backed
+// out, skipping every driver the ride service has excluded from it.
+func (s *MatchingService) HandleRideDriverCancelled(ctx context.Context, payload []byte) error {
+	var event eventsv1.RideDriverCancelled
+	envelope, err := eventsv1.Unmarshal(payload, &event)
+	if err != nil {
+		return err
+	}
+	rideID := event.GetRideId()
+	pickupLat, pickupLng := event.GetPickupLat(), event.GetPickupLng()
+	if rideID == "" {
+		return nil
+	}
+	excluded := map[string]bool{}
+	if driverID := event.GetDriverId(); driverID != "" {
+		excluded[driverID] = true
+	}
+	for _, driverID := range event.GetExcludedDriverIds() {
+		if driverID != "" {
+			excluded[driverID] = true
+		}
+	}
+
+	ctx = withTrace(ctx, envelope.TraceID, envelope.RequestID)
+	active, ok, err := s.Repo.GetActiveOffer(ctx, rideID)
+	if err != nil {
+		return err
+	}
+	if ok && active.OfferID != "" {
+		return nil
+	}
+	// Candidates and offer counts from the previous round no longer apply.
+	if err := s.Repo.ClearRide(ctx, rideID); err != nil {
+		return err
+	}
+	return s.dispatch(ctx, rideID, pickupLat, pickupLng, excluded, envelope.RequestID)
+}
+
+// dispatch takes the ride lock, seeds candidates around the pickup and sends
+// the first offer, cancelling the ride when nobody is available.
+func (s *MatchingService) dispatch(ctx context.Context, rideID string, pickupLat float64, pickupLng float64, excluded map[string]bool, requestID string) error {
 	lockTTL := s.LockTTLSeconds
 	if lockTTL <= 0 {
 		lockTTL = 10
@@ -146,7 +194,11 @@ func (s *MatchingService) HandleRideRequested(ctx context.Context, payload []byt
 	if !locked {
 		return nil
 	}
-	candidates, err := s.FindCandidates(ctx, pickupLat, pickupLng, s.MatchRadius, s.MatchLimit)
+	if err := s.startMatching(ctx, rideID); err != nil {
+		_ = s.Repo.ReleaseRideLock(ctx, rideID)
+		return err
+	}
+	candidates, err := s.findCandidates(ctx, pickupLat, pickupLng, s.MatchRadius, s.MatchLimit, excluded)
 	if err != nil {
 		return err
 	}
@@ -155,13 +207,13 @@ func (s *MatchingService) HandleRideRequested(ctx context.Context, payload []byt
 		if s.Metrics != nil {
 			s.Metrics.IncNoCandidates()
 		}
-		return s.cancelRide(ctx, rideID, "NO_DRIVER")
+		return s.cancelRide(ctx, rideID, ridev1.CancellationReason_CANCELLATION_REASON_NO_DRIVER)
 	}
 	if err := s.seedCandidates(ctx, rideID, candidates); err != nil {
 		_ = s.Repo.ReleaseRideLock(ctx, rideID)
 		return err
 	}
-	if err := s.sendNextOffer(ctx, rideID, envelope.RequestID); err != nil {
+	if err := s.sendNextOffer(ctx, rideID, requestID); err != nil {
 		_ = s.Repo.ReleaseRideLock(ctx, rideID)
 		return err
 	}
@@ -169,21 +221,19 @@ func (s *MatchingService) HandleRideRequested(ctx context.Context, payload []byt
 }
 
 func (s *MatchingService) HandleDriverLocation(ctx context.Context, payload []byte) error {
-	var envelope domain.EventEnvelope
-	if err := json.Unmarshal(payload, &envelope); err != nil {
+	var event eventsv1.DriverLocationUpdated
+	if _, err := eventsv1.Unmarshal(payload, &event); err != nil {
 		return err
 	}
-	data, ok := envelope.Payload.(map[string]any)
-	if !ok {
-		return errors.New("invalid payload")
-	}
-	driverID, _ := data["driver_id"].(string)
-	lat, _ := data["lat"].(float64)
-	lng, _ := data["lng"].(float64)
+	driverID, lat, lng := event.GetDriverId(), event.GetLat(), event.GetLng()
 	if driverID == "" {
 		return nil
 	}
-	return s.Repo.SetLocation(ctx, driverID, lat, lng)
+	if err := s.Repo.SetLocation(ctx, driverID, lat, lng); err != nil {
+		return err
+	}
+	s.recordSupply(ctx, driverID, lat, lng)
+	return nil
 }
 
 func (s *MatchingService) HandleOfferExpired(ctx context.Context, payload []byte) error {
@@ -195,18 +245,12 @@ func (s *MatchingService) HandleOfferDeclined(ctx context.Context, payload []byt
 }
 
 func (s *MatchingService) HandleOfferAccepted(ctx context.Context, payload []byte) error {
-	var envelope domain.EventEnvelope
-	if err := json.Unmarshal(payload, &envelope); err != nil {
+	var event eventsv1.RideOfferUpdated
+	envelope, err := eventsv1.Unmarshal(payload, &event)
+	if err != nil {
 		return err
 	}
-	data, ok := envelope.Payload.(map[string]any)
-	if !ok {
-		data, ok = envelope.Data.(map[string]any)
-	}
-	if !ok {
-		return errors.New("invalid payload")
-	}
-	rideID, _ := data["ride_id"].(string)
+	rideID := event.GetRideId()
 	if rideID == "" {
 		return nil
 	}
@@ -217,20 +261,12 @@ func (s *MatchingService) HandleOfferAccepted(ctx context.Context, payload []byt
 }
 
 func (s *MatchingService) handleOfferCompletion(ctx context.Context, payload []byte) error {
-	var envelope domain.EventEnvelope
-	if err := json.Unmarshal(payload, &envelope); err != nil {
+	var event eventsv1.RideOfferUpdated
+	envelope, err := eventsv1.Unmarshal(payload, &event)
+	if err != nil {
 		return err
 	}
-	data, ok := envelope.Payload.(map[string]any)
-	if !ok {
-		data, ok = envelope.Data.(map[string]any)
-	}
-	if !ok {
-		return errors.New("invalid payload")
-	}
-	rideID, _ := data["ride_id"].(string)
-	offerID, _ := data["offer_id"].(string)
-	driverID, _ := data["driver_id"].(string)
+	rideID, offerID, driverID := event.GetRideId(), event.GetOfferId(), event.GetDriverId()
 	if rideID == "" || offerID == "" {
 		return nil
 	}
@@ -307,7 +343,7 @@ func (s *MatchingService) sendNextOffer(ctx context.Context, rideID string, idem
 			}
 			_ = s.Repo.ReleaseRideLock(ctx, rideID)
 			_ = s.Repo.ClearRide(ctx, rideID)
-			return s.cancelRide(ctx, rideID, "NO_DRIVER")
+			return s.cancelRide(ctx, rideID, ridev1.CancellationReason_CANCELLATION_REASON_NO_DRIVER)
 		}
 		if s.MaxOffers > 0 {
 			count, err := s.Repo.GetOfferCount(ctx, rideID)
@@ -317,7 +353,7 @@ func (s *MatchingService) sendNextOffer(ctx context.Context, rideID string, idem
 			if count >= s.MaxOffers {
 				_ = s.Repo.ReleaseRideLock(ctx, rideID)
 				_ = s.Repo.ClearRide(ctx, rideID)
-				return s.cancelRide(ctx, rideID, "NO_DRIVER")
+				return s.cancelRide(ctx, rideID, ridev1.CancellationReason_CANCELLATION_REASON_NO_DRIVER)
 			}
 		}
 		exists, err := s.Repo.HasOffer(ctx, driverID)
@@ -354,7 +390,7 @@ func (s *MatchingService) sendNextOffer(ctx context.Context, rideID string, idem
 			activeTTL = offerTTL
 		}
 		_ = s.Repo.SetActiveOffer(ctx, rideID, resp.GetOfferId(), driverID, activeTTL)
-		_ = s.Repo.SetLastOfferAt(ctx, driverID, time.Now().UTC().Unix())
+		_ = s.Repo.SetLastOfferAt(ctx, driverID, s.now().Unix())
 		_, _ = s.Repo.IncrementOfferCount(ctx, rideID, s.CandidateTTL)
 		if s.Metrics != nil {
 			s.Metrics.IncSent()
@@ -406,15 +442,30 @@ func (s *MatchingService) rankCandidates(ctx context.Context, candidates []outbo
 	return candidates, nil
 }
 
-func (s *MatchingService) cancelRide(ctx context.Context, rideID string, reason string) error {
+func (s *MatchingService) startMatching(ctx context.Context, rideID string) error {
 	if s == nil || s.RideClient == nil {
 		return nil
 	}
 	callCtx := withInternalToken(ctx, s.InternalToken)
-	_, err := s.RideClient.CancelRide(callCtx, &ridev1.CancelRideRequest{
+	_, err := s.RideClient.StartMatching(callCtx, &ridev1.StartMatchingRequest{
 		RideId:    rideID,
-		Reason:    reason,
-		RequestId: rideID + ":" + reason,
+		RequestId: rideID + ":MATCHING",
+	})
+	return err
+}
+
+func (s *MatchingService) cancelRide(ctx context.Context, rideID string, code ridev1.CancellationReason) error {
+	if s == nil || s.RideClient == nil {
+		return nil
+	}
+	reason := strings.TrimPrefix(code.String(), "CANCELLATION_REASON_")
+	callCtx := withInternalToken(ctx, s.InternalToken)
+	_, err := s.RideClient.CancelRide(callCtx, &ridev1.CancelRideRequest{
+		RideId:     rideID,
+		Reason:     reason,
+		ReasonCode: code,
+		RequestId:  rideID + ":" + reason,
+		Actor:      "matching",
 	})
 	return err
 }
@@ -456,22 +507,3 @@ func (s *MatchingService) computeBackoff(attempt int, baseMs int, maxMs int) tim
 	jitter := time.Duration(s.randIntn(100)) * time.Millisecond
 	return time.Duration(backoff) + jitter
 }
-
-func getFloat(values map[string]any, key string) (float64, bool) {
-	raw, ok := values[key]
-	if !ok {
-		return 0, false
-	}
-	switch v := raw.(type) {
-	case float64:
-		return v, true
-	case float32:
-		return float64(v), true
-	case int:
-		return float64(v), true
-	case int64:
-		return float64(v), true
-	default:
-		return 0, false
-	}
-}
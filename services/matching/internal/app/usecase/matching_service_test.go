@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
+	ridev1 "github.com/daffahilmyf/ride-hailing/proto/ride/v1"
+	"github.com/daffahilmyf/ride-hailing/services/matching/internal/ports/outbound"
+	"google.golang.org/grpc"
+)
+
+type fakeDispatchRepo struct {
+	outbound.DriverRepo
+	nearby     []outbound.Candidate
+	candidates map[string][]string
+	active     map[string]outbound.ActiveOffer
+	cleared    []string
+}
+
+func newFakeDispatchRepo(nearby ...string) *fakeDispatchRepo {
+	repo := &fakeDispatchRepo{candidates: map[string][]string{}, active: map[string]outbound.ActiveOffer{}}
+	for i, id := range nearby {
+		repo.nearby = append(repo.nearby, outbound.Candidate{DriverID: id, DistanceM: float64(100 * (i + 1))})
+	}
+	return repo
+}
+
+func (r *fakeDispatchRepo) Nearby(ctx context.Context, lat float64, lng float64, radiusMeters float64, limit int) ([]outbound.Candidate, error) {
+	return append([]outbound.Candidate(nil), r.nearby...), nil
+}
+
+func (r *fakeDispatchRepo) IsAvailable(ctx context.Context, driverID string) (bool, error) {
+	return true, nil
+}
+
+func (r *fakeDispatchRepo) IsCoolingDown(ctx context.Context, driverID string) (bool, error) {
+	return false, nil
+}
+
+func (r *fakeDispatchRepo) GetLastOfferAt(ctx context.Context, driverIDs []string) (map[string]int64, error) {
+	return map[string]int64{}, nil
+}
+
+func (r *fakeDispatchRepo) AcquireRideLock(ctx context.Context, rideID string, ttlSeconds int) (bool, error) {
+	return true, nil
+}
+
+func (r *fakeDispatchRepo) RefreshRideLock(ctx context.Context, rideID string, ttlSeconds int) error {
+	return nil
+}
+
+func (r *fakeDispatchRepo) ReleaseRideLock(ctx context.Context, rideID string) error {
+	return nil
+}
+
+func (r *fakeDispatchRepo) ClearRide(ctx context.Context, rideID string) error {
+	r.cleared = append(r.cleared, rideID)
+	delete(r.candidates, rideID)
+	delete(r.active, rideID)
+	return nil
+}
+
+func (r *fakeDispatchRepo) HasRideCandidates(ctx context.Context, rideID string) (bool, error) {
+	return len(r.candidates[rideID]) > 0, nil
+}
+
+func (r *fakeDispatchRepo) StoreRideCandidates(ctx context.Context, rideID string, driverIDs []string, ttlSeconds int) error {
+	r.candidates[rideID] = append([]string(nil), driverIDs...)
+	return nil
+}
+
+func (r *fakeDispatchRepo) PopRideCandidate(ctx context.Context, rideID string) (string, error) {
+	queue := r.candidates[rideID]
+	if len(queue) == 0 {
+		return "", nil
+	}
+	r.candidates[rideID] = queue[1:]
+	return queue[0], nil
+}
+
+func (r *fakeDispatchRepo) HasOffer(ctx context.Context, driverID string) (bool, error) {
+	return false, nil
+}
+
+func (r *fakeDispatchRepo) MarkOfferSent(ctx context.Context, driverID string, offerID string, ttlSeconds int) error {
+	return nil
+}
+
+func (r *fakeDispatchRepo) GetActiveOffer(ctx context.Context, rideID string) (outbound.ActiveOffer, bool, error) {
+	offer, ok := r.active[rideID]
+	return offer, ok, nil
+}
+
+func (r *fakeDispatchRepo) SetActiveOffer(ctx context.Context, rideID string, offerID string, driverID string, ttlSeconds int) error {
+	r.active[rideID] = outbound.ActiveOffer{OfferID: offerID, DriverID: driverID}
+	return nil
+}
+
+func (r *fakeDispatchRepo) SetLastOfferAt(ctx context.Context, driverID string, tsUnix int64) error {
+	return nil
+}
+
+func (r *fakeDispatchRepo) IncrementOfferCount(ctx context.Context, rideID string, ttlSeconds int) (int, error) {
+	return 1, nil
+}
+
+type fakeRideClient struct {
+	offers    []*ridev1.CreateOfferRequest
+	cancelled []*ridev1.CancelRideRequest
+}
+
+func (c *fakeRideClient) StartMatching(ctx context.Context, in *ridev1.StartMatchingRequest, opts ...grpc.CallOption) (*ridev1.StartMatchingResponse, error) {
+	return &ridev1.StartMatchingResponse{RideId: in.RideId, Status: "MATCHING"}, nil
+}
+
+func (c *fakeRideClient) CreateOffer(ctx context.Context, in *ridev1.CreateOfferRequest, opts ...grpc.CallOption) (*ridev1.CreateOfferResponse, error) {
+	c.offers = append(c.offers, in)
+	return &ridev1.CreateOfferResponse{OfferId: "offer-" + in.DriverId, RideId: in.RideId, DriverId: in.DriverId}, nil
+}
+
+func (c *fakeRideClient) CancelRide(ctx context.Context, in *ridev1.CancelRideRequest, opts ...grpc.CallOption) (*ridev1.CancelRideResponse, error) {
+	c.cancelled = append(c.cancelled, in)
+	return &ridev1.CancelRideResponse{RideId: in.RideId, Status: "CANCELLED"}, nil
+}
+
+func driverCancelled(t *testing.T, driverID string, excluded ...string) []byte {
+	t.Helper()
+	payload, err := eventsv1.Marshal(eventsv1.TopicRideDriverCancelled, "ride-service", "", "req-1", &eventsv1.RideDriverCancelled{
+		RideId:            "ride-1",
+		DriverId:          driverID,
+		PickupLat:         -6.2,
+		PickupLng:         106.8,
+		ExcludedDriverIds: excluded,
+	}, time.Now(), "event-1")
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	return payload
+}
+
+func TestDriverCancelledRedispatchesWithoutExcludedDrivers(t *testing.T) {
+	repo := newFakeDispatchRepo("d1", "d2", "d3")
+	rides := &fakeRideClient{}
+	svc := &MatchingService{Repo: repo, RideClient: rides, MatchRadius: 1000, MatchLimit: 10, Sleep: func(time.Duration) {}}
+
+	if err := svc.HandleRideDriverCancelled(context.Background(), driverCancelled(t, "d1", "d1", "d2")); err != nil {
+		t.Fatalf("handle error: %v", err)
+	}
+	if len(repo.cleared) != 1 {
+		t.Fatalf("expected stale matching state cleared, got %v", repo.cleared)
+	}
+	if len(rides.offers) != 1 || rides.offers[0].GetDriverId() != "d3" {
+		t.Fatalf("expected a single offer to d3, got %v", rides.offers)
+	}
+	if got := repo.candidates["ride-1"]; len(got) != 0 {
+		t.Fatalf("expected excluded drivers left out of the queue, got %v", got)
+	}
+
+	// A redelivered event must not start a second round over the live offer.
+	if err := svc.HandleRideDriverCancelled(context.Background(), driverCancelled(t, "d1", "d1", "d2")); err != nil {
+		t.Fatalf("handle error: %v", err)
+	}
+	if len(rides.offers) != 1 || len(repo.cleared) != 1 {
+		t.Fatalf("expected redelivery to be ignored, got %d offers", len(rides.offers))
+	}
+}
+
+func TestDriverCancelledWithNoOtherDriverCancelsRide(t *testing.T) {
+	repo := newFakeDispatchRepo("d1")
+	rides := &fakeRideClient{}
+	svc := &MatchingService{Repo: repo, RideClient: rides, MatchRadius: 1000, MatchLimit: 10, Sleep: func(time.Duration) {}}
+
+	if err := svc.HandleRideDriverCancelled(context.Background(), driverCancelled(t, "d1")); err != nil {
+		t.Fatalf("handle error: %v", err)
+	}
+	if len(rides.offers) != 0 || len(rides.cancelled) != 1 || rides.cancelled[0].GetReason() != "NO_DRIVER" {
+		t.Fatalf("expected ride cancelled for lack of drivers, got offers=%v cancelled=%v", rides.offers, rides.cancelled)
+	}
+}
+
+// Consumers decode through the shared contracts, so ride.requested is read the
+// same whether it arrives in the current envelope, with fields this consumer
+// does not know yet, or in the legacy payload envelope.
+func TestRideRequestedAcceptsCompatibleEnvelopes(t *testing.T) {
+	current, err := eventsv1.Marshal(eventsv1.TopicRideRequested, "ride-service", "", "req-1", &eventsv1.RideRequested{
+		RideId:    "ride-1",
+		PickupLat: -6.2,
+		PickupLng: 106.8,
+	}, time.Now(), "event-1")
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	payloads := map[string][]byte{
+		"current":     current,
+		"added field": []byte(`{"type":"ride.requested","version":"v1","data":{"ride_id":"ride-1","pickup_lat":-6.2,"pickup_lng":106.8,"eta_hint":"soon"}}`),
+		"legacy":      []byte(`{"type":"ride.requested","version":"v1","source":"ride","payload":{"ride_id":"ride-1","pickup_lat":-6.2,"pickup_lng":106.8,"fare_amount":"30000"}}`),
+	}
+	for name, payload := range payloads {
+		repo := newFakeDispatchRepo("d1")
+		rides := &fakeRideClient{}
+		svc := &MatchingService{Repo: repo, RideClient: rides, MatchRadius: 1000, MatchLimit: 10, Sleep: func(time.Duration) {}}
+		if err := svc.HandleRideRequested(context.Background(), payload); err != nil {
+			t.Fatalf("%s: handle error: %v", name, err)
+		}
+		if len(rides.offers) != 1 || rides.offers[0].GetRideId() != "ride-1" {
+			t.Fatalf("%s: expected one offer for ride-1, got %v", name, rides.offers)
+		}
+	}
+
+	unsupported := []byte(`{"type":"ride.requested","version":"v2","data":{"ride_id":"ride-1"}}`)
+	svc := &MatchingService{Repo: newFakeDispatchRepo("d1"), RideClient: &fakeRideClient{}, Sleep: func(time.Duration) {}}
+	if err := svc.HandleRideRequested(context.Background(), unsupported); !errors.Is(err, eventsv1.ErrUnsupportedVersion) {
+		t.Fatalf("expected a newer contract version rejected, got %v", err)
+	}
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/daffahilmyf/ride-hailing/services/matching/internal/domain"
+)
+
+// GetSurge computes the multiplier for the cell containing the point from the
+// demand and supply seen over the policy window, and stores it so the next
+// call can apply hysteresis against it.
+func (s *MatchingService) GetSurge(ctx context.Context, lat float64, lng float64) (domain.Surge, error) {
+	policy := s.SurgePolicy
+	now := s.now()
+	surge := domain.Surge{
+		Cell:       domain.Geohash(lat, lng, policy.Precision),
+		Multiplier: 1,
+		ComputedAt: now,
+	}
+	if s.Surge == nil {
+		return surge, nil
+	}
+	demand, supply, err := s.Surge.CountSince(ctx, surge.Cell, now.Add(-policy.Window))
+	if err != nil {
+		return domain.Surge{}, err
+	}
+	previous, _, err := s.Surge.GetMultiplier(ctx, surge.Cell)
+	if err != nil {
+		return domain.Surge{}, err
+	}
+	surge.Demand = demand
+	surge.Supply = supply
+	surge.Multiplier = policy.Next(previous, demand, supply)
+	if surge.Multiplier != previous {
+		if err := s.Surge.SetMultiplier(ctx, surge.Cell, surge.Multiplier, policy.Window); err != nil {
+			return domain.Surge{}, err
+		}
+	}
+	return surge, nil
+}
+
+func (s *MatchingService) recordDemand(ctx context.Context, rideID string, lat float64, lng float64) {
+	if s.Surge == nil {
+		return
+	}
+	cell := domain.Geohash(lat, lng, s.SurgePolicy.Precision)
+	_ = s.Surge.RecordDemand(ctx, cell, rideID, s.now(), s.SurgePolicy.Window)
+}
+
+func (s *MatchingService) recordSupply(ctx context.Context, driverID string, lat float64, lng float64) {
+	if s.Surge == nil {
+		return
+	}
+	available, err := s.Repo.IsAvailable(ctx, driverID)
+	if err != nil || !available {
+		return
+	}
+	cell := domain.Geohash(lat, lng, s.SurgePolicy.Precision)
+	_ = s.Surge.RecordSupply(ctx, cell, driverID, s.now(), s.SurgePolicy.Window)
+}
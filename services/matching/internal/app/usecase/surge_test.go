@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
+	"github.com/daffahilmyf/ride-hailing/services/matching/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/matching/internal/ports/outbound"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+type fakeDriverRepo struct {
+	outbound.DriverRepo
+	available map[string]bool
+}
+
+func (r *fakeDriverRepo) SetLocation(ctx context.Context, driverID string, lat float64, lng float64) error {
+	return nil
+}
+
+func (r *fakeDriverRepo) IsAvailable(ctx context.Context, driverID string) (bool, error) {
+	return r.available[driverID], nil
+}
+
+type fakeSurgeRepo struct {
+	clock      *fakeClock
+	demand     map[string]map[string]time.Time
+	supply     map[string]map[string]time.Time
+	multiplier map[string]float64
+	expiresAt  map[string]time.Time
+}
+
+func newFakeSurgeRepo(clock *fakeClock) *fakeSurgeRepo {
+	return &fakeSurgeRepo{
+		clock:      clock,
+		demand:     map[string]map[string]time.Time{},
+		supply:     map[string]map[string]time.Time{},
+		multiplier: map[string]float64{},
+		expiresAt:  map[string]time.Time{},
+	}
+}
+
+func (r *fakeSurgeRepo) RecordDemand(ctx context.Context, cell string, rideID string, at time.Time, window time.Duration) error {
+	if r.demand[cell] == nil {
+		r.demand[cell] = map[string]time.Time{}
+	}
+	r.demand[cell][rideID] = at
+	return nil
+}
+
+func (r *fakeSurgeRepo) RecordSupply(ctx context.Context, cell string, driverID string, at time.Time, window time.Duration) error {
+	if r.supply[cell] == nil {
+		r.supply[cell] = map[string]time.Time{}
+	}
+	r.supply[cell][driverID] = at
+	return nil
+}
+
+func (r *fakeSurgeRepo) CountSince(ctx context.Context, cell string, since time.Time) (int, int, error) {
+	count := func(entries map[string]time.Time) int {
+		n := 0
+		for _, at := range entries {
+			if !at.Before(since) {
+				n++
+			}
+		}
+		return n
+	}
+	return count(r.demand[cell]), count(r.supply[cell]), nil
+}
+
+func (r *fakeSurgeRepo) GetMultiplier(ctx context.Context, cell string) (float64, bool, error) {
+	if exp, ok := r.expiresAt[cell]; ok && !r.clock.now.Before(exp) {
+		return 0, false, nil
+	}
+	val, ok := r.multiplier[cell]
+	return val, ok, nil
+}
+
+func (r *fakeSurgeRepo) SetMultiplier(ctx context.Context, cell string, multiplier float64, ttl time.Duration) error {
+	r.multiplier[cell] = multiplier
+	r.expiresAt[cell] = r.clock.now.Add(ttl)
+	return nil
+}
+
+func newSurgeService() (*MatchingService, *fakeClock, *fakeDriverRepo) {
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)}
+	drivers := &fakeDriverRepo{available: map[string]bool{}}
+	return &MatchingService{
+		Repo:        drivers,
+		Surge:       newFakeSurgeRepo(clock),
+		SurgePolicy: domain.DefaultSurgePolicy(),
+		Clock:       clock,
+	}, clock, drivers
+}
+
+func driverLocation(t *testing.T, driverID string, lat float64, lng float64) []byte {
+	t.Helper()
+	payload, err := eventsv1.Marshal(eventsv1.TopicDriverLocationUpdated, "location-service", "", "", &eventsv1.DriverLocationUpdated{
+		DriverId: driverID,
+		Lat:      lat,
+		Lng:      lng,
+	}, time.Now(), "event-1")
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	return payload
+}
+
+func TestGetSurgeSlidingWindow(t *testing.T) {
+	svc, clock, drivers := newSurgeService()
+	ctx := context.Background()
+	lat, lng := -6.2, 106.8167
+
+	for i := 0; i < 6; i++ {
+		id := fmt.Sprintf("d%d", i)
+		drivers.available[id] = i < 5
+		if err := svc.HandleDriverLocation(ctx, driverLocation(t, id, lat, lng)); err != nil {
+			t.Fatalf("location error: %v", err)
+		}
+	}
+	for i := 0; i < 10; i++ {
+		svc.recordDemand(ctx, fmt.Sprintf("r%d", i), lat, lng)
+	}
+
+	surge, err := svc.GetSurge(ctx, lat, lng)
+	if err != nil {
+		t.Fatalf("surge error: %v", err)
+	}
+	if surge.Demand != 10 || surge.Supply != 5 {
+		t.Fatalf("expected demand 10 supply 5, got %d/%d", surge.Demand, surge.Supply)
+	}
+	if surge.Multiplier != 1.5 {
+		t.Fatalf("expected 1.5, got %v", surge.Multiplier)
+	}
+
+	// One more request nudges the target to 1.6, inside the hysteresis band.
+	clock.now = clock.now.Add(time.Minute)
+	svc.recordDemand(ctx, "r10", lat, lng)
+	surge, _ = svc.GetSurge(ctx, lat, lng)
+	if surge.Multiplier != 1.5 {
+		t.Fatalf("expected hysteresis to hold 1.5, got %v", surge.Multiplier)
+	}
+
+	// A point in another cell is unaffected.
+	other, _ := svc.GetSurge(ctx, -6.3, 106.9)
+	if other.Multiplier != 1 || other.Cell == surge.Cell {
+		t.Fatalf("expected no surge in cell %s, got %v", other.Cell, other.Multiplier)
+	}
+
+	// Once the window slides past every sample the cell cools down.
+	clock.now = clock.now.Add(svc.SurgePolicy.Window + time.Second)
+	surge, _ = svc.GetSurge(ctx, lat, lng)
+	if surge.Demand != 0 || surge.Multiplier != 1 {
+		t.Fatalf("expected surge to reset, got demand %d multiplier %v", surge.Demand, surge.Multiplier)
+	}
+}
+
+func TestGetSurgeWithoutStore(t *testing.T) {
+	svc := &MatchingService{SurgePolicy: domain.DefaultSurgePolicy()}
+	surge, err := svc.GetSurge(context.Background(), 1, 2)
+	if err != nil {
+		t.Fatalf("surge error: %v", err)
+	}
+	if surge.Multiplier != 1 {
+		t.Fatalf("expected 1, got %v", surge.Multiplier)
+	}
+}
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// Geohash encodes a point into a cell identifier of the given length.
+func Geohash(lat float64, lng float64, precision int) string {
+	if precision <= 0 {
+		precision = 6
+	}
+	latRange := [2]float64{-90, 90}
+	lngRange := [2]float64{-180, 180}
+	out := make([]byte, 0, precision)
+	bit, ch := 0, 0
+	even := true
+	for len(out) < precision {
+		if even {
+			mid := (lngRange[0] + lngRange[1]) / 2
+			if lng >= mid {
+				ch |= 1 << (4 - bit)
+				lngRange[0] = mid
+			} else {
+				lngRange[1] = mid
+			}
+		} else {
+			mid := (latRange[0] + latRange[1]) / 2
+			if lat >= mid {
+				ch |= 1 << (4 - bit)
+				latRange[0] = mid
+			} else {
+				latRange[1] = mid
+			}
+		}
+		even = !even
+		if bit < 4 {
+			bit++
+			continue
+		}
+		out = append(out, geohashAlphabet[ch])
+		bit, ch = 0, 0
+	}
+	return string(out)
+}
+
+// SurgePolicy turns demand and supply counts for a cell into a fare multiplier.
+type SurgePolicy struct {
+	Window      time.Duration
+	Precision   int
+	Threshold   float64
+	Sensitivity float64
+	Step        float64
+	Max         float64
+	Hysteresis  float64
+}
+
+type Surge struct {
+	Cell       string
+	Multiplier float64
+	Demand     int
+	Supply     int
+	ComputedAt time.Time
+}
+
+func DefaultSurgePolicy() SurgePolicy {
+	return SurgePolicy{
+		Window:      5 * time.Minute,
+		Precision:   6,
+		Threshold:   1,
+		Sensitivity: 0.5,
+		Step:        0.1,
+		Max:         3,
+		Hysteresis:  0.2,
+	}
+}
+
+// Target is the multiplier the counts call for: 1.0 until the demand/supply
+// ratio passes Threshold, then rising by Sensitivity per unit of ratio,
+// floored to Step and capped at Max.
+func (p SurgePolicy) Target(demand int, supply int) float64 {
+	if demand <= 0 {
+		return 1
+	}
+	max := p.Max
+	if max < 1 {
+		max = 1
+	}
+	if supply <= 0 {
+		return max
+	}
+	ratio := float64(demand) / float64(supply)
+	raw := 1 + math.Max(0, ratio-p.Threshold)*p.Sensitivity
+	if p.Step > 0 {
+		raw = 1 + math.Floor((raw-1)/p.Step+1e-9)*p.Step
+	}
+	raw = math.Round(raw*100) / 100
+	return math.Min(raw, max)
+}
+
+// Next applies hysteresis: the published multiplier only moves once the target
+// differs from it by at least Hysteresis, so a cell hovering around a step
+// boundary does not flap. A cell with no demand drops straight back to 1.0.
+func (p SurgePolicy) Next(previous float64, demand int, supply int) float64 {
+	target := p.Target(demand, supply)
+	if previous < 1 || demand <= 0 {
+		return target
+	}
+	if math.Abs(target-previous) < p.Hysteresis-1e-9 {
+		return previous
+	}
+	return target
+}
@@ -0,0 +1,67 @@
+package domain
+
+import "testing"
+
+func TestGeohash(t *testing.T) {
+	tests := []struct {
+		lat, lng  float64
+		precision int
+		want      string
+	}{
+		{57.64911, 10.40744, 11, "u4pruydqqvj"},
+		{-6.2, 106.8167, 6, "qqguwx"},
+		{0, 0, 1, "s"},
+	}
+	for _, tt := range tests {
+		if got := Geohash(tt.lat, tt.lng, tt.precision); got != tt.want {
+			t.Fatalf("Geohash(%v, %v, %d) = %s, want %s", tt.lat, tt.lng, tt.precision, got, tt.want)
+		}
+	}
+}
+
+func TestSurgeTarget(t *testing.T) {
+	p := DefaultSurgePolicy()
+	tests := []struct {
+		name           string
+		demand, supply int
+		want           float64
+	}{
+		{"no_demand", 0, 0, 1},
+		{"balanced", 5, 5, 1},
+		{"double", 10, 5, 1.5},
+		{"floored_to_step", 13, 5, 1.8},
+		{"capped", 100, 5, 3},
+		{"no_supply", 3, 0, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.Target(tt.demand, tt.supply); got != tt.want {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSurgeNextHysteresis(t *testing.T) {
+	p := DefaultSurgePolicy()
+	tests := []struct {
+		name           string
+		previous       float64
+		demand, supply int
+		want           float64
+	}{
+		{"first_reading", 0, 10, 5, 1.5},
+		{"small_rise_held", 1.5, 11, 5, 1.5},
+		{"large_rise_applied", 1.5, 12, 5, 1.7},
+		{"small_drop_held", 1.5, 9, 5, 1.5},
+		{"large_drop_applied", 1.5, 7, 5, 1.2},
+		{"no_demand_resets", 1.1, 0, 5, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.Next(tt.previous, tt.demand, tt.supply); got != tt.want {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
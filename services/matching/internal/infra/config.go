@@ -26,6 +26,7 @@ type Config struct {
 	MaxOffers              int
 	AvgSpeedKmh            float64
 	EtaJitterMs            int
+	Surge                  SurgeConfig
 	NATSURL                string
 	NATSSelfHeal           bool
 	EventsEnabled          bool
@@ -38,6 +39,18 @@ type Config struct {
 	Observability          ObservabilityConfig
 }
 
+type SurgeConfig struct {
+	Enabled          bool
+	KeyPrefix        string
+	WindowSeconds    int
+	GeohashPrecision int
+	Threshold        float64
+	Sensitivity      float64
+	Step             float64
+	MaxMultiplier    float64
+	Hysteresis       float64
+}
+
 type ObservabilityConfig struct {
 	MetricsEnabled  bool
 	MetricsAddr     string
@@ -73,15 +86,26 @@ func DefaultConfig() Config {
 		MaxOffers:              5,
 		AvgSpeedKmh:            24,
 		EtaJitterMs:            200,
-		NATSURL:                "nats://nats:4222",
-		NATSSelfHeal:           true,
-		EventsEnabled:          true,
-		RideRequestedSubject:   "ride.requested",
-		DriverLocationSubject:  "driver.location.updated",
-		InternalAuthEnabled:    false,
-		InternalAuthToken:      "",
-		RideServiceAddr:        "ride:50051",
-		RideServiceToken:       "",
+		Surge: SurgeConfig{
+			Enabled:          true,
+			KeyPrefix:        "surge:",
+			WindowSeconds:    300,
+			GeohashPrecision: 6,
+			Threshold:        1,
+			Sensitivity:      0.5,
+			Step:             0.1,
+			MaxMultiplier:    3,
+			Hysteresis:       0.2,
+		},
+		NATSURL:               "nats://nats:4222",
+		NATSSelfHeal:          true,
+		EventsEnabled:         true,
+		RideRequestedSubject:  "ride.requested",
+		DriverLocationSubject: "driver.location.updated",
+		InternalAuthEnabled:   false,
+		InternalAuthToken:     "",
+		RideServiceAddr:       "ride:50051",
+		RideServiceToken:      "",
 		Observability: ObservabilityConfig{
 			MetricsEnabled:  true,
 			MetricsAddr:     ":9096",
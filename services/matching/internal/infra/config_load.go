@@ -29,6 +29,15 @@ func LoadConfig() Config {
 	cfg.MaxOffers = viper.GetInt("matching.max_offers")
 	cfg.AvgSpeedKmh = viper.GetFloat64("matching.avg_speed_kmh")
 	cfg.EtaJitterMs = viper.GetInt("matching.eta_jitter_ms")
+	cfg.Surge.Enabled = viper.GetBool("surge.enabled")
+	cfg.Surge.KeyPrefix = viper.GetString("surge.key_prefix")
+	cfg.Surge.WindowSeconds = viper.GetInt("surge.window_seconds")
+	cfg.Surge.GeohashPrecision = viper.GetInt("surge.geohash_precision")
+	cfg.Surge.Threshold = viper.GetFloat64("surge.threshold")
+	cfg.Surge.Sensitivity = viper.GetFloat64("surge.sensitivity")
+	cfg.Surge.Step = viper.GetFloat64("surge.step")
+	cfg.Surge.MaxMultiplier = viper.GetFloat64("surge.max_multiplier")
+	cfg.Surge.Hysteresis = viper.GetFloat64("surge.hysteresis")
 	cfg.NATSURL = viper.GetString("nats.url")
 	cfg.NATSSelfHeal = viper.GetBool("nats.self_heal")
 	cfg.EventsEnabled = viper.GetBool("events.enabled")
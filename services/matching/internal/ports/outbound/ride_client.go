@@ -8,6 +8,7 @@ import (
 )
 
 type RideService interface {
+	StartMatching(ctx context.Context, in *ridev1.StartMatchingRequest, opts ...grpc.CallOption) (*ridev1.StartMatchingResponse, error)
 	CreateOffer(ctx context.Context, in *ridev1.CreateOfferRequest, opts ...grpc.CallOption) (*ridev1.CreateOfferResponse, error)
 	CancelRide(ctx context.Context, in *ridev1.CancelRideRequest, opts ...grpc.CallOption) (*ridev1.CancelRideResponse, error)
 }
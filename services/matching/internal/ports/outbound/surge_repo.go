@@ -0,0 +1,14 @@
+package outbound
+
+import (
+	"context"
+	"time"
+)
+
+type SurgeRepo interface {
+	RecordDemand(ctx context.Context, cell string, rideID string, at time.Time, window time.Duration) error
+	RecordSupply(ctx context.Context, cell string, driverID string, at time.Time, window time.Duration) error
+	CountSince(ctx context.Context, cell string, since time.Time) (demand int, supply int, err error)
+	GetMultiplier(ctx context.Context, cell string) (float64, bool, error)
+	SetMultiplier(ctx context.Context, cell string, multiplier float64, ttl time.Duration) error
+}
@@ -2,8 +2,10 @@ package main
 
 import (
 	"context"
+	"crypto/ecdh"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
@@ -11,6 +13,7 @@ import (
 	"syscall"
 	"time"
 
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
 	"github.com/daffahilmyf/ride-hailing/services/notify/internal/adapters/broker"
 	"github.com/daffahilmyf/ride-hailing/services/notify/internal/app"
 	"github.com/daffahilmyf/ride-hailing/services/notify/internal/app/workers"
@@ -173,9 +176,20 @@ var serveCmd = &cobra.Command{
 			ensureStream(logger, js, "RIDES", []string{"ride.>"}, cfg.NATSSelfHeal)
 			ensureStream(logger, js, "DRIVERS", []string{"driver.>"}, cfg.NATSSelfHeal)
 
+			pinKey, err := eventsv1.ParsePINPrivateKey(cfg.PickupPINPrivateKey)
+			if err != nil {
+				logger.Fatal("pickup_pin.private_key_invalid", zap.Error(err))
+			}
+
 			consumer := broker.NewConsumer(js)
 			handler := func(subject string, payload []byte) error {
-				n, data, err := toNotification(subject, payload)
+				n, data, err := toNotification(subject, payload, pinKey)
+				if errors.Is(err, eventsv1.ErrSealedPIN) {
+					// Redelivery cannot open it either; the rider gets no PIN
+					// and support overrides it at pickup.
+					logger.Warn("pickup_pin.open_failed", zap.String("subject", subject), zap.Error(err))
+					return nil
+				}
 				if err != nil {
 					if consumeErrors != nil {
 						consumeErrors.Inc()
@@ -246,38 +260,54 @@ var serveCmd = &cobra.Command{
 	},
 }
 
-func toNotification(subject string, payload []byte) (app.Notification, map[string]interface{}, error) {
-	var envelope map[string]interface{}
-	if err := json.Unmarshal(payload, &envelope); err != nil {
+func toNotification(subject string, payload []byte, pinKey *ecdh.PrivateKey) (app.Notification, map[string]interface{}, error) {
+	envelope, err := eventsv1.ParseEnvelope(payload)
+	if err != nil && !errors.Is(err, eventsv1.ErrMissingData) {
 		return app.Notification{}, nil, err
 	}
 
-	data, _ := envelope["data"].(map[string]interface{})
-	if data == nil {
-		data, _ = envelope["payload"].(map[string]interface{})
-	}
-	if data == nil {
-		data = map[string]interface{}{}
+	// Notify forwards every topic as-is, so data stays generic here.
+	data := map[string]interface{}{}
+	if len(envelope.Data) > 0 {
+		_ = json.Unmarshal(envelope.Data, &data)
 	}
 
-	eventType, _ := envelope["type"].(string)
-	traceID, _ := envelope["trace_id"].(string)
-	requestID, _ := envelope["request_id"].(string)
+	eventType := envelope.Type
 	if eventType == "" {
 		eventType = subject
 	}
+	if eventType == eventsv1.TopicRidePinIssued {
+		if err := openPickupPIN(data, pinKey); err != nil {
+			return app.Notification{}, nil, err
+		}
+	}
 
 	n := app.Notification{
 		Event:     eventType,
 		Subject:   subject,
-		TraceID:   traceID,
-		RequestID: requestID,
+		TraceID:   envelope.TraceID,
+		RequestID: envelope.RequestID,
 		Data:      data,
 		Timestamp: time.Now().UTC().Format(time.RFC3339Nano),
+		RiderOnly: eventType == eventsv1.TopicRidePinIssued,
 	}
 	return n, data, nil
 }
 
+// openPickupPIN swaps the sealed PIN in a ride.pin.issued payload for the PIN
+// itself, which only ever goes out to the rider's own stream.
+func openPickupPIN(data map[string]interface{}, key *ecdh.PrivateKey) error {
+	rideID, _ := data["ride_id"].(string)
+	sealed, _ := data["sealed_pin"].(string)
+	pin, err := eventsv1.OpenPIN(key, rideID, sealed)
+	if err != nil {
+		return fmt.Errorf("ride %s: %w", rideID, err)
+	}
+	delete(data, "sealed_pin")
+	data["pin"] = pin
+	return nil
+}
+
 func ensureStream(logger *zap.Logger, js nats.JetStreamContext, name string, subjects []string, selfHeal bool) {
 	if js == nil {
 		return
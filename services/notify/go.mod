@@ -3,6 +3,7 @@ module github.com/daffahilmyf/ride-hailing/services/notify
 go 1.24.0
 
 require (
+	github.com/daffahilmyf/ride-hailing/proto v0.0.0
 	github.com/nats-io/nats.go v1.39.0
 	github.com/prometheus/client_golang v1.23.2
 	github.com/spf13/cobra v1.8.0
@@ -37,9 +38,11 @@ require (
 	go.yaml.in/yaml/v2 v2.4.2 // indirect
 	golang.org/x/crypto v0.31.0 // indirect
 	golang.org/x/exp v0.0.0-20230905200255-921286631fa9 // indirect
-	golang.org/x/sys v0.35.0 // indirect
-	golang.org/x/text v0.28.0 // indirect
-	google.golang.org/protobuf v1.36.8 // indirect
+	golang.org/x/sys v0.38.0 // indirect
+	golang.org/x/text v0.31.0 // indirect
+	google.golang.org/protobuf v1.36.10 // indirect
 	gopkg.in/ini.v1 v1.67.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/daffahilmyf/ride-hailing/proto => ../../proto
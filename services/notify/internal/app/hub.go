@@ -19,6 +19,9 @@ type Notification struct {
 	Data      interface{} `json:"data,omitempty"`
 	Timestamp string      `json:"timestamp"`
 	Schema    int         `json:"schema_version"`
+	// RiderOnly keeps a notification, such as the rider's pickup PIN, from
+	// anyone but the rider it names, even subscribers following the ride.
+	RiderOnly bool `json:"-"`
 }
 
 type Filters struct {
@@ -75,7 +78,7 @@ func (h *Hub) SubscribeWithReplay(id string, filters Filters, lastEventID uint64
 				continue
 			}
 			if nID, err := ParseEventID(n.ID); err == nil && nID > lastEventID {
-				if matches(filters, mapData(n.Data)) {
+				if visible(filters, n, mapData(n.Data)) {
 					select {
 					case s.Ch <- n:
 					default:
@@ -106,7 +109,7 @@ func (h *Hub) Broadcast(n Notification, data map[string]interface{}) (int, int)
 	sent := 0
 	dropped := 0
 	for _, sub := range h.subscribers {
-		if !matches(sub.Filters, data) {
+		if !visible(sub.Filters, n, data) {
 			continue
 		}
 		select {
@@ -120,6 +123,14 @@ func (h *Hub) Broadcast(n Notification, data map[string]interface{}) (int, int)
 	return sent, dropped
 }
 
+func visible(f Filters, n Notification, data map[string]interface{}) bool {
+	if n.RiderOnly {
+		v, ok := data["rider_id"].(string)
+		return ok && f.RiderID != "" && v == f.RiderID
+	}
+	return matches(f, data)
+}
+
 func matches(f Filters, data map[string]interface{}) bool {
 	if f.DriverID == "" && f.RiderID == "" && f.RideID == "" {
 		return true
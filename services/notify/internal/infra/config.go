@@ -13,6 +13,9 @@ type Config struct {
 	SSEKeepaliveSeconds    int
 	ReplayBufferSize       int
 	MetricsEnabled         bool
+	// PickupPINPrivateKey is the base64 X25519 key ride.pin.issued PINs are
+	// sealed to; ride holds only its public half.
+	PickupPINPrivateKey string
 }
 
 func DefaultConfig() Config {
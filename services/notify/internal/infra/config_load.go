@@ -16,5 +16,6 @@ func LoadConfig() Config {
 	cfg.SSEKeepaliveSeconds = viper.GetInt("sse.keepalive_seconds")
 	cfg.ReplayBufferSize = viper.GetInt("sse.replay_buffer_size")
 	cfg.MetricsEnabled = viper.GetBool("observability.metrics_enabled")
+	cfg.PickupPINPrivateKey = viper.GetString("pickup_pin.private_key")
 	return cfg
 }
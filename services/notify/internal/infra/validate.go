@@ -13,6 +13,9 @@ func ValidateConfig(cfg Config) error {
 		if cfg.RideSubject == "" || cfg.DriverSubject == "" {
 			return errors.New("events.*_subject is required when events enabled")
 		}
+		if cfg.PickupPINPrivateKey == "" {
+			return errors.New("pickup_pin.private_key is required when events enabled")
+		}
 	}
 	return nil
 }
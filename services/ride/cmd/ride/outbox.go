@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/adapters/db"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/usecase"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/infra"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var outboxCmd = &cobra.Command{
+	Use:   "outbox",
+	Short: "Inspect and repair outbox messages",
+}
+
+var outboxListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List outbox messages by status, topic and age",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		admin, logger := newOutboxAdmin()
+		defer logger.Sync()
+
+		status, _ := cmd.Flags().GetString("status")
+		topic, _ := cmd.Flags().GetString("topic")
+		olderThan, _ := cmd.Flags().GetDuration("older-than")
+		newerThan, _ := cmd.Flags().GetDuration("newer-than")
+		limit, _ := cmd.Flags().GetInt("limit")
+		cursor, _ := cmd.Flags().GetString("cursor")
+
+		query := usecase.ListOutboxQuery{Status: status, Topic: topic, PageSize: limit, Cursor: cursor}
+		now := time.Now().UTC()
+		if olderThan > 0 {
+			query.CreatedBefore = now.Add(-olderThan)
+		}
+		if newerThan > 0 {
+			query.CreatedAfter = now.Add(-newerThan)
+		}
+		page, err := admin.List(context.Background(), query)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tTOPIC\tSTATUS\tATTEMPTS\tAGE\tLAST_ERROR")
+		for _, msg := range page.Messages {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\n", msg.ID, msg.Topic, msg.Status, msg.AttemptCount, now.Sub(msg.CreatedAt).Truncate(time.Second), msg.LastError)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		if page.NextCursor != "" {
+			fmt.Printf("next cursor: %s\n", page.NextCursor)
+		}
+		return nil
+	},
+}
+
+var outboxInspectCmd = &cobra.Command{
+	Use:   "inspect <id>",
+	Short: "Show one outbox message with its payload and last error",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		admin, logger := newOutboxAdmin()
+		defer logger.Sync()
+
+		msg, err := admin.Get(context.Background(), args[0])
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(msg)
+	},
+}
+
+var outboxReplayCmd = &cobra.Command{
+	Use:   "replay [id...]",
+	Short: "Publish outbox messages again, by ID or by topic and time range",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		admin, logger := newOutboxAdmin()
+		defer logger.Sync()
+
+		action, err := outboxActionFromFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		action.Topic, _ = cmd.Flags().GetString("topic")
+		action.Status, _ = cmd.Flags().GetString("status")
+		if action.CreatedAfter, err = timeFlag(cmd, "from"); err != nil {
+			return err
+		}
+		if action.CreatedBefore, err = timeFlag(cmd, "to"); err != nil {
+			return err
+		}
+		count, err := admin.Replay(context.Background(), action)
+		if err != nil {
+			return err
+		}
+		logger.Info("outbox.admin_replay", zap.String("actor", action.Actor), zap.Int64("rows", count))
+		return nil
+	},
+}
+
+var outboxSkipCmd = &cobra.Command{
+	Use:   "skip <id>...",
+	Short: "Mark poison outbox messages as skipped without publishing them",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		admin, logger := newOutboxAdmin()
+		defer logger.Sync()
+
+		action, err := outboxActionFromFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		count, err := admin.Skip(context.Background(), action)
+		if err != nil {
+			return err
+		}
+		logger.Info("outbox.admin_skip", zap.String("actor", action.Actor), zap.Int64("rows", count))
+		return nil
+	},
+}
+
+var outboxPurgeCmd = &cobra.Command{
+	Use:   "purge <id>...",
+	Short: "Delete outbox messages, keeping their payload in the audit log",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		admin, logger := newOutboxAdmin()
+		defer logger.Sync()
+
+		action, err := outboxActionFromFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		count, err := admin.Purge(context.Background(), action)
+		if err != nil {
+			return err
+		}
+		logger.Info("outbox.admin_purge", zap.String("actor", action.Actor), zap.Int64("rows", count))
+		return nil
+	},
+}
+
+var outboxStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show outbox backlog by status and topic",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		admin, logger := newOutboxAdmin()
+		defer logger.Sync()
+
+		stats, err := admin.Stats(context.Background())
+		if err != nil {
+			return err
+		}
+		now := time.Now().UTC()
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "STATUS\tCOUNT\tOLDEST")
+		for _, row := range stats.Statuses {
+			fmt.Fprintf(w, "%s\t%d\t%s\n", row.Status, row.Count, now.Sub(row.Oldest).Truncate(time.Second))
+		}
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "TOPIC\tPENDING\tPROCESSING\tFAILED")
+		for _, row := range stats.Topics {
+			fmt.Fprintf(w, "%s\t%d\t%d\t%d\n", row.Topic, row.Pending, row.Processing, row.Failed)
+		}
+		return w.Flush()
+	},
+}
+
+func init() {
+	outboxCmd.AddCommand(outboxListCmd, outboxInspectCmd, outboxReplayCmd, outboxSkipCmd, outboxPurgeCmd, outboxStatsCmd)
+
+	outboxListCmd.Flags().String("status", "", "only messages in this status")
+	outboxListCmd.Flags().String("topic", "", "only messages on this topic")
+	outboxListCmd.Flags().Duration("older-than", 0, "only messages created at least this long ago")
+	outboxListCmd.Flags().Duration("newer-than", 0, "only messages created within this long")
+	outboxListCmd.Flags().Int("limit", 50, "messages per page")
+	outboxListCmd.Flags().String("cursor", "", "cursor printed by the previous page")
+
+	outboxReplayCmd.Flags().String("topic", "", "replay messages on this topic")
+	outboxReplayCmd.Flags().String("status", "", "status of messages to replay by range (default FAILED)")
+	outboxReplayCmd.Flags().String("from", "", "replay messages created at or after this RFC3339 time")
+	outboxReplayCmd.Flags().String("to", "", "replay messages created before this RFC3339 time")
+
+	for _, c := range []*cobra.Command{outboxReplayCmd, outboxSkipCmd, outboxPurgeCmd} {
+		c.Flags().String("actor", os.Getenv("USER"), "operator recorded in the audit log")
+		c.Flags().String("note", "", "reason recorded in the audit log (required for skip and purge)")
+	}
+}
+
+func newOutboxAdmin() (*usecase.OutboxAdmin, *zap.Logger) {
+	cfg := infra.LoadConfig()
+	logger := infra.NewLogger()
+
+	pg, err := db.NewPostgres(context.Background(), cfg.PostgresDSN)
+	if err != nil {
+		logger.Fatal("db.connect_failed", zap.Error(err))
+	}
+	return &usecase.OutboxAdmin{Repo: db.NewOutboxRepo(pg.DB), Clock: usecase.SystemClock{}}, logger
+}
+
+func outboxActionFromFlags(cmd *cobra.Command, ids []string) (usecase.OutboxActionCmd, error) {
+	actor, _ := cmd.Flags().GetString("actor")
+	note, _ := cmd.Flags().GetString("note")
+	if actor == "" {
+		return usecase.OutboxActionCmd{}, fmt.Errorf("--actor is required")
+	}
+	return usecase.OutboxActionCmd{IDs: ids, Actor: actor, Note: note}, nil
+}
+
+func timeFlag(cmd *cobra.Command, name string) (time.Time, error) {
+	value, _ := cmd.Flags().GetString(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("--%s: %w", name, err)
+	}
+	return t.UTC(), nil
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/adapters/db"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/usecase"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/infra"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var payoutCmd = &cobra.Command{
+	Use:   "payout",
+	Short: "Settle driver balances in a payout batch",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg := infra.LoadConfig()
+		logger := infra.NewLogger()
+		defer logger.Sync()
+
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		minAmount := cfg.Ledger.PayoutMinAmount
+		if cmd.Flags().Changed("min-amount") {
+			minAmount, _ = cmd.Flags().GetInt64("min-amount")
+		}
+
+		pg, err := db.NewPostgres(context.Background(), cfg.PostgresDSN)
+		if err != nil {
+			logger.Fatal("db.connect_failed", zap.Error(err))
+		}
+
+		uc := &usecase.RideService{
+			Repo:      db.NewRideRepo(pg.DB),
+			TxManager: db.NewTxManager(pg.DB),
+			Outbox:    db.NewOutboxRepo(pg.DB),
+			Clock:     usecase.SystemClock{},
+			IDGen:     uuid.NewString,
+		}
+		batch, err := uc.RunPayouts(context.Background(), usecase.PayoutCmd{
+			MinAmount: minAmount,
+			Limit:     cfg.Ledger.PayoutBatchSize,
+			DryRun:    dryRun,
+		})
+		if err != nil {
+			logger.Fatal("payout.failed", zap.Error(err))
+		}
+		for _, payout := range batch.Payouts {
+			logger.Info("payout.driver",
+				zap.String("batch_id", batch.ID),
+				zap.String("driver_id", payout.DriverID),
+				zap.Int64("amount", payout.Amount),
+				zap.String("currency", payout.Currency),
+				zap.Bool("dry_run", dryRun),
+			)
+		}
+		logger.Info("payout.batch", zap.String("batch_id", batch.ID), zap.Int("payouts", len(batch.Payouts)), zap.Bool("dry_run", dryRun))
+		return nil
+	},
+}
+
+func init() {
+	payoutCmd.Flags().Bool("dry-run", false, "report balances that would be paid without posting")
+	payoutCmd.Flags().Int64("min-amount", 0, "smallest balance to pay out in minor units (default ledger.payout_min_amount)")
+}
@@ -26,42 +26,86 @@ func init() {
 	cobra.OnInitialize(initConfig)
 	rootCmd.AddCommand(serveCmd)
 	rootCmd.AddCommand(replayOutboxCmd)
+	rootCmd.AddCommand(outboxCmd)
+	rootCmd.AddCommand(payoutCmd)
 
 	rootCmd.PersistentFlags().String("config", "", "config file (default is ./config/config.yaml)")
 	rootCmd.PersistentFlags().String("grpc.addr", ":50051", "gRPC listen address")
 	rootCmd.PersistentFlags().String("postgres.dsn", "", "PostgreSQL DSN")
 	rootCmd.PersistentFlags().Int("shutdown.timeout", 10, "shutdown timeout in seconds")
 	rootCmd.PersistentFlags().Int("idempotency.ttl", 86400, "idempotency key TTL in seconds")
+	rootCmd.PersistentFlags().Int("idempotency.lease", 30, "seconds a request holds its idempotency key before it can be taken over")
+	rootCmd.PersistentFlags().Int("idempotency.wait", 2000, "milliseconds a duplicate request waits for the first to finish")
 	rootCmd.PersistentFlags().String("nats.url", "", "NATS URL")
 	rootCmd.PersistentFlags().Bool("outbox.enabled", true, "enable outbox publisher")
 	rootCmd.PersistentFlags().Int("outbox.interval_millis", 2000, "outbox polling interval in milliseconds")
 	rootCmd.PersistentFlags().Int("outbox.batch_size", 25, "outbox publish batch size")
 	rootCmd.PersistentFlags().Int("outbox.max_attempts", 10, "outbox max attempts per message")
 	rootCmd.PersistentFlags().Int("outbox.retention_hours", 168, "outbox retention in hours")
+	rootCmd.PersistentFlags().Int("outbox.lease_seconds", 60, "seconds a claimed outbox batch may stay in processing before it is reclaimed")
+	rootCmd.PersistentFlags().Int("outbox.reap_interval_millis", 10000, "outbox lease reaper interval in milliseconds")
+	rootCmd.PersistentFlags().Bool("outbox.listen_enabled", true, "wake the outbox publisher on postgres notifications")
 	rootCmd.PersistentFlags().Bool("offer_expiry.enabled", true, "enable offer expiry worker")
 	rootCmd.PersistentFlags().Int("offer_expiry.interval_millis", 5000, "offer expiry interval in milliseconds")
 	rootCmd.PersistentFlags().Int("offer_expiry.batch_size", 50, "offer expiry batch size")
+	rootCmd.PersistentFlags().Bool("scheduler.enabled", true, "enable scheduled ride worker")
+	rootCmd.PersistentFlags().Int("scheduler.interval_millis", 30000, "scheduled ride scan interval in milliseconds")
+	rootCmd.PersistentFlags().Int("scheduler.dispatch_lead_seconds", 900, "seconds before pickup to start matching a scheduled ride")
+	rootCmd.PersistentFlags().Int("cancellation.free_window_seconds", 120, "seconds after driver assignment a rider may cancel without a fee")
+	rootCmd.PersistentFlags().Int64("cancellation.rider_fee", 5000, "rider cancellation fee in minor currency units")
+	rootCmd.PersistentFlags().Bool("pickup_pin.enabled", true, "require the rider's pickup PIN to start a ride")
+	rootCmd.PersistentFlags().Int("pickup_pin.max_attempts", 3, "wrong pickup PIN entries before the PIN locks")
+	rootCmd.PersistentFlags().Float64("arrival.radius_meters", 100, "distance from the pickup at which the driver counts as arrived")
+	rootCmd.PersistentFlags().Int("arrival.grace_seconds", 300, "seconds the driver waits at the pickup before a rider no-show")
+	rootCmd.PersistentFlags().Bool("stuck_rides.enabled", true, "enable stuck ride SLA worker")
+	rootCmd.PersistentFlags().Int("stuck_rides.max_redispatches", 2, "times a stuck ride is redispatched before it times out")
+	rootCmd.PersistentFlags().Int("ratings.window_hours", 72, "hours after completion a ride may be rated")
+	rootCmd.PersistentFlags().Bool("payments.enabled", true, "hold, capture and void ride payments")
+	rootCmd.PersistentFlags().String("payments.provider", "fake", "payment provider")
+	rootCmd.PersistentFlags().Float64("ledger.commission_percent", 20, "platform commission in percent for products without their own rate")
 	rootCmd.PersistentFlags().Bool("internal_auth.enabled", false, "enable internal gRPC auth")
 	rootCmd.PersistentFlags().String("internal_auth.token", "", "internal auth token")
 	rootCmd.PersistentFlags().String("grpc.user_addr", "user:50054", "user service gRPC address")
+	rootCmd.PersistentFlags().String("grpc.matching_addr", "matching:50052", "matching service gRPC address")
 
 	_ = viper.BindPFlag("config", rootCmd.PersistentFlags().Lookup("config"))
 	_ = viper.BindPFlag("grpc.addr", rootCmd.PersistentFlags().Lookup("grpc.addr"))
 	_ = viper.BindPFlag("postgres.dsn", rootCmd.PersistentFlags().Lookup("postgres.dsn"))
 	_ = viper.BindPFlag("shutdown.timeout", rootCmd.PersistentFlags().Lookup("shutdown.timeout"))
 	_ = viper.BindPFlag("idempotency.ttl_seconds", rootCmd.PersistentFlags().Lookup("idempotency.ttl"))
+	_ = viper.BindPFlag("idempotency.lease_seconds", rootCmd.PersistentFlags().Lookup("idempotency.lease"))
+	_ = viper.BindPFlag("idempotency.wait_millis", rootCmd.PersistentFlags().Lookup("idempotency.wait"))
 	_ = viper.BindPFlag("nats.url", rootCmd.PersistentFlags().Lookup("nats.url"))
 	_ = viper.BindPFlag("outbox.enabled", rootCmd.PersistentFlags().Lookup("outbox.enabled"))
 	_ = viper.BindPFlag("outbox.interval_millis", rootCmd.PersistentFlags().Lookup("outbox.interval_millis"))
 	_ = viper.BindPFlag("outbox.batch_size", rootCmd.PersistentFlags().Lookup("outbox.batch_size"))
 	_ = viper.BindPFlag("outbox.max_attempts", rootCmd.PersistentFlags().Lookup("outbox.max_attempts"))
 	_ = viper.BindPFlag("outbox.retention_hours", rootCmd.PersistentFlags().Lookup("outbox.retention_hours"))
+	_ = viper.BindPFlag("outbox.lease_seconds", rootCmd.PersistentFlags().Lookup("outbox.lease_seconds"))
+	_ = viper.BindPFlag("outbox.reap_interval_millis", rootCmd.PersistentFlags().Lookup("outbox.reap_interval_millis"))
+	_ = viper.BindPFlag("outbox.listen_enabled", rootCmd.PersistentFlags().Lookup("outbox.listen_enabled"))
 	_ = viper.BindPFlag("offer_expiry.enabled", rootCmd.PersistentFlags().Lookup("offer_expiry.enabled"))
 	_ = viper.BindPFlag("offer_expiry.interval_millis", rootCmd.PersistentFlags().Lookup("offer_expiry.interval_millis"))
 	_ = viper.BindPFlag("offer_expiry.batch_size", rootCmd.PersistentFlags().Lookup("offer_expiry.batch_size"))
+	_ = viper.BindPFlag("scheduler.enabled", rootCmd.PersistentFlags().Lookup("scheduler.enabled"))
+	_ = viper.BindPFlag("scheduler.interval_millis", rootCmd.PersistentFlags().Lookup("scheduler.interval_millis"))
+	_ = viper.BindPFlag("scheduler.dispatch_lead_seconds", rootCmd.PersistentFlags().Lookup("scheduler.dispatch_lead_seconds"))
+	_ = viper.BindPFlag("cancellation.free_window_seconds", rootCmd.PersistentFlags().Lookup("cancellation.free_window_seconds"))
+	_ = viper.BindPFlag("cancellation.rider_fee", rootCmd.PersistentFlags().Lookup("cancellation.rider_fee"))
+	_ = viper.BindPFlag("pickup_pin.enabled", rootCmd.PersistentFlags().Lookup("pickup_pin.enabled"))
+	_ = viper.BindPFlag("pickup_pin.max_attempts", rootCmd.PersistentFlags().Lookup("pickup_pin.max_attempts"))
+	_ = viper.BindPFlag("arrival.radius_meters", rootCmd.PersistentFlags().Lookup("arrival.radius_meters"))
+	_ = viper.BindPFlag("arrival.grace_seconds", rootCmd.PersistentFlags().Lookup("arrival.grace_seconds"))
+	_ = viper.BindPFlag("stuck_rides.enabled", rootCmd.PersistentFlags().Lookup("stuck_rides.enabled"))
+	_ = viper.BindPFlag("stuck_rides.max_redispatches", rootCmd.PersistentFlags().Lookup("stuck_rides.max_redispatches"))
+	_ = viper.BindPFlag("ratings.window_hours", rootCmd.PersistentFlags().Lookup("ratings.window_hours"))
+	_ = viper.BindPFlag("payments.enabled", rootCmd.PersistentFlags().Lookup("payments.enabled"))
+	_ = viper.BindPFlag("payments.provider", rootCmd.PersistentFlags().Lookup("payments.provider"))
+	_ = viper.BindPFlag("ledger.commission_percent", rootCmd.PersistentFlags().Lookup("ledger.commission_percent"))
 	_ = viper.BindPFlag("internal_auth.enabled", rootCmd.PersistentFlags().Lookup("internal_auth.enabled"))
 	_ = viper.BindPFlag("internal_auth.token", rootCmd.PersistentFlags().Lookup("internal_auth.token"))
 	_ = viper.BindPFlag("grpc.user_addr", rootCmd.PersistentFlags().Lookup("grpc.user_addr"))
+	_ = viper.BindPFlag("grpc.matching_addr", rootCmd.PersistentFlags().Lookup("grpc.matching_addr"))
 }
 
 func initConfig() {
@@ -2,19 +2,23 @@ package main
 
 import (
 	"context"
+	"math"
 	"net"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
 	"github.com/daffahilmyf/ride-hailing/services/ride/internal/adapters/broker"
 	"github.com/daffahilmyf/ride-hailing/services/ride/internal/adapters/db"
 	grpcadapter "github.com/daffahilmyf/ride-hailing/services/ride/internal/adapters/grpc"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/adapters/payment"
 	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/handlers"
 	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/metrics"
 	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/usecase"
 	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/workers"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
 	"github.com/daffahilmyf/ride-hailing/services/ride/internal/infra"
 	"github.com/google/uuid"
 	"github.com/nats-io/nats.go"
@@ -46,13 +50,28 @@ var serveCmd = &cobra.Command{
 		outbox := db.NewOutboxRepo(pg.DB)
 		offers := db.NewRideOfferRepo(pg.DB)
 		txMgr := db.NewTxManager(pg.DB)
+		pricing := newPricing(logger, cfg.Pricing)
 		uc := &usecase.RideService{
-			Repo:         repo,
-			Idempotency:  idem,
+			Repo:        repo,
+			Idempotency: idem,
+			IdempotencyPolicy: usecase.IdempotencyPolicy{
+				Lease: time.Duration(cfg.IdempotencyLeaseSec) * time.Second,
+				Wait:  time.Duration(cfg.IdempotencyWaitMillis) * time.Millisecond,
+			},
 			TxManager:    txMgr,
 			Outbox:       outbox,
 			Offers:       offers,
 			OfferMetrics: &usecase.OfferMetrics{},
+			Pricing:      pricing,
+			Scheduling:   newSchedulePolicy(cfg.Scheduler),
+			Cancellation: newCancellationPolicy(cfg.Cancellation),
+			Ratings:      newRatingPolicy(cfg.Ratings),
+			Payments:     newPayments(logger, cfg.Payments),
+			Commission:   newCommissionPolicy(cfg.Ledger),
+			CancelSigner: pricing.Signer,
+			PickupPINs:   newPickupPINs(logger, cfg.PickupPIN),
+			Arrival:      newArrivalPolicy(cfg.Arrival),
+			StuckRides:   newStuckRidePolicy(cfg.StuckRides),
 			Clock:        usecase.SystemClock{},
 			IDGen:        uuid.NewString,
 		}
@@ -110,8 +129,21 @@ var serveCmd = &cobra.Command{
 			uc.UserClient = grpcadapter.NewUserClientWithToken(userClient, cfg.InternalAuthToken)
 		}
 
+		if cfg.Pricing.SurgeEnabled && uc.Pricing != nil {
+			matchingClient, err := grpcadapter.NewMatchingClient(cfg.MatchingAddr, cfg.InternalAuthToken, time.Second)
+			if err != nil {
+				logger.Warn("matching_client.connect_failed", zap.Error(err))
+			} else {
+				defer matchingClient.Close()
+				uc.Pricing.Surge = matchingClient
+			}
+		}
+
 		grpcMetrics := grpcadapter.NewMetrics()
-		srv := grpcadapter.NewServer(logger, handlers.Dependencies{Usecase: uc}, grpcMetrics, grpcadapter.AuthConfig{
+		srv := grpcadapter.NewServer(logger, handlers.Dependencies{
+			Usecase:     uc,
+			OutboxAdmin: &usecase.OutboxAdmin{Repo: outbox, Clock: usecase.SystemClock{}},
+		}, grpcMetrics, grpcadapter.AuthConfig{
 			Enabled: cfg.InternalAuthEnabled,
 			Token:   cfg.InternalAuthToken,
 		})
@@ -122,6 +154,11 @@ var serveCmd = &cobra.Command{
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
+		reporter := &workers.MetricsReporter{
+			Logger:   logger,
+			Interval: 30 * time.Second,
+		}
+
 		if cfg.OutboxEnabled {
 			nc, err := nats.Connect(cfg.NATSURL)
 			if err != nil {
@@ -144,15 +181,26 @@ var serveCmd = &cobra.Command{
 				BatchSize:   cfg.OutboxBatchSize,
 				MaxAttempts: cfg.OutboxMaxAttempts,
 				Interval:    time.Duration(cfg.OutboxIntervalMillis) * time.Millisecond,
+				Lease:       time.Duration(cfg.OutboxLeaseSeconds) * time.Second,
+			}
+			if cfg.OutboxListenEnabled {
+				wake := make(chan struct{}, 1)
+				go db.NewOutboxListener(cfg.PostgresDSN, logger).Run(ctx, wake)
+				worker.Wake = wake
 			}
 			go worker.Run(ctx)
 
-			reporter := &workers.MetricsReporter{
-				Outbox:   outboxMetrics,
-				Logger:   logger,
-				Interval: 30 * time.Second,
+			reaper := &workers.OutboxReaper{
+				Repo:        outbox,
+				Logger:      logger,
+				Metrics:     outboxMetrics,
+				BatchSize:   100,
+				MaxAttempts: cfg.OutboxMaxAttempts,
+				Interval:    time.Duration(cfg.OutboxReapIntervalMs) * time.Millisecond,
 			}
-			go reporter.Run(ctx)
+			go reaper.Run(ctx)
+
+			reporter.Outbox = outboxMetrics
 
 			retention := time.Duration(cfg.OutboxRetentionHours) * time.Hour
 			cleanup := &workers.OutboxCleanupWorker{
@@ -162,17 +210,70 @@ var serveCmd = &cobra.Command{
 				Interval:  24 * time.Hour,
 			}
 			go cleanup.Run(ctx)
+
+			if cfg.Arrival.GeofenceEnabled {
+				ensureStream(logger, js, "DRIVERS", []string{"driver.>"}, cfg.NATSSelfHeal)
+				arrivals := &workers.EventConsumer{
+					Consumer: broker.NewConsumer(js),
+					Subject:  cfg.Arrival.DriverLocationSubject,
+					Durable:  "ride-driver-arrival",
+					Batch:    50,
+					Logger:   logger,
+					Handler:  uc.HandleDriverLocation,
+				}
+				go func() {
+					if err := arrivals.Run(ctx); err != nil {
+						logger.Warn("event.consumer_stopped", zap.String("subject", cfg.Arrival.DriverLocationSubject), zap.Error(err))
+					}
+				}()
+			}
 		}
 
 		if cfg.OfferExpiryEnabled {
+			expiryMetrics := &metrics.OfferExpiryMetrics{}
 			expiry := &workers.OfferExpiryWorker{
-				Repo:     offers,
 				Usecase:  uc,
+				Metrics:  expiryMetrics,
 				Logger:   logger,
 				Interval: time.Duration(cfg.OfferExpiryIntervalMs) * time.Millisecond,
 				Batch:    cfg.OfferExpiryBatchSize,
 			}
 			go expiry.Run(ctx)
+			reporter.OfferExpiry = expiryMetrics
+		}
+
+		if cfg.StuckRides.Enabled {
+			stuckMetrics := &metrics.StuckRideMetrics{}
+			stuck := &workers.StuckRideWorker{
+				Usecase:  uc,
+				Metrics:  stuckMetrics,
+				Logger:   logger,
+				Interval: time.Duration(cfg.StuckRides.IntervalMs) * time.Millisecond,
+				Batch:    cfg.StuckRides.BatchSize,
+			}
+			go stuck.Run(ctx)
+			reporter.StuckRides = stuckMetrics
+		}
+		go reporter.Run(ctx)
+
+		if cfg.Scheduler.Enabled {
+			scheduler := &workers.ScheduledRideWorker{
+				Usecase:  uc,
+				Logger:   logger,
+				Interval: time.Duration(cfg.Scheduler.IntervalMs) * time.Millisecond,
+				Batch:    cfg.Scheduler.BatchSize,
+			}
+			go scheduler.Run(ctx)
+		}
+
+		if uc.Payments != nil {
+			payments := &workers.PaymentWorker{
+				Usecase:  uc,
+				Logger:   logger,
+				Interval: time.Duration(cfg.Payments.IntervalMs) * time.Millisecond,
+				Batch:    cfg.Payments.BatchSize,
+			}
+			go payments.Run(ctx)
 		}
 
 		lis, err := net.Listen("tcp", cfg.GRPCAddr)
@@ -247,6 +348,131 @@ func ensureStream(logger *zap.Logger, js nats.JetStreamContext, name string, sub
 	logger.Info("nats.stream_created", zap.String("stream", name))
 }
 
+func newSchedulePolicy(cfg infra.SchedulerConfig) domain.SchedulePolicy {
+	return domain.SchedulePolicy{
+		MinAdvance:      time.Duration(cfg.MinAdvanceSeconds) * time.Second,
+		MaxAdvance:      time.Duration(cfg.MaxAdvanceSeconds) * time.Second,
+		DispatchLead:    time.Duration(cfg.DispatchLeadSeconds) * time.Second,
+		ReminderLead:    time.Duration(cfg.ReminderLeadSeconds) * time.Second,
+		DispatchTimeout: time.Duration(cfg.DispatchTimeoutSeconds) * time.Second,
+	}
+}
+
+func newCancellationPolicy(cfg infra.CancellationConfig) domain.CancellationPolicy {
+	return domain.CancellationPolicy{
+		FreeWindow:   time.Duration(cfg.FreeWindowSeconds) * time.Second,
+		RiderFee:     cfg.RiderFee,
+		StrikeWindow: time.Duration(cfg.StrikeWindowHours) * time.Hour,
+		ConfirmTTL:   time.Duration(cfg.ConfirmTTLSeconds) * time.Second,
+	}
+}
+
+func newPickupPINs(logger *zap.Logger, cfg infra.PickupPINConfig) *usecase.PickupPINs {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.Secret == "" {
+		logger.Fatal("pickup_pin.secret_missing", zap.String("hint", "set pickup_pin.secret or disable pickup_pin.enabled"))
+	}
+	notifyKey, err := eventsv1.ParsePINPublicKey(cfg.NotifyPublicKey)
+	if err != nil {
+		logger.Fatal("pickup_pin.notify_public_key_invalid", zap.String("hint", "set pickup_pin.notify_public_key to notify's base64 X25519 public key"), zap.Error(err))
+	}
+	return &usecase.PickupPINs{
+		Secret:    []byte(cfg.Secret),
+		NotifyKey: notifyKey,
+		Policy: domain.PickupPINPolicy{
+			Length:      cfg.Length,
+			MaxAttempts: cfg.MaxAttempts,
+		},
+	}
+}
+
+func newArrivalPolicy(cfg infra.ArrivalConfig) domain.ArrivalPolicy {
+	return domain.ArrivalPolicy{
+		RadiusMeters: cfg.RadiusMeters,
+		GracePeriod:  time.Duration(cfg.GraceSeconds) * time.Second,
+		NoShowFee:    cfg.NoShowFee,
+	}
+}
+
+func newStuckRidePolicy(cfg infra.StuckRidesConfig) domain.StuckRidePolicy {
+	return domain.StuckRidePolicy{
+		Requested:       time.Duration(cfg.RequestedSeconds) * time.Second,
+		Matching:        time.Duration(cfg.MatchingSeconds) * time.Second,
+		Offered:         time.Duration(cfg.OfferedSeconds) * time.Second,
+		MaxRedispatches: cfg.MaxRedispatches,
+	}
+}
+
+func newRatingPolicy(cfg infra.RatingsConfig) domain.RatingPolicy {
+	return domain.RatingPolicy{
+		Window:        time.Duration(cfg.WindowHours) * time.Hour,
+		MaxTags:       cfg.MaxTags,
+		MaxCommentLen: cfg.MaxCommentLength,
+	}
+}
+
+func newCommissionPolicy(cfg infra.LedgerConfig) domain.CommissionPolicy {
+	products := make(map[string]int, len(cfg.ProductCommissionPercent))
+	for name, percent := range cfg.ProductCommissionPercent {
+		products[name] = percentToBps(percent)
+	}
+	return domain.CommissionPolicy{
+		DefaultBps: percentToBps(cfg.CommissionPercent),
+		ByProduct:  products,
+	}
+}
+
+func percentToBps(percent float64) int {
+	return int(math.Round(percent * 100))
+}
+
+func newPayments(logger *zap.Logger, cfg infra.PaymentsConfig) *usecase.Payments {
+	if !cfg.Enabled {
+		return nil
+	}
+	switch cfg.Provider {
+	case payment.FakeProviderName:
+		logger.Warn("payments.fake_provider", zap.String("hint", "no real money moves; do not use in production"))
+		return &usecase.Payments{
+			Provider:    payment.NewFakeProvider(cfg.FakeDeclineAbove),
+			Name:        payment.FakeProviderName,
+			MaxAttempts: cfg.MaxAttempts,
+		}
+	default:
+		logger.Fatal("payments.unknown_provider", zap.String("provider", cfg.Provider))
+		return nil
+	}
+}
+
+func newPricing(logger *zap.Logger, cfg infra.PricingConfig) *usecase.Pricing {
+	// Fare quotes and cancellation confirm tokens must verify on whichever
+	// instance the client reaches next, so the key has to be shared.
+	if cfg.QuoteSecret == "" {
+		logger.Fatal("pricing.quote_secret_missing", zap.String("hint", "set pricing.quote_secret"))
+	}
+	secret := []byte(cfg.QuoteSecret)
+	products := make(map[string]domain.FareRule, len(cfg.Products))
+	for name, p := range cfg.Products {
+		products[name] = domain.FareRule{
+			BaseFare:    p.BaseFare,
+			PerKm:       p.PerKm,
+			PerMinute:   p.PerMinute,
+			MinimumFare: p.MinimumFare,
+			BookingFee:  p.BookingFee,
+		}
+	}
+	return &usecase.Pricing{
+		Products:       products,
+		DefaultProduct: cfg.DefaultProduct,
+		Currency:       cfg.Currency,
+		AvgSpeedKmh:    cfg.AvgSpeedKmh,
+		QuoteTTL:       time.Duration(cfg.QuoteTTLSeconds) * time.Second,
+		Signer:         usecase.QuoteSigner{Secret: secret},
+	}
+}
+
 func startIdempotencyCleanup(logger *zap.Logger, cleaner *db.IdempotencyCleanup, ttl time.Duration) {
 	if cleaner == nil || ttl <= 0 {
 		return
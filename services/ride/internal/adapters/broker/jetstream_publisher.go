@@ -16,13 +16,24 @@ func NewPublisher(js nats.JetStreamContext) *Publisher {
 }
 
 func (p *Publisher) Publish(ctx context.Context, subject string, payload []byte) error {
+	return p.PublishMsg(ctx, subject, "", payload)
+}
+
+// PublishMsg sets Nats-Msg-Id so JetStream drops a redelivery of the same
+// message within the stream's duplicate window.
+func (p *Publisher) PublishMsg(ctx context.Context, subject string, msgID string, payload []byte) error {
 	if p == nil || p.js == nil {
 		return nil
 	}
-	_, err := p.js.PublishMsg(&nats.Msg{
+	msg := &nats.Msg{
 		Subject: subject,
 		Data:    payload,
-	}, nats.Context(ctx))
+	}
+	if msgID != "" {
+		msg.Header = nats.Header{}
+		msg.Header.Set(nats.MsgIdHdr, msgID)
+	}
+	_, err := p.js.PublishMsg(msg, nats.Context(ctx))
 	return err
 }
 
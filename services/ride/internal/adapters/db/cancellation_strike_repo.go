@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+	"gorm.io/gorm/clause"
+)
+
+type cancellationStrikeModel struct {
+	ID        string    `gorm:"column:id;primaryKey"`
+	UserID    string    `gorm:"column:user_id"`
+	Role      string    `gorm:"column:role"`
+	RideID    string    `gorm:"column:ride_id"`
+	Reason    string    `gorm:"column:reason"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+func (cancellationStrikeModel) TableName() string {
+	return "cancellation_strikes"
+}
+
+func (r *RideRepo) AddStrike(ctx context.Context, strike outbound.CancellationStrike) error {
+	return r.DB.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&cancellationStrikeModel{
+			ID:        strike.ID,
+			UserID:    strike.UserID,
+			Role:      strike.Role,
+			RideID:    strike.RideID,
+			Reason:    strike.Reason,
+			CreatedAt: strike.CreatedAt,
+		}).Error
+}
+
+func (r *RideRepo) CountStrikes(ctx context.Context, userID string, since time.Time) (int, error) {
+	var count int64
+	if err := r.DB.WithContext(ctx).Model(&cancellationStrikeModel{}).
+		Where("user_id = ? AND created_at >= ?", userID, since).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -17,32 +18,96 @@ func NewIdempotencyRepo(db *gorm.DB) *IdempotencyRepo {
 }
 
 type idempotencyModel struct {
-	ID           string    `gorm:"column:id;primaryKey"`
-	Key          string    `gorm:"column:key"`
-	ResponseBody string    `gorm:"column:response_body"`
-	CreatedAt    time.Time `gorm:"column:created_at"`
+	ID           string     `gorm:"column:id;primaryKey"`
+	Scope        string     `gorm:"column:scope"`
+	Key          string     `gorm:"column:key"`
+	RequestHash  string     `gorm:"column:request_hash"`
+	Status       string     `gorm:"column:status"`
+	ResponseBody *string    `gorm:"column:response_body"`
+	LockedUntil  *time.Time `gorm:"column:locked_until"`
+	CreatedAt    time.Time  `gorm:"column:created_at"`
 }
 
 func (idempotencyModel) TableName() string { return "idempotency_keys" }
 
-func (r *IdempotencyRepo) Get(ctx context.Context, key string) (string, bool, error) {
+// Reserve relies on the (scope, key) unique index: the insert only lands for a
+// fresh key, and the conflict update only fires for a lapsed reservation of
+// the same request. locked_until doubles as the holder's claim token, so it is
+// stored at the column's microsecond precision to compare equal on settle.
+func (r *IdempotencyRepo) Reserve(ctx context.Context, record outbound.IdempotencyRecord, now time.Time) (outbound.IdempotencyRecord, bool, error) {
+	record.LockedUntil = record.LockedUntil.Truncate(time.Microsecond)
+	result := r.DB.WithContext(ctx).Exec(`
+		INSERT INTO idempotency_keys (id, scope, key, request_hash, status, locked_until, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (scope, key) DO UPDATE
+		SET locked_until = EXCLUDED.locked_until
+		WHERE idempotency_keys.status = ?
+		  AND idempotency_keys.request_hash = EXCLUDED.request_hash
+		  AND idempotency_keys.locked_until < ?`,
+		uuid.NewString(), record.Scope, record.Key, record.RequestHash, outbound.IdempotencyInProgress, record.LockedUntil, record.CreatedAt,
+		outbound.IdempotencyInProgress, now,
+	)
+	if result.Error != nil {
+		return outbound.IdempotencyRecord{}, false, result.Error
+	}
+	if result.RowsAffected > 0 {
+		return record, true, nil
+	}
+	existing, ok, err := r.Get(ctx, record.Scope, record.Key)
+	if err != nil {
+		return outbound.IdempotencyRecord{}, false, err
+	}
+	if !ok {
+		// Released between the insert and the read; let the caller retry.
+		return outbound.IdempotencyRecord{Scope: record.Scope, Key: record.Key, RequestHash: record.RequestHash, Status: outbound.IdempotencyInProgress}, false, nil
+	}
+	return existing, false, nil
+}
+
+func (r *IdempotencyRepo) Get(ctx context.Context, scope, key string) (outbound.IdempotencyRecord, bool, error) {
 	var m idempotencyModel
-	err := r.DB.WithContext(ctx).First(&m, "key = ?", key).Error
+	err := r.DB.WithContext(ctx).First(&m, "scope = ? AND key = ?", scope, key).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return "", false, nil
+			return outbound.IdempotencyRecord{}, false, nil
 		}
-		return "", false, err
+		return outbound.IdempotencyRecord{}, false, err
 	}
-	return m.ResponseBody, true, nil
+	record := outbound.IdempotencyRecord{
+		Scope:       m.Scope,
+		Key:         m.Key,
+		RequestHash: m.RequestHash,
+		Status:      m.Status,
+		CreatedAt:   m.CreatedAt,
+	}
+	if m.ResponseBody != nil {
+		record.Response = *m.ResponseBody
+	}
+	if m.LockedUntil != nil {
+		record.LockedUntil = *m.LockedUntil
+	}
+	return record, true, nil
 }
 
-func (r *IdempotencyRepo) Save(ctx context.Context, key string, response string) error {
-	m := idempotencyModel{
-		ID:           uuid.NewString(),
-		Key:          key,
-		ResponseBody: response,
-		CreatedAt:    time.Now().UTC(),
+func (r *IdempotencyRepo) Complete(ctx context.Context, reservation outbound.IdempotencyRecord, response string) error {
+	result := r.DB.WithContext(ctx).Model(&idempotencyModel{}).
+		Where("scope = ? AND key = ? AND status = ? AND locked_until = ?", reservation.Scope, reservation.Key, outbound.IdempotencyInProgress, reservation.LockedUntil).
+		Updates(map[string]interface{}{
+			"status":        outbound.IdempotencyCompleted,
+			"response_body": response,
+			"locked_until":  nil,
+		})
+	if result.Error != nil {
+		return result.Error
 	}
-	return r.DB.WithContext(ctx).Create(&m).Error
+	if result.RowsAffected == 0 {
+		return outbound.ErrConflict
+	}
+	return nil
+}
+
+func (r *IdempotencyRepo) Release(ctx context.Context, reservation outbound.IdempotencyRecord) error {
+	return r.DB.WithContext(ctx).
+		Where("scope = ? AND key = ? AND status = ? AND locked_until = ?", reservation.Scope, reservation.Key, outbound.IdempotencyInProgress, reservation.LockedUntil).
+		Delete(&idempotencyModel{}).Error
 }
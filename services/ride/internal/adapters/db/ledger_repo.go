@@ -0,0 +1,249 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+	"gorm.io/gorm/clause"
+)
+
+type ledgerAccountModel struct {
+	ID        string    `gorm:"column:id;primaryKey"`
+	OwnerType string    `gorm:"column:owner_type"`
+	OwnerID   string    `gorm:"column:owner_id"`
+	Currency  string    `gorm:"column:currency"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+func (ledgerAccountModel) TableName() string {
+	return "ledger_accounts"
+}
+
+type ledgerTransactionModel struct {
+	ID        string    `gorm:"column:id;primaryKey"`
+	Reference string    `gorm:"column:reference"`
+	RideID    *string   `gorm:"column:ride_id"`
+	Currency  string    `gorm:"column:currency"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+func (ledgerTransactionModel) TableName() string {
+	return "ledger_transactions"
+}
+
+type ledgerEntryModel struct {
+	ID            int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	TransactionID string    `gorm:"column:transaction_id"`
+	AccountID     string    `gorm:"column:account_id"`
+	Direction     string    `gorm:"column:direction"`
+	Kind          string    `gorm:"column:kind"`
+	Amount        int64     `gorm:"column:amount"`
+	RideID        *string   `gorm:"column:ride_id"`
+	CreatedAt     time.Time `gorm:"column:created_at"`
+}
+
+func (ledgerEntryModel) TableName() string {
+	return "ledger_entries"
+}
+
+func (r *RideRepo) PostLedger(ctx context.Context, posting outbound.LedgerPosting) error {
+	var rideID *string
+	if posting.RideID != "" {
+		rideID = &posting.RideID
+	}
+	result := r.DB.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "reference"}}, DoNothing: true}).
+		Create(&ledgerTransactionModel{
+			ID:        posting.ID,
+			Reference: posting.Reference,
+			RideID:    rideID,
+			Currency:  posting.Currency,
+			CreatedAt: posting.CreatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return outbound.ErrConflict
+	}
+
+	accounts := make([]ledgerAccountModel, 0, len(posting.Entries))
+	entries := make([]ledgerEntryModel, 0, len(posting.Entries))
+	seen := make(map[string]bool, len(posting.Entries))
+	for _, entry := range posting.Entries {
+		if !seen[entry.AccountID] {
+			seen[entry.AccountID] = true
+			accounts = append(accounts, ledgerAccountModel{
+				ID:        entry.AccountID,
+				OwnerType: entry.OwnerType,
+				OwnerID:   entry.OwnerID,
+				Currency:  posting.Currency,
+				CreatedAt: posting.CreatedAt,
+			})
+		}
+		entries = append(entries, ledgerEntryModel{
+			TransactionID: posting.ID,
+			AccountID:     entry.AccountID,
+			Direction:     entry.Direction,
+			Kind:          entry.Kind,
+			Amount:        entry.Amount,
+			RideID:        rideID,
+			CreatedAt:     posting.CreatedAt,
+		})
+	}
+	if err := r.DB.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&accounts).Error; err != nil {
+		return err
+	}
+	return r.DB.WithContext(ctx).Create(&entries).Error
+}
+
+func (r *RideRepo) LedgerPosted(ctx context.Context, reference string) (bool, error) {
+	var count int64
+	if err := r.DB.WithContext(ctx).Model(&ledgerTransactionModel{}).Where("reference = ?", reference).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+type earningsRow struct {
+	PeriodStart time.Time `gorm:"column:period_start"`
+	Currency    string    `gorm:"column:currency"`
+	Earnings    int64     `gorm:"column:earnings"`
+	Tips        int64     `gorm:"column:tips"`
+	Fees        int64     `gorm:"column:fees"`
+	Payouts     int64     `gorm:"column:payouts"`
+	Rides       int       `gorm:"column:rides"`
+}
+
+func (r *RideRepo) DriverEarnings(ctx context.Context, query outbound.EarningsQuery) ([]outbound.EarningsBucket, error) {
+	unit := "day"
+	if query.Period == string(domain.EarningsWeekly) {
+		unit = "week"
+	}
+	var rows []earningsRow
+	err := r.DB.WithContext(ctx).Raw(`
+SELECT date_trunc(?, e.created_at AT TIME ZONE 'UTC') AS period_start,
+       a.currency AS currency,
+       COALESCE(SUM(e.amount) FILTER (WHERE e.kind = ?), 0) AS earnings,
+       COALESCE(SUM(e.amount) FILTER (WHERE e.kind = ?), 0) AS tips,
+       COALESCE(SUM(e.amount) FILTER (WHERE e.kind = ?), 0) AS fees,
+       COALESCE(SUM(e.amount) FILTER (WHERE e.kind = ?), 0) AS payouts,
+       COUNT(DISTINCT e.ride_id) AS rides
+  FROM ledger_entries e
+  JOIN ledger_accounts a ON a.id = e.account_id
+ WHERE a.owner_type = ? AND a.owner_id = ?
+   AND e.created_at >= ? AND e.created_at < ?
+ GROUP BY 1, 2
+ ORDER BY 1, 2`,
+		unit,
+		string(domain.EntryEarning), string(domain.EntryTip), string(domain.EntryFee), string(domain.EntryPayout),
+		string(domain.OwnerDriver), query.DriverID, query.From, query.To,
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	out := make([]outbound.EarningsBucket, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, outbound.EarningsBucket{
+			PeriodStart: row.PeriodStart.UTC(),
+			Currency:    row.Currency,
+			Earnings:    row.Earnings,
+			Tips:        row.Tips,
+			Fees:        row.Fees,
+			Payouts:     row.Payouts,
+			Rides:       row.Rides,
+		})
+	}
+	return out, nil
+}
+
+type balanceRow struct {
+	AccountID string `gorm:"column:account_id"`
+	OwnerID   string `gorm:"column:owner_id"`
+	Currency  string `gorm:"column:currency"`
+	Balance   int64  `gorm:"column:balance"`
+}
+
+func (r *RideRepo) DriverBalances(ctx context.Context, minBalance int64, limit int) ([]outbound.AccountBalance, error) {
+	rows, err := r.driverBalances(ctx, nil, minBalance, limit)
+	if err != nil {
+		return nil, err
+	}
+	return toAccountBalances(rows), nil
+}
+
+func (r *RideRepo) ClaimDriverBalances(ctx context.Context, minBalance int64, limit int) ([]outbound.AccountBalance, error) {
+	candidates, err := r.driverBalances(ctx, nil, minBalance, limit)
+	if err != nil || len(candidates) == 0 {
+		return nil, err
+	}
+	ids := make([]string, 0, len(candidates))
+	for _, row := range candidates {
+		ids = append(ids, row.AccountID)
+	}
+	// Only the accounts about to be paid are locked, and with NO KEY UPDATE,
+	// which still lets ledger postings take the FOR KEY SHARE lock their
+	// foreign key needs.
+	var locked []ledgerAccountModel
+	if err := r.DB.WithContext(ctx).
+		Select("id").
+		Where("id IN ?", ids).
+		Clauses(clause.Locking{Strength: "NO KEY UPDATE"}).
+		Order("id").
+		Find(&locked).Error; err != nil {
+		return nil, err
+	}
+	// Sum again under the lock: the sum then reads whatever a batch we waited
+	// on has committed, and drops accounts it already paid out.
+	rows, err := r.driverBalances(ctx, ids, minBalance, limit)
+	if err != nil {
+		return nil, err
+	}
+	return toAccountBalances(rows), nil
+}
+
+// driverBalances sums driver accounts holding at least minBalance, limited to
+// accountIDs when given.
+func (r *RideRepo) driverBalances(ctx context.Context, accountIDs []string, minBalance int64, limit int) ([]balanceRow, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	if minBalance <= 0 {
+		minBalance = 1
+	}
+	filter := ""
+	args := []interface{}{string(domain.Credit), string(domain.OwnerDriver)}
+	if accountIDs != nil {
+		filter = "AND a.id IN ?"
+		args = append(args, accountIDs)
+	}
+	args = append(args, string(domain.Credit), minBalance, limit)
+	var rows []balanceRow
+	err := r.DB.WithContext(ctx).Raw(`
+SELECT a.id AS account_id, a.owner_id AS owner_id, a.currency AS currency,
+       SUM(CASE e.direction WHEN ? THEN e.amount ELSE -e.amount END) AS balance
+  FROM ledger_entries e
+  JOIN ledger_accounts a ON a.id = e.account_id
+ WHERE a.owner_type = ? `+filter+`
+ GROUP BY a.id, a.owner_id, a.currency
+HAVING SUM(CASE e.direction WHEN ? THEN e.amount ELSE -e.amount END) >= ?
+ ORDER BY a.id
+ LIMIT ?`, args...).Scan(&rows).Error
+	return rows, err
+}
+
+func toAccountBalances(rows []balanceRow) []outbound.AccountBalance {
+	out := make([]outbound.AccountBalance, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, outbound.AccountBalance{
+			OwnerID:  row.OwnerID,
+			Currency: row.Currency,
+			Balance:  row.Balance,
+		})
+	}
+	return out
+}
@@ -0,0 +1,186 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+func (r *OutboxRepo) List(ctx context.Context, filter outbound.OutboxFilter) ([]outbound.OutboxRecord, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+	q := r.DB.WithContext(ctx).Model(&outboxModel{}).Where("seq > ?", filter.AfterSeq)
+	if filter.Status != "" {
+		q = q.Where("status = ?", filter.Status)
+	}
+	if filter.Topic != "" {
+		q = q.Where("topic = ?", filter.Topic)
+	}
+	if !filter.CreatedAfter.IsZero() {
+		q = q.Where("created_at >= ?", filter.CreatedAfter)
+	}
+	if !filter.CreatedBefore.IsZero() {
+		q = q.Where("created_at < ?", filter.CreatedBefore)
+	}
+	var rows []outboxModel
+	if err := q.Order("seq").Limit(limit).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	out := make([]outbound.OutboxRecord, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, toOutboxRecord(row))
+	}
+	return out, nil
+}
+
+func (r *OutboxRepo) Get(ctx context.Context, id string) (outbound.OutboxRecord, error) {
+	var row outboxModel
+	if err := r.DB.WithContext(ctx).First(&row, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return outbound.OutboxRecord{}, outbound.ErrNotFound
+		}
+		return outbound.OutboxRecord{}, err
+	}
+	return toOutboxRecord(row), nil
+}
+
+func (r *OutboxRepo) Replay(ctx context.Context, selection outbound.OutboxSelection, audit outbound.OutboxAudit) (int64, error) {
+	return r.adminUpdate(ctx, selection, audit, `
+		UPDATE outbox o
+		SET status = 'PENDING', attempt_count = 0, last_error = NULL,
+		    available_at = ?, claimed_at = NULL, lease_until = NULL
+		FROM picked WHERE o.id = picked.id
+		RETURNING picked.id, picked.status, picked.topic, NULL::text AS payload`, audit.At)
+}
+
+func (r *OutboxRepo) Skip(ctx context.Context, selection outbound.OutboxSelection, audit outbound.OutboxAudit) (int64, error) {
+	return r.adminUpdate(ctx, selection, audit, `
+		UPDATE outbox o
+		SET status = 'SKIPPED', claimed_at = NULL, lease_until = NULL
+		FROM picked WHERE o.id = picked.id
+		RETURNING picked.id, picked.status, picked.topic, NULL::text AS payload`)
+}
+
+func (r *OutboxRepo) Purge(ctx context.Context, selection outbound.OutboxSelection, audit outbound.OutboxAudit) (int64, error) {
+	return r.adminUpdate(ctx, selection, audit, `
+		DELETE FROM outbox o
+		USING picked WHERE o.id = picked.id
+		RETURNING picked.id, picked.status, picked.topic, picked.payload`)
+}
+
+// adminUpdate applies change to the selected rows and writes one audit row
+// per affected row, all in a single statement. change runs against a CTE
+// named picked (id, status, topic, payload) holding the locked selection.
+func (r *OutboxRepo) adminUpdate(ctx context.Context, selection outbound.OutboxSelection, audit outbound.OutboxAudit, change string, changeArgs ...any) (int64, error) {
+	where, args := selectionClause(selection)
+	args = append(args, changeArgs...)
+	args = append(args, audit.Action, audit.Actor, audit.Note, audit.At)
+	result := r.DB.WithContext(ctx).Exec(`
+		WITH picked AS (
+			SELECT id, status, topic, payload FROM outbox
+			WHERE `+where+`
+			FOR UPDATE
+		), changed AS (`+change+`)
+		INSERT INTO outbox_audit (outbox_id, action, actor, note, topic, from_status, payload, created_at)
+		SELECT id, ?, ?, ?, topic, status, payload, ? FROM changed`, args...)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+func selectionClause(selection outbound.OutboxSelection) (string, []any) {
+	clauses := []string{"status IN ?"}
+	args := []any{selection.Statuses}
+	if len(selection.IDs) > 0 {
+		clauses = append(clauses, "id IN ?")
+		args = append(args, selection.IDs)
+	}
+	if selection.Topic != "" {
+		clauses = append(clauses, "topic = ?")
+		args = append(args, selection.Topic)
+	}
+	if !selection.CreatedAfter.IsZero() {
+		clauses = append(clauses, "created_at >= ?")
+		args = append(args, selection.CreatedAfter)
+	}
+	if !selection.CreatedBefore.IsZero() {
+		clauses = append(clauses, "created_at < ?")
+		args = append(args, selection.CreatedBefore)
+	}
+	return strings.Join(clauses, " AND "), args
+}
+
+func (r *OutboxRepo) Stats(ctx context.Context, topicLimit int) (outbound.OutboxStats, error) {
+	if topicLimit <= 0 {
+		topicLimit = 20
+	}
+	var statuses []struct {
+		Status string
+		Count  int64
+		Oldest time.Time
+	}
+	if err := r.DB.WithContext(ctx).Raw(`
+		SELECT status, COUNT(*) AS count, MIN(created_at) AS oldest
+		FROM outbox GROUP BY status ORDER BY status`).Scan(&statuses).Error; err != nil {
+		return outbound.OutboxStats{}, err
+	}
+	var topics []struct {
+		Topic      string
+		Pending    int64
+		Processing int64
+		Failed     int64
+	}
+	if err := r.DB.WithContext(ctx).Raw(`
+		SELECT topic,
+		       COUNT(*) FILTER (WHERE status = 'PENDING') AS pending,
+		       COUNT(*) FILTER (WHERE status = 'PROCESSING') AS processing,
+		       COUNT(*) FILTER (WHERE status = 'FAILED') AS failed
+		FROM outbox
+		WHERE status IN ('PENDING', 'PROCESSING', 'FAILED')
+		GROUP BY topic
+		ORDER BY COUNT(*) DESC, topic
+		LIMIT ?`, topicLimit).Scan(&topics).Error; err != nil {
+		return outbound.OutboxStats{}, err
+	}
+	stats := outbound.OutboxStats{
+		Statuses: make([]outbound.OutboxStatusStats, 0, len(statuses)),
+		Topics:   make([]outbound.OutboxTopicBacklog, 0, len(topics)),
+	}
+	for _, row := range statuses {
+		stats.Statuses = append(stats.Statuses, outbound.OutboxStatusStats{Status: row.Status, Count: row.Count, Oldest: row.Oldest})
+	}
+	for _, row := range topics {
+		stats.Topics = append(stats.Topics, outbound.OutboxTopicBacklog{Topic: row.Topic, Pending: row.Pending, Processing: row.Processing, Failed: row.Failed})
+	}
+	return stats, nil
+}
+
+func toOutboxRecord(row outboxModel) outbound.OutboxRecord {
+	record := outbound.OutboxRecord{
+		ID:           row.ID,
+		Seq:          row.Seq,
+		Topic:        row.Topic,
+		Payload:      row.Payload,
+		Status:       row.Status,
+		AttemptCount: row.AttemptCount,
+		AvailableAt:  row.AvailableAt,
+		CreatedAt:    row.CreatedAt,
+	}
+	if row.AggregateID != nil {
+		record.AggregateID = *row.AggregateID
+	}
+	if row.LastError != nil {
+		record.LastError = *row.LastError
+	}
+	if row.LeaseUntil != nil {
+		record.LeaseUntil = *row.LeaseUntil
+	}
+	return record
+}
@@ -0,0 +1,81 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// OutboxChannel is the NOTIFY channel the outbox insert trigger signals.
+const OutboxChannel = "outbox_enqueued"
+
+// OutboxListener holds a dedicated connection LISTENing on OutboxChannel and
+// turns notifications into wake-ups for the outbox worker. The connection is
+// re-established with backoff when it drops.
+type OutboxListener struct {
+	DSN        string
+	Logger     *zap.Logger
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+func NewOutboxListener(dsn string, logger *zap.Logger) *OutboxListener {
+	return &OutboxListener{DSN: dsn, Logger: logger, MinBackoff: time.Second, MaxBackoff: 30 * time.Second}
+}
+
+// Run delivers a wake-up on wake for every notification until ctx ends.
+// Sends never block: a pending wake-up already covers later enqueues. Every
+// (re)connect also sends one, since notifications raised while the listener
+// was down are lost.
+func (l *OutboxListener) Run(ctx context.Context, wake chan<- struct{}) {
+	minBackoff, maxBackoff := l.MinBackoff, l.MaxBackoff
+	if minBackoff <= 0 {
+		minBackoff = time.Second
+	}
+	if maxBackoff < minBackoff {
+		maxBackoff = 30 * time.Second
+	}
+	backoff := minBackoff
+	for {
+		err := l.listen(ctx, wake, func() { backoff = minBackoff })
+		if ctx.Err() != nil {
+			return
+		}
+		l.Logger.Warn("outbox.listener_disconnected", zap.Error(err), zap.Duration("retry_in", backoff))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+func (l *OutboxListener) listen(ctx context.Context, wake chan<- struct{}, connected func()) error {
+	conn, err := pgx.Connect(ctx, l.DSN)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+	if _, err := conn.Exec(ctx, "LISTEN "+OutboxChannel); err != nil {
+		return err
+	}
+	connected()
+	l.Logger.Info("outbox.listener_connected", zap.String("channel", OutboxChannel))
+	signal(wake)
+	for {
+		if _, err := conn.WaitForNotification(ctx); err != nil {
+			return err
+		}
+		signal(wake)
+	}
+}
+
+func signal(wake chan<- struct{}) {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
-	"gorm.io/gorm/clause"
 
 	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
 )
@@ -15,19 +14,26 @@ type OutboxRepo struct {
 	DB *gorm.DB
 }
 
+// outboxClaimLock is the advisory lock key that serializes Claim.
+const outboxClaimLock = 7_302_001
+
 func NewOutboxRepo(db *gorm.DB) *OutboxRepo {
 	return &OutboxRepo{DB: db}
 }
 
 type outboxModel struct {
-	ID           string    `gorm:"column:id;primaryKey"`
-	Topic        string    `gorm:"column:topic"`
-	Payload      string    `gorm:"column:payload"`
-	Status       string    `gorm:"column:status"`
-	AttemptCount int       `gorm:"column:attempt_count"`
-	LastError    *string   `gorm:"column:last_error"`
-	AvailableAt  time.Time `gorm:"column:available_at"`
-	CreatedAt    time.Time `gorm:"column:created_at"`
+	ID           string     `gorm:"column:id;primaryKey"`
+	Topic        string     `gorm:"column:topic"`
+	Payload      string     `gorm:"column:payload"`
+	AggregateID  *string    `gorm:"column:aggregate_id"`
+	Seq          int64      `gorm:"column:seq;->"`
+	Status       string     `gorm:"column:status"`
+	AttemptCount int        `gorm:"column:attempt_count"`
+	LastError    *string    `gorm:"column:last_error"`
+	AvailableAt  time.Time  `gorm:"column:available_at"`
+	ClaimedAt    *time.Time `gorm:"column:claimed_at"`
+	LeaseUntil   *time.Time `gorm:"column:lease_until"`
+	CreatedAt    time.Time  `gorm:"column:created_at"`
 }
 
 func (outboxModel) TableName() string { return "outbox" }
@@ -45,28 +51,54 @@ func (r *OutboxRepo) Enqueue(ctx context.Context, msg outbound.OutboxMessage) er
 	if m.ID == "" {
 		m.ID = uuid.NewString()
 	}
+	if msg.AggregateID != "" {
+		m.AggregateID = &msg.AggregateID
+	}
 	return r.DB.WithContext(ctx).Create(&m).Error
 }
 
-func (r *OutboxRepo) Claim(ctx context.Context, limit int, maxAttempts int) ([]outbound.OutboxMessage, error) {
+func (r *OutboxRepo) Claim(ctx context.Context, limit int, maxAttempts int, lease time.Duration) ([]outbound.OutboxMessage, error) {
 	if limit <= 0 {
 		limit = 10
 	}
 	if maxAttempts <= 0 {
 		maxAttempts = 10
 	}
+	if lease <= 0 {
+		lease = time.Minute
+	}
 
 	var rows []outboxModel
 	tx := r.DB.WithContext(ctx).Begin()
 	if tx.Error != nil {
 		return nil, tx.Error
 	}
-	if err := tx.
-		Where("status = ? AND attempt_count < ? AND available_at <= ?", "PENDING", maxAttempts, time.Now().UTC()).
-		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
-		Order("created_at").
-		Limit(limit).
-		Find(&rows).Error; err != nil {
+	// Claims are serialized so two workers never hold rows of the same
+	// aggregate at once; the claim itself is a single short statement.
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", outboxClaimLock).Error; err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	// A row is due unless an earlier row of its aggregate is still unsent and
+	// not due itself: in flight, backing off after a failure, or dead-lettered.
+	// Rows an operator skipped no longer hold the aggregate back.
+	// Earlier due rows sort first, so they land in the same batch.
+	due := time.Now().UTC()
+	if err := tx.Raw(`
+		SELECT o.* FROM outbox o
+		WHERE o.status = 'PENDING' AND o.attempt_count < ? AND o.available_at <= ?
+		  AND NOT EXISTS (
+			SELECT 1 FROM outbox p
+			WHERE p.aggregate_id = o.aggregate_id
+			  AND p.seq < o.seq
+			  AND p.status NOT IN ('SENT', 'SKIPPED')
+			  AND NOT (p.status = 'PENDING' AND p.attempt_count < ? AND p.available_at <= ?)
+		  )
+		ORDER BY o.seq
+		LIMIT ?
+		FOR UPDATE OF o`,
+		maxAttempts, due, maxAttempts, due, limit,
+	).Scan(&rows).Error; err != nil {
 		_ = tx.Rollback()
 		return nil, err
 	}
@@ -79,11 +111,16 @@ func (r *OutboxRepo) Claim(ctx context.Context, limit int, maxAttempts int) ([]o
 		_ = tx.Commit()
 		return nil, nil
 	}
+	// Postgres keeps microseconds, so the token is truncated to match what
+	// is stored.
+	now := time.Now().UTC().Truncate(time.Microsecond)
 	if err := tx.Model(&outboxModel{}).
 		Where("id IN ?", ids).
 		Updates(map[string]interface{}{
 			"status":        "PROCESSING",
 			"attempt_count": gorm.Expr("attempt_count + 1"),
+			"claimed_at":    now,
+			"lease_until":   now.Add(lease),
 		}).Error; err != nil {
 		_ = tx.Rollback()
 		return nil, err
@@ -94,43 +131,74 @@ func (r *OutboxRepo) Claim(ctx context.Context, limit int, maxAttempts int) ([]o
 
 	out := make([]outbound.OutboxMessage, 0, len(rows))
 	for _, row := range rows {
-		out = append(out, outbound.OutboxMessage{
-			ID:      row.ID,
-			Topic:   row.Topic,
-			Payload: row.Payload,
-			Attempt: row.AttemptCount + 1,
-		})
+		msg := outbound.OutboxMessage{
+			ID:        row.ID,
+			Topic:     row.Topic,
+			Payload:   row.Payload,
+			Attempt:   row.AttemptCount + 1,
+			CreatedAt: row.CreatedAt,
+			ClaimedAt: now,
+		}
+		if row.AggregateID != nil {
+			msg.AggregateID = *row.AggregateID
+		}
+		out = append(out, msg)
 	}
 	return out, nil
 }
 
-func (r *OutboxRepo) MarkSent(ctx context.Context, id string) error {
-	return r.DB.WithContext(ctx).Model(&outboxModel{}).
-		Where("id = ?", id).
-		Updates(map[string]interface{}{
-			"status":       "SENT",
-			"last_error":   nil,
-			"available_at": time.Now().UTC(),
-		}).Error
+func (r *OutboxRepo) MarkSent(ctx context.Context, msg outbound.OutboxMessage) error {
+	return r.settle(ctx, msg, map[string]interface{}{
+		"status":       "SENT",
+		"last_error":   nil,
+		"available_at": time.Now().UTC(),
+		"claimed_at":   nil,
+		"lease_until":  nil,
+	})
 }
 
-func (r *OutboxRepo) MarkFailed(ctx context.Context, id string, reason string, nextAttemptAt time.Time) error {
+func (r *OutboxRepo) MarkFailed(ctx context.Context, msg outbound.OutboxMessage, reason string, nextAttemptAt time.Time) error {
 	if nextAttemptAt.IsZero() {
-		return r.DB.WithContext(ctx).Model(&outboxModel{}).
-			Where("id = ?", id).
-			Updates(map[string]interface{}{
-				"status":       "FAILED",
-				"last_error":   reason,
-				"available_at": time.Now().UTC(),
-			}).Error
-	}
-	return r.DB.WithContext(ctx).Model(&outboxModel{}).
-		Where("id = ?", id).
-		Updates(map[string]interface{}{
-			"status":       "PENDING",
+		return r.settle(ctx, msg, map[string]interface{}{
+			"status":       "FAILED",
 			"last_error":   reason,
-			"available_at": nextAttemptAt,
-		}).Error
+			"available_at": time.Now().UTC(),
+			"claimed_at":   nil,
+			"lease_until":  nil,
+		})
+	}
+	return r.settle(ctx, msg, map[string]interface{}{
+		"status":       "PENDING",
+		"last_error":   reason,
+		"available_at": nextAttemptAt,
+		"claimed_at":   nil,
+		"lease_until":  nil,
+	})
+}
+
+func (r *OutboxRepo) Unclaim(ctx context.Context, msg outbound.OutboxMessage) error {
+	return r.settle(ctx, msg, map[string]interface{}{
+		"status":        "PENDING",
+		"attempt_count": gorm.Expr("GREATEST(attempt_count - 1, 0)"),
+		"claimed_at":    nil,
+		"lease_until":   nil,
+	})
+}
+
+// settle applies updates to a claimed row only while it is still PROCESSING
+// under msg's claim. A row that was reclaimed, and possibly claimed again by
+// another worker, is left alone and reported as ErrLeaseLost.
+func (r *OutboxRepo) settle(ctx context.Context, msg outbound.OutboxMessage, updates map[string]interface{}) error {
+	result := r.DB.WithContext(ctx).Model(&outboxModel{}).
+		Where("id = ? AND status = ? AND claimed_at = ?", msg.ID, "PROCESSING", msg.ClaimedAt).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return outbound.ErrLeaseLost
+	}
+	return nil
 }
 
 func (r *OutboxRepo) DeleteSentBefore(ctx context.Context, cutoff time.Time) (int64, error) {
@@ -163,3 +231,46 @@ func (r *OutboxRepo) ResetFailed(ctx context.Context, limit int) (int64, error)
 	}
 	return result.RowsAffected, nil
 }
+
+// ReclaimExpired returns PROCESSING rows whose lease ran out, because the
+// worker that claimed them died before marking them, to PENDING. Rows that
+// already used their last attempt are dead-lettered as FAILED instead, since
+// Claim would never pick them up again.
+func (r *OutboxRepo) ReclaimExpired(ctx context.Context, limit int, maxAttempts int) (outbound.OutboxReclaim, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	if maxAttempts <= 0 {
+		maxAttempts = 10
+	}
+	var statuses []string
+	err := r.DB.WithContext(ctx).Raw(`
+		UPDATE outbox
+		SET status = CASE WHEN attempt_count >= ? THEN 'FAILED' ELSE 'PENDING' END,
+		    last_error = 'lease expired',
+		    available_at = ?,
+		    claimed_at = NULL,
+		    lease_until = NULL
+		WHERE id IN (
+			SELECT id FROM outbox
+			WHERE status = 'PROCESSING' AND lease_until < ?
+			ORDER BY lease_until
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING status`,
+		maxAttempts, time.Now().UTC(), time.Now().UTC(), limit,
+	).Scan(&statuses).Error
+	if err != nil {
+		return outbound.OutboxReclaim{}, err
+	}
+	var reclaim outbound.OutboxReclaim
+	for _, status := range statuses {
+		if status == "FAILED" {
+			reclaim.Failed++
+		} else {
+			reclaim.Requeued++
+		}
+	}
+	return reclaim, nil
+}
@@ -0,0 +1,125 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type paymentModel struct {
+	ID             string    `gorm:"column:id;primaryKey"`
+	RideID         string    `gorm:"column:ride_id"`
+	RiderID        string    `gorm:"column:rider_id"`
+	Amount         int64     `gorm:"column:amount"`
+	CaptureAmount  int64     `gorm:"column:capture_amount"`
+	CapturedAmount int64     `gorm:"column:captured_amount"`
+	RefundedAmount int64     `gorm:"column:refunded_amount"`
+	Currency       string    `gorm:"column:currency"`
+	Status         string    `gorm:"column:status"`
+	Provider       string    `gorm:"column:provider"`
+	ProviderRef    string    `gorm:"column:provider_ref"`
+	Attempts       int       `gorm:"column:attempts"`
+	LastError      string    `gorm:"column:last_error"`
+	CreatedAt      time.Time `gorm:"column:created_at"`
+	UpdatedAt      time.Time `gorm:"column:updated_at"`
+}
+
+func (paymentModel) TableName() string {
+	return "payments"
+}
+
+func (m paymentModel) toOutbound() outbound.Payment {
+	return outbound.Payment{
+		ID:             m.ID,
+		RideID:         m.RideID,
+		RiderID:        m.RiderID,
+		Amount:         m.Amount,
+		CaptureAmount:  m.CaptureAmount,
+		CapturedAmount: m.CapturedAmount,
+		RefundedAmount: m.RefundedAmount,
+		Currency:       m.Currency,
+		Status:         m.Status,
+		Provider:       m.Provider,
+		ProviderRef:    m.ProviderRef,
+		Attempts:       m.Attempts,
+		LastError:      m.LastError,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+func (r *RideRepo) CreatePayment(ctx context.Context, payment outbound.Payment) error {
+	return r.DB.WithContext(ctx).Create(&paymentModel{
+		ID:          payment.ID,
+		RideID:      payment.RideID,
+		RiderID:     payment.RiderID,
+		Amount:      payment.Amount,
+		Currency:    payment.Currency,
+		Status:      payment.Status,
+		Provider:    payment.Provider,
+		ProviderRef: payment.ProviderRef,
+		CreatedAt:   payment.CreatedAt,
+		UpdatedAt:   payment.UpdatedAt,
+	}).Error
+}
+
+// GetPaymentByRide locks the payment row for the rest of the transaction so
+// concurrent refunds or settlements serialize on it.
+func (r *RideRepo) GetPaymentByRide(ctx context.Context, rideID string) (outbound.Payment, error) {
+	var m paymentModel
+	err := r.DB.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&m, "ride_id = ?", rideID).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return outbound.Payment{}, outbound.ErrNotFound
+		}
+		return outbound.Payment{}, err
+	}
+	return m.toOutbound(), nil
+}
+
+func (r *RideRepo) UpdatePaymentIfCurrent(ctx context.Context, payment outbound.Payment, currentStatus string) error {
+	result := r.DB.WithContext(ctx).Model(&paymentModel{}).
+		Where("id = ? AND status = ?", payment.ID, currentStatus).
+		Updates(map[string]interface{}{
+			"status":          payment.Status,
+			"capture_amount":  payment.CaptureAmount,
+			"captured_amount": payment.CapturedAmount,
+			"refunded_amount": payment.RefundedAmount,
+			"provider_ref":    payment.ProviderRef,
+			"attempts":        payment.Attempts,
+			"last_error":      payment.LastError,
+			"updated_at":      payment.UpdatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return outbound.ErrConflict
+	}
+	return nil
+}
+
+func (r *RideRepo) ListPendingPayments(ctx context.Context, limit int) ([]outbound.Payment, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	var rows []paymentModel
+	if err := r.DB.WithContext(ctx).
+		Where("status IN ?", []string{string(domain.PaymentCapturePending), string(domain.PaymentVoidPending)}).
+		Order("updated_at").
+		Limit(limit).
+		Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	out := make([]outbound.Payment, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, row.toOutbound())
+	}
+	return out, nil
+}
@@ -0,0 +1,61 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+type rideEventModel struct {
+	ID         string    `gorm:"column:id;primaryKey"`
+	RideID     string    `gorm:"column:ride_id"`
+	FromStatus string    `gorm:"column:from_status"`
+	ToStatus   string    `gorm:"column:to_status"`
+	Actor      string    `gorm:"column:actor"`
+	ActorID    *string   `gorm:"column:actor_id"`
+	Reason     string    `gorm:"column:reason"`
+	CreatedAt  time.Time `gorm:"column:created_at"`
+}
+
+func (rideEventModel) TableName() string {
+	return "ride_events"
+}
+
+func (r *RideRepo) AppendEvent(ctx context.Context, event outbound.RideEvent) error {
+	m := rideEventModel{
+		ID:         event.ID,
+		RideID:     event.RideID,
+		FromStatus: event.FromStatus,
+		ToStatus:   event.ToStatus,
+		Actor:      event.Actor,
+		ActorID:    event.ActorID,
+		Reason:     event.Reason,
+		CreatedAt:  event.CreatedAt,
+	}
+	return r.DB.WithContext(ctx).Create(&m).Error
+}
+
+func (r *RideRepo) ListEvents(ctx context.Context, rideID string) ([]outbound.RideEvent, error) {
+	var rows []rideEventModel
+	if err := r.DB.WithContext(ctx).
+		Where("ride_id = ?", rideID).
+		Order("created_at ASC, id ASC").
+		Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	out := make([]outbound.RideEvent, 0, len(rows))
+	for _, m := range rows {
+		out = append(out, outbound.RideEvent{
+			ID:         m.ID,
+			RideID:     m.RideID,
+			FromStatus: m.FromStatus,
+			ToStatus:   m.ToStatus,
+			Actor:      m.Actor,
+			ActorID:    m.ActorID,
+			Reason:     m.Reason,
+			CreatedAt:  m.CreatedAt,
+		})
+	}
+	return out, nil
+}
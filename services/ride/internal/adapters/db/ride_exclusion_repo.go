@@ -0,0 +1,42 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+	"gorm.io/gorm/clause"
+)
+
+type rideExclusionModel struct {
+	RideID    string    `gorm:"column:ride_id;primaryKey"`
+	DriverID  string    `gorm:"column:driver_id;primaryKey"`
+	Reason    string    `gorm:"column:reason"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+func (rideExclusionModel) TableName() string {
+	return "ride_driver_exclusions"
+}
+
+func (r *RideRepo) ExcludeDriver(ctx context.Context, exclusion outbound.DriverExclusion) error {
+	return r.DB.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&rideExclusionModel{
+			RideID:    exclusion.RideID,
+			DriverID:  exclusion.DriverID,
+			Reason:    exclusion.Reason,
+			CreatedAt: exclusion.CreatedAt,
+		}).Error
+}
+
+func (r *RideRepo) ListExcludedDrivers(ctx context.Context, rideID string) ([]string, error) {
+	var driverIDs []string
+	if err := r.DB.WithContext(ctx).Model(&rideExclusionModel{}).
+		Where("ride_id = ?", rideID).
+		Order("created_at, driver_id").
+		Pluck("driver_id", &driverIDs).Error; err != nil {
+		return nil, err
+	}
+	return driverIDs, nil
+}
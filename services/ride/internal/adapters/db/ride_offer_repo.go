@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -83,18 +84,46 @@ func (r *RideOfferRepo) UpdateStatusIfCurrent(ctx context.Context, id string, cu
 	return outbound.ErrConflict
 }
 
-func (r *RideOfferRepo) ListExpired(ctx context.Context, cutoff int64, limit int) ([]outbound.RideOffer, error) {
+func (r *RideOfferRepo) ExpireDue(ctx context.Context, cutoff int64, limit int) ([]outbound.RideOffer, error) {
 	if limit <= 0 {
 		limit = 50
 	}
+	// The subquery walks ride_offers_status_expires_idx and locks the batch;
+	// the outer UPDATE flips it and hands the rows back.
 	var rows []rideOfferModel
-	if err := r.DB.WithContext(ctx).
-		Where("status = ? AND expires_at <= ?", string(domain.OfferPending), time.Unix(cutoff, 0).UTC()).
-		Order("expires_at").
-		Limit(limit).
-		Find(&rows).Error; err != nil {
+	if err := r.DB.WithContext(ctx).Raw(`
+		UPDATE ride_offers SET status = ?
+		WHERE id IN (
+			SELECT id FROM ride_offers
+			WHERE status = ? AND expires_at <= ?
+			ORDER BY expires_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, ride_id, driver_id, status, expires_at, created_at`,
+		string(domain.OfferExpired), string(domain.OfferPending), time.Unix(cutoff, 0).UTC(), limit,
+	).Scan(&rows).Error; err != nil {
 		return nil, err
 	}
+	// RETURNING does not keep the subquery's order.
+	sort.Slice(rows, func(i, j int) bool { return rows[i].ExpiresAt.Before(rows[j].ExpiresAt) })
+	return toOutboundOffers(rows), nil
+}
+
+func (r *RideOfferRepo) ExpirePendingForRide(ctx context.Context, rideID string) ([]outbound.RideOffer, error) {
+	var rows []rideOfferModel
+	if err := r.DB.WithContext(ctx).Raw(`
+		UPDATE ride_offers SET status = ?
+		WHERE ride_id = ? AND status = ?
+		RETURNING id, ride_id, driver_id, status, expires_at, created_at`,
+		string(domain.OfferExpired), rideID, string(domain.OfferPending),
+	).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	return toOutboundOffers(rows), nil
+}
+
+func toOutboundOffers(rows []rideOfferModel) []outbound.RideOffer {
 	out := make([]outbound.RideOffer, 0, len(rows))
 	for _, row := range rows {
 		out = append(out, outbound.RideOffer{
@@ -106,5 +135,5 @@ func (r *RideOfferRepo) ListExpired(ctx context.Context, cutoff int64, limit int
 			CreatedAt: row.CreatedAt.Unix(),
 		})
 	}
-	return out, nil
+	return out
 }
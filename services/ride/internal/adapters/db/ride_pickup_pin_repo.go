@@ -0,0 +1,111 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ridePickupPINModel struct {
+	RideID         string     `gorm:"column:ride_id;primaryKey"`
+	PinHash        string     `gorm:"column:pin_hash"`
+	FailedAttempts int        `gorm:"column:failed_attempts"`
+	LockedAt       *time.Time `gorm:"column:locked_at"`
+	OverriddenAt   *time.Time `gorm:"column:overridden_at"`
+	OverriddenBy   *string    `gorm:"column:overridden_by"`
+	CreatedAt      time.Time  `gorm:"column:created_at"`
+	UpdatedAt      time.Time  `gorm:"column:updated_at"`
+}
+
+func (ridePickupPINModel) TableName() string {
+	return "ride_pickup_pins"
+}
+
+func (m ridePickupPINModel) toOutbound() outbound.PickupPIN {
+	return outbound.PickupPIN{
+		RideID:         m.RideID,
+		Hash:           m.PinHash,
+		FailedAttempts: m.FailedAttempts,
+		LockedAt:       m.LockedAt,
+		OverriddenAt:   m.OverriddenAt,
+		OverriddenBy:   m.OverriddenBy,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+func (r *RideRepo) SavePickupPIN(ctx context.Context, pin outbound.PickupPIN) error {
+	return r.DB.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "ride_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"pin_hash", "failed_attempts", "locked_at", "overridden_at", "overridden_by", "updated_at"}),
+		}).
+		Create(&ridePickupPINModel{
+			RideID:         pin.RideID,
+			PinHash:        pin.Hash,
+			FailedAttempts: pin.FailedAttempts,
+			LockedAt:       pin.LockedAt,
+			OverriddenAt:   pin.OverriddenAt,
+			OverriddenBy:   pin.OverriddenBy,
+			CreatedAt:      pin.CreatedAt,
+			UpdatedAt:      pin.UpdatedAt,
+		}).Error
+}
+
+func (r *RideRepo) GetPickupPIN(ctx context.Context, rideID string) (outbound.PickupPIN, error) {
+	var m ridePickupPINModel
+	if err := r.DB.WithContext(ctx).First(&m, "ride_id = ?", rideID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return outbound.PickupPIN{}, outbound.ErrNotFound
+		}
+		return outbound.PickupPIN{}, err
+	}
+	return m.toOutbound(), nil
+}
+
+// RecordPickupPINFailure counts and locks in one statement so concurrent
+// wrong entries cannot slip past maxAttempts.
+func (r *RideRepo) RecordPickupPINFailure(ctx context.Context, rideID string, maxAttempts int, at time.Time) (outbound.PickupPIN, error) {
+	var rows []ridePickupPINModel
+	err := r.DB.WithContext(ctx).Raw(`
+		UPDATE ride_pickup_pins
+		SET failed_attempts = failed_attempts + 1,
+		    locked_at = CASE WHEN failed_attempts + 1 >= ? THEN ?::timestamptz END,
+		    updated_at = ?
+		WHERE ride_id = ? AND locked_at IS NULL AND overridden_at IS NULL
+		RETURNING *`,
+		maxAttempts, at, at, rideID,
+	).Scan(&rows).Error
+	if err != nil {
+		return outbound.PickupPIN{}, err
+	}
+	if len(rows) == 0 {
+		if _, err := r.GetPickupPIN(ctx, rideID); err != nil {
+			return outbound.PickupPIN{}, err
+		}
+		return outbound.PickupPIN{}, outbound.ErrConflict
+	}
+	return rows[0].toOutbound(), nil
+}
+
+func (r *RideRepo) OverridePickupPIN(ctx context.Context, rideID string, actorID string, at time.Time) error {
+	result := r.DB.WithContext(ctx).
+		Model(&ridePickupPINModel{}).
+		Where("ride_id = ?", rideID).
+		Updates(map[string]interface{}{
+			"overridden_at": at,
+			"overridden_by": actorID,
+			"updated_at":    at,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return outbound.ErrNotFound
+	}
+	return nil
+}
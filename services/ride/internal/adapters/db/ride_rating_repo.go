@@ -0,0 +1,50 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+	"gorm.io/gorm/clause"
+)
+
+type rideRatingModel struct {
+	RideID    string    `gorm:"column:ride_id;primaryKey"`
+	RaterRole string    `gorm:"column:rater_role;primaryKey"`
+	RaterID   string    `gorm:"column:rater_id"`
+	RateeID   string    `gorm:"column:ratee_id"`
+	Stars     int       `gorm:"column:stars"`
+	Tags      []string  `gorm:"column:tags;serializer:json"`
+	Comment   string    `gorm:"column:comment"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+func (rideRatingModel) TableName() string {
+	return "ride_ratings"
+}
+
+func (r *RideRepo) AddRating(ctx context.Context, rating outbound.RideRating) error {
+	tags := rating.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	result := r.DB.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&rideRatingModel{
+			RideID:    rating.RideID,
+			RaterRole: rating.RaterRole,
+			RaterID:   rating.RaterID,
+			RateeID:   rating.RateeID,
+			Stars:     rating.Stars,
+			Tags:      tags,
+			Comment:   rating.Comment,
+			CreatedAt: rating.CreatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return outbound.ErrConflict
+	}
+	return nil
+}
@@ -2,10 +2,14 @@ package db
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
 	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type RideRepo struct {
@@ -17,22 +21,62 @@ func NewRideRepo(db *gorm.DB) *RideRepo {
 }
 
 type rideModel struct {
-	ID         string    `gorm:"column:id;primaryKey"`
-	RiderID    string    `gorm:"column:rider_id"`
-	DriverID   *string   `gorm:"column:driver_id"`
-	Status     string    `gorm:"column:status"`
-	PickupLat  float64   `gorm:"column:pickup_lat"`
-	PickupLng  float64   `gorm:"column:pickup_lng"`
-	DropoffLat float64   `gorm:"column:dropoff_lat"`
-	DropoffLng float64   `gorm:"column:dropoff_lng"`
-	CreatedAt  time.Time `gorm:"column:created_at"`
-	UpdatedAt  time.Time `gorm:"column:updated_at"`
+	ID              string     `gorm:"column:id;primaryKey"`
+	RiderID         string     `gorm:"column:rider_id"`
+	DriverID        *string    `gorm:"column:driver_id"`
+	Status          string     `gorm:"column:status"`
+	PickupLat       float64    `gorm:"column:pickup_lat"`
+	PickupLng       float64    `gorm:"column:pickup_lng"`
+	DropoffLat      float64    `gorm:"column:dropoff_lat"`
+	DropoffLng      float64    `gorm:"column:dropoff_lng"`
+	Product         string     `gorm:"column:product"`
+	FareAmount      int64      `gorm:"column:fare_amount"`
+	Currency        string     `gorm:"column:fare_currency"`
+	QuoteID         *string    `gorm:"column:quote_id"`
+	PickupAt        *time.Time `gorm:"column:pickup_at"`
+	ReminderAt      *time.Time `gorm:"column:reminder_sent_at"`
+	AssignedAt      *time.Time `gorm:"column:assigned_at"`
+	ArrivedAt       *time.Time `gorm:"column:arrived_at"`
+	CompletedAt     *time.Time `gorm:"column:completed_at"`
+	CancellationFee int64      `gorm:"column:cancellation_fee"`
+	CancelReason    *string    `gorm:"column:cancel_reason"`
+	Redispatches    int        `gorm:"column:redispatch_count"`
+	Version         int64      `gorm:"column:version"`
+	CreatedAt       time.Time  `gorm:"column:created_at"`
+	UpdatedAt       time.Time  `gorm:"column:updated_at"`
 }
 
 func (rideModel) TableName() string {
 	return "rides"
 }
 
+func (m rideModel) toOutbound() outbound.Ride {
+	return outbound.Ride{
+		ID:              m.ID,
+		RiderID:         m.RiderID,
+		DriverID:        m.DriverID,
+		Status:          m.Status,
+		PickupLat:       m.PickupLat,
+		PickupLng:       m.PickupLng,
+		DropoffLat:      m.DropoffLat,
+		DropoffLng:      m.DropoffLng,
+		Product:         m.Product,
+		FareAmount:      m.FareAmount,
+		Currency:        m.Currency,
+		QuoteID:         m.QuoteID,
+		PickupAt:        m.PickupAt,
+		AssignedAt:      m.AssignedAt,
+		ArrivedAt:       m.ArrivedAt,
+		CompletedAt:     m.CompletedAt,
+		CancellationFee: m.CancellationFee,
+		CancelReason:    m.CancelReason,
+		Redispatches:    m.Redispatches,
+		Version:         m.Version,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+	}
+}
+
 func (r *RideRepo) Create(ctx context.Context, ride outbound.Ride) error {
 	m := rideModel{
 		ID:         ride.ID,
@@ -43,10 +87,19 @@ func (r *RideRepo) Create(ctx context.Context, ride outbound.Ride) error {
 		PickupLng:  ride.PickupLng,
 		DropoffLat: ride.DropoffLat,
 		DropoffLng: ride.DropoffLng,
+		Product:    ride.Product,
+		FareAmount: ride.FareAmount,
+		Currency:   ride.Currency,
+		QuoteID:    ride.QuoteID,
+		PickupAt:   ride.PickupAt,
+		Version:    ride.Version,
 		CreatedAt:  ride.CreatedAt,
 		UpdatedAt:  ride.UpdatedAt,
 	}
-	return r.DB.WithContext(ctx).Create(&m).Error
+	if err := r.DB.WithContext(ctx).Create(&m).Error; err != nil {
+		return activeRideError(err)
+	}
+	return r.createStops(ctx, ride.ID, ride.Stops)
 }
 
 func (r *RideRepo) Get(ctx context.Context, id string) (outbound.Ride, error) {
@@ -57,64 +110,224 @@ func (r *RideRepo) Get(ctx context.Context, id string) (outbound.Ride, error) {
 		}
 		return outbound.Ride{}, err
 	}
-	return outbound.Ride{
-		ID:         m.ID,
-		RiderID:    m.RiderID,
-		DriverID:   m.DriverID,
-		Status:     m.Status,
-		PickupLat:  m.PickupLat,
-		PickupLng:  m.PickupLng,
-		DropoffLat: m.DropoffLat,
-		DropoffLng: m.DropoffLng,
-		CreatedAt:  m.CreatedAt,
-		UpdatedAt:  m.UpdatedAt,
-	}, nil
-}
-
-func (r *RideRepo) UpdateStatusIfCurrent(ctx context.Context, id string, currentStatus string, nextStatus string, updatedAt time.Time) error {
-	result := r.DB.WithContext(ctx).Model(&rideModel{}).
-		Where("id = ? AND status = ?", id, currentStatus).
-		Updates(map[string]interface{}{"status": nextStatus, "updated_at": updatedAt})
+	rides, err := r.withStops(ctx, []rideModel{m})
+	if err != nil {
+		return outbound.Ride{}, err
+	}
+	return rides[0], nil
+}
+
+func (r *RideRepo) GetActiveByRider(ctx context.Context, riderID string) (outbound.Ride, error) {
+	return r.getActive(ctx, "rider_id = ?", riderID)
+}
+
+func (r *RideRepo) GetActiveByDriver(ctx context.Context, driverID string) (outbound.Ride, error) {
+	return r.getActive(ctx, "driver_id = ?", driverID)
+}
+
+func (r *RideRepo) getActive(ctx context.Context, query string, userID string) (outbound.Ride, error) {
+	var m rideModel
+	if err := r.DB.WithContext(ctx).Where(query, userID).Where("status IN ?", activeStatuses()).First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return outbound.Ride{}, outbound.ErrNotFound
+		}
+		return outbound.Ride{}, err
+	}
+	return m.toOutbound(), nil
+}
+
+func (r *RideRepo) List(ctx context.Context, filter outbound.RideFilter) ([]outbound.Ride, error) {
+	q := r.DB.WithContext(ctx).Model(&rideModel{})
+	if filter.RiderID != "" {
+		q = q.Where("rider_id = ?", filter.RiderID)
+	}
+	if filter.DriverID != "" {
+		q = q.Where("driver_id = ?", filter.DriverID)
+	}
+	if filter.Status != "" {
+		q = q.Where("status = ?", filter.Status)
+	}
+	if !filter.CreatedFrom.IsZero() {
+		q = q.Where("created_at >= ?", filter.CreatedFrom)
+	}
+	if !filter.CreatedTo.IsZero() {
+		q = q.Where("created_at < ?", filter.CreatedTo)
+	}
+	if filter.After != nil {
+		q = q.Where("(created_at, id) < (?, ?)", filter.After.CreatedAt, filter.After.ID)
+	}
+	var rows []rideModel
+	if err := q.Order("created_at DESC, id DESC").Limit(filter.Limit).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	return r.withStops(ctx, rows)
+}
+
+func (r *RideRepo) UpdateStatusIfCurrent(ctx context.Context, id string, version int64, currentStatus string, nextStatus string, updatedAt time.Time) error {
+	return r.updateIfCurrent(ctx, id, version, map[string]interface{}{
+		"status":     nextStatus,
+		"updated_at": updatedAt,
+	}, "status = ?", currentStatus)
+}
+
+func (r *RideRepo) AssignDriverIfCurrent(ctx context.Context, id string, version int64, driverID string, currentStatus string, nextStatus string, updatedAt time.Time) error {
+	return r.updateIfCurrent(ctx, id, version, map[string]interface{}{
+		"driver_id":   driverID,
+		"status":      nextStatus,
+		"assigned_at": updatedAt,
+		"updated_at":  updatedAt,
+	}, "status = ?", currentStatus)
+}
+
+func (r *RideRepo) ReleaseDriverIfCurrent(ctx context.Context, id string, version int64, driverID string, currentStatus string, nextStatus string, updatedAt time.Time) error {
+	return r.updateIfCurrent(ctx, id, version, map[string]interface{}{
+		"driver_id":   nil,
+		"status":      nextStatus,
+		"assigned_at": nil,
+		"arrived_at":  nil,
+		"updated_at":  updatedAt,
+	}, "status = ? AND driver_id = ?", currentStatus, driverID)
+}
+
+func (r *RideRepo) ArriveIfCurrent(ctx context.Context, id string, version int64, currentStatus string, arrivedAt time.Time) error {
+	return r.updateIfCurrent(ctx, id, version, map[string]interface{}{
+		"status":     string(domain.StatusDriverArrived),
+		"arrived_at": arrivedAt,
+		"updated_at": arrivedAt,
+	}, "status = ?", currentStatus)
+}
+
+func (r *RideRepo) RedispatchIfCurrent(ctx context.Context, id string, version int64, currentStatus string, nextStatus string, updatedAt time.Time) error {
+	return r.updateIfCurrent(ctx, id, version, map[string]interface{}{
+		"status":           nextStatus,
+		"redispatch_count": gorm.Expr("redispatch_count + 1"),
+		"updated_at":       updatedAt,
+	}, "status = ?", currentStatus)
+}
+
+func (r *RideRepo) CancelIfCurrent(ctx context.Context, id string, version int64, currentStatus string, cancellation outbound.RideCancellation, updatedAt time.Time) error {
+	return r.updateIfCurrent(ctx, id, version, map[string]interface{}{
+		"status":           string(domain.StatusCancelled),
+		"cancel_reason":    cancellation.Reason,
+		"cancellation_fee": cancellation.Fee,
+		"updated_at":       updatedAt,
+	}, "status = ?", currentStatus)
+}
+
+func (r *RideRepo) CompleteIfCurrent(ctx context.Context, id string, version int64, currentStatus string, completedAt time.Time) error {
+	return r.updateIfCurrent(ctx, id, version, map[string]interface{}{
+		"status":       string(domain.StatusCompleted),
+		"completed_at": completedAt,
+		"updated_at":   completedAt,
+	}, "status = ?", currentStatus)
+}
+
+// updateIfCurrent applies updates to the ride only while it is still at
+// version and matches guard, bumping the version in the same statement. A
+// missed write is reported as ErrNotFound, as a conflict carrying the version
+// the ride is at now, or as plain ErrConflict when only guard failed.
+func (r *RideRepo) updateIfCurrent(ctx context.Context, id string, version int64, updates map[string]interface{}, guard string, args ...interface{}) error {
+	updates["version"] = gorm.Expr("version + 1")
+	q := r.DB.WithContext(ctx).Model(&rideModel{}).Where("id = ? AND version = ?", id, version)
+	if guard != "" {
+		q = q.Where(guard, args...)
+	}
+	result := q.Updates(updates)
 	if result.Error != nil {
-		return result.Error
+		return activeRideError(result.Error)
 	}
 	if result.RowsAffected > 0 {
 		return nil
 	}
-	if exists, err := r.exists(ctx, id); err != nil {
+	var current rideModel
+	if err := r.DB.WithContext(ctx).Select("id", "version").First(&current, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return outbound.ErrNotFound
+		}
 		return err
-	} else if !exists {
-		return outbound.ErrNotFound
 	}
-	return outbound.ErrConflict
+	if current.Version == version {
+		return outbound.ErrConflict
+	}
+	return &outbound.VersionConflictError{RideID: id, Current: current.Version}
 }
 
-func (r *RideRepo) AssignDriverIfCurrent(ctx context.Context, id string, driverID string, currentStatus string, nextStatus string, updatedAt time.Time) error {
-	result := r.DB.WithContext(ctx).Model(&rideModel{}).
-		Where("id = ? AND status = ?", id, currentStatus).
-		Updates(map[string]interface{}{
-			"driver_id":  driverID,
-			"status":     nextStatus,
-			"updated_at": updatedAt,
-		})
-	if result.Error != nil {
-		return result.Error
+func (r *RideRepo) ClaimScheduled(ctx context.Context, cutoff time.Time, limit int) ([]outbound.Ride, error) {
+	return r.claim(ctx, r.DB.WithContext(ctx).
+		Where("status = ? AND pickup_at <= ?", string(domain.StatusScheduled), cutoff).
+		Where("NOT EXISTS (SELECT 1 FROM rides AS active WHERE active.rider_id = rides.rider_id AND active.status IN ?)", activeStatuses()), "pickup_at", limit)
+}
+
+func (r *RideRepo) ClaimOverdueScheduled(ctx context.Context, cutoff time.Time, limit int) ([]outbound.Ride, error) {
+	return r.claim(ctx, r.DB.WithContext(ctx).
+		Where("status = ? AND pickup_at <= ?", string(domain.StatusScheduled), cutoff), "pickup_at", limit)
+}
+
+func (r *RideRepo) ClaimReminders(ctx context.Context, cutoff time.Time, limit int) ([]outbound.Ride, error) {
+	return r.claim(ctx, r.DB.WithContext(ctx).
+		Where("status = ? AND reminder_sent_at IS NULL AND pickup_at <= ?", string(domain.StatusScheduled), cutoff), "pickup_at", limit)
+}
+
+func (r *RideRepo) ClaimStuck(ctx context.Context, status string, cutoff time.Time, limit int) ([]outbound.Ride, error) {
+	return r.claim(ctx, r.DB.WithContext(ctx).
+		Where("status = ? AND updated_at <= ?", status, cutoff), "updated_at", limit)
+}
+
+func (r *RideRepo) claim(ctx context.Context, q *gorm.DB, order string, limit int) ([]outbound.Ride, error) {
+	if limit <= 0 {
+		limit = 50
 	}
-	if result.RowsAffected > 0 {
-		return nil
+	var rows []rideModel
+	if err := q.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Order(order).
+		Limit(limit).
+		Find(&rows).Error; err != nil {
+		return nil, err
 	}
-	if exists, err := r.exists(ctx, id); err != nil {
+	return r.withStops(ctx, rows)
+}
+
+func (r *RideRepo) MarkReminderSent(ctx context.Context, id string, version int64, sentAt time.Time) error {
+	return r.updateIfCurrent(ctx, id, version, map[string]interface{}{
+		"reminder_sent_at": sentAt,
+	}, "")
+}
+
+// ReschedulePickup moves the pickup of a SCHEDULED ride and re-arms its
+// reminder for the new time.
+func (r *RideRepo) ReschedulePickup(ctx context.Context, id string, version int64, pickupAt time.Time, updatedAt time.Time) error {
+	return r.updateIfCurrent(ctx, id, version, map[string]interface{}{
+		"pickup_at":        pickupAt,
+		"reminder_sent_at": nil,
+		"updated_at":       updatedAt,
+	}, "status = ?", string(domain.StatusScheduled))
+}
+
+// uniqueViolation is the Postgres SQLSTATE for a unique index violation.
+const uniqueViolation = "23505"
+
+// activeRideError turns a violation of rides_rider_active_idx or
+// rides_driver_active_idx into the matching domain error. The failed statement
+// aborts the transaction, so the ride that holds the user is left for the
+// caller to look up.
+func activeRideError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != uniqueViolation {
 		return err
-	} else if !exists {
-		return outbound.ErrNotFound
 	}
-	return outbound.ErrConflict
+	switch pgErr.ConstraintName {
+	case "rides_rider_active_idx":
+		return &domain.ActiveRideError{Err: domain.ErrRiderHasActiveRide}
+	case "rides_driver_active_idx":
+		return &domain.ActiveRideError{Err: domain.ErrDriverHasActiveRide}
+	}
+	return err
 }
 
-func (r *RideRepo) exists(ctx context.Context, id string) (bool, error) {
-	var count int64
-	if err := r.DB.WithContext(ctx).Model(&rideModel{}).Where("id = ?", id).Count(&count).Error; err != nil {
-		return false, err
+func activeStatuses() []string {
+	statuses := make([]string, 0, len(domain.ActiveStatuses))
+	for _, status := range domain.ActiveStatuses {
+		statuses = append(statuses, string(status))
 	}
-	return count > 0, nil
+	return statuses
 }
@@ -0,0 +1,109 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+type rideStopModel struct {
+	RideID     string     `gorm:"column:ride_id;primaryKey"`
+	Seq        int        `gorm:"column:seq;primaryKey"`
+	Lat        float64    `gorm:"column:lat"`
+	Lng        float64    `gorm:"column:lng"`
+	ArrivedAt  *time.Time `gorm:"column:arrived_at"`
+	DepartedAt *time.Time `gorm:"column:departed_at"`
+}
+
+func (rideStopModel) TableName() string {
+	return "ride_stops"
+}
+
+func (r *RideRepo) createStops(ctx context.Context, rideID string, stops []outbound.RideStop) error {
+	if len(stops) == 0 {
+		return nil
+	}
+	rows := make([]rideStopModel, 0, len(stops))
+	for _, s := range stops {
+		rows = append(rows, rideStopModel{
+			RideID:     rideID,
+			Seq:        s.Seq,
+			Lat:        s.Lat,
+			Lng:        s.Lng,
+			ArrivedAt:  s.ArrivedAt,
+			DepartedAt: s.DepartedAt,
+		})
+	}
+	return r.DB.WithContext(ctx).Create(&rows).Error
+}
+
+// withStops converts ride rows and attaches their stops with a single query.
+func (r *RideRepo) withStops(ctx context.Context, rides []rideModel) ([]outbound.Ride, error) {
+	out := make([]outbound.Ride, 0, len(rides))
+	if len(rides) == 0 {
+		return out, nil
+	}
+	ids := make([]string, 0, len(rides))
+	for _, m := range rides {
+		ids = append(ids, m.ID)
+	}
+	var rows []rideStopModel
+	if err := r.DB.WithContext(ctx).
+		Where("ride_id IN ?", ids).
+		Order("ride_id, seq").
+		Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	byRide := make(map[string][]outbound.RideStop, len(rides))
+	for _, s := range rows {
+		byRide[s.RideID] = append(byRide[s.RideID], outbound.RideStop{
+			Seq:        s.Seq,
+			Lat:        s.Lat,
+			Lng:        s.Lng,
+			ArrivedAt:  s.ArrivedAt,
+			DepartedAt: s.DepartedAt,
+		})
+	}
+	for _, m := range rides {
+		ride := m.toOutbound()
+		ride.Stops = byRide[m.ID]
+		out = append(out, ride)
+	}
+	return out, nil
+}
+
+func (r *RideRepo) MarkStopArrived(ctx context.Context, rideID string, version int64, seq int, at time.Time) error {
+	return r.markStop(ctx, rideID, version, seq, "arrived_at IS NULL", "arrived_at", at)
+}
+
+func (r *RideRepo) MarkStopDeparted(ctx context.Context, rideID string, version int64, seq int, at time.Time) error {
+	return r.markStop(ctx, rideID, version, seq, "arrived_at IS NOT NULL AND departed_at IS NULL", "departed_at", at)
+}
+
+// markStop bumps the ride's version before touching the stop, so a stop update
+// conflicts with any other write made since the ride was read. Callers run it
+// inside a transaction.
+func (r *RideRepo) markStop(ctx context.Context, rideID string, version int64, seq int, guard string, column string, at time.Time) error {
+	if err := r.updateIfCurrent(ctx, rideID, version, map[string]interface{}{"updated_at": at}, ""); err != nil {
+		return err
+	}
+	result := r.DB.WithContext(ctx).Model(&rideStopModel{}).
+		Where("ride_id = ? AND seq = ?", rideID, seq).
+		Where(guard).
+		Update(column, at)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+	var count int64
+	if err := r.DB.WithContext(ctx).Model(&rideStopModel{}).Where("ride_id = ? AND seq = ?", rideID, seq).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return outbound.ErrNotFound
+	}
+	return outbound.ErrConflict
+}
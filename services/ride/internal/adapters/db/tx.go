@@ -34,6 +34,19 @@ func (t *gormTx) Rollback() error {
 	return t.tx.Rollback().Error
 }
 
+func (t *gormTx) Savepoint(name string, fn func() error) error {
+	if err := t.tx.SavePoint(name).Error; err != nil {
+		return err
+	}
+	if err := fn(); err != nil {
+		if rbErr := t.tx.RollbackTo(name).Error; rbErr != nil {
+			return rbErr
+		}
+		return err
+	}
+	return t.tx.Exec("RELEASE SAVEPOINT " + name).Error
+}
+
 func (t *gormTx) RideRepo() outbound.RideRepo {
 	return NewRideRepo(t.tx)
 }
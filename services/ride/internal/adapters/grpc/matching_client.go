@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	matchingv1 "github.com/daffahilmyf/ride-hailing/proto/matching/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// MatchingClient connects lazily: matching depends on ride, so ride must be
+// able to start before matching is reachable.
+type MatchingClient struct {
+	conn    *grpc.ClientConn
+	client  matchingv1.MatchingServiceClient
+	token   string
+	timeout time.Duration
+}
+
+func NewMatchingClient(addr string, token string, requestTimeout time.Duration) (*MatchingClient, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &MatchingClient{
+		conn:    conn,
+		client:  matchingv1.NewMatchingServiceClient(conn),
+		token:   token,
+		timeout: requestTimeout,
+	}, nil
+}
+
+func (c *MatchingClient) Close() error {
+	if c == nil || c.conn == nil {
+		return nil
+	}
+	return c.conn.Close()
+}
+
+func (c *MatchingClient) GetSurge(ctx context.Context, in *matchingv1.GetSurgeRequest, opts ...grpc.CallOption) (*matchingv1.GetSurgeResponse, error) {
+	ctx = WithInternalToken(ctx, c.token)
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	return c.client.GetSurge(ctx, in, opts...)
+}
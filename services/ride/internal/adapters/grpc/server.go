@@ -15,6 +15,7 @@ func NewServer(logger *zap.Logger, deps handlers.Dependencies, metrics *Metrics,
 		grpc.ChainUnaryInterceptor(UnaryInterceptors(logger, metrics, auth)...),
 	)
 	handlers.RegisterRideServer(srv, logger, deps)
+	handlers.RegisterOutboxAdminServer(srv, logger, deps)
 	return &Server{grpc: srv}
 }
 
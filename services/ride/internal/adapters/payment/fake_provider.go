@@ -0,0 +1,130 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+const FakeProviderName = "fake"
+
+var ErrUnknownAuthorization = errors.New("unknown authorization")
+
+type fakeAuthorization struct {
+	amount   int64
+	captured int64
+	refunded int64
+	voided   bool
+}
+
+type fakeOutcome struct {
+	result outbound.PaymentResult
+	err    error
+}
+
+// FakeProvider is an in-process card processor for tests and local stacks. It
+// keeps holds in memory, honours idempotency keys like a real gateway and
+// declines any authorization above DeclineAbove when that is set.
+type FakeProvider struct {
+	DeclineAbove int64
+
+	mu       sync.Mutex
+	auths    map[string]*fakeAuthorization
+	outcomes map[string]fakeOutcome
+}
+
+func NewFakeProvider(declineAbove int64) *FakeProvider {
+	return &FakeProvider{
+		DeclineAbove: declineAbove,
+		auths:        map[string]*fakeAuthorization{},
+		outcomes:     map[string]fakeOutcome{},
+	}
+}
+
+func (p *FakeProvider) Authorize(ctx context.Context, req outbound.AuthorizeRequest) (outbound.PaymentResult, error) {
+	return p.once("authorize:"+req.IdempotencyKey, func() (outbound.PaymentResult, error) {
+		if req.Amount <= 0 || (p.DeclineAbove > 0 && req.Amount > p.DeclineAbove) {
+			return outbound.PaymentResult{}, domain.ErrPaymentDeclined
+		}
+		ref := "fake_" + uuid.NewString()
+		p.auths[ref] = &fakeAuthorization{amount: req.Amount}
+		return outbound.PaymentResult{ProviderRef: ref, Amount: req.Amount}, nil
+	})
+}
+
+func (p *FakeProvider) Capture(ctx context.Context, req outbound.CaptureRequest) (outbound.PaymentResult, error) {
+	return p.once("capture:"+req.IdempotencyKey, func() (outbound.PaymentResult, error) {
+		auth, ok := p.auths[req.ProviderRef]
+		if !ok {
+			return outbound.PaymentResult{}, ErrUnknownAuthorization
+		}
+		if auth.voided || auth.captured > 0 || req.Amount <= 0 || req.Amount > auth.amount {
+			return outbound.PaymentResult{}, domain.ErrInvalidPaymentTransition
+		}
+		auth.captured = req.Amount
+		return outbound.PaymentResult{ProviderRef: req.ProviderRef, Amount: req.Amount}, nil
+	})
+}
+
+func (p *FakeProvider) Void(ctx context.Context, req outbound.VoidRequest) (outbound.PaymentResult, error) {
+	return p.once("void:"+req.IdempotencyKey, func() (outbound.PaymentResult, error) {
+		auth, ok := p.auths[req.ProviderRef]
+		if !ok {
+			return outbound.PaymentResult{}, ErrUnknownAuthorization
+		}
+		if auth.captured > 0 {
+			return outbound.PaymentResult{}, domain.ErrInvalidPaymentTransition
+		}
+		auth.voided = true
+		return outbound.PaymentResult{ProviderRef: req.ProviderRef}, nil
+	})
+}
+
+func (p *FakeProvider) Refund(ctx context.Context, req outbound.RefundRequest) (outbound.PaymentResult, error) {
+	return p.once("refund:"+req.IdempotencyKey, func() (outbound.PaymentResult, error) {
+		auth, ok := p.auths[req.ProviderRef]
+		if !ok {
+			return outbound.PaymentResult{}, ErrUnknownAuthorization
+		}
+		if req.Amount <= 0 || auth.refunded+req.Amount > auth.captured {
+			return outbound.PaymentResult{}, domain.ErrInvalidRefund
+		}
+		auth.refunded += req.Amount
+		return outbound.PaymentResult{ProviderRef: req.ProviderRef, Amount: req.Amount}, nil
+	})
+}
+
+// Captured reports how much of the hold behind ref has been captured.
+func (p *FakeProvider) Captured(ref string) int64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if auth, ok := p.auths[ref]; ok {
+		return auth.captured
+	}
+	return 0
+}
+
+// Voided reports whether the hold behind ref was released.
+func (p *FakeProvider) Voided(ref string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if auth, ok := p.auths[ref]; ok {
+		return auth.voided
+	}
+	return false
+}
+
+func (p *FakeProvider) once(key string, call func() (outbound.PaymentResult, error)) (outbound.PaymentResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if outcome, ok := p.outcomes[key]; ok {
+		return outcome.result, outcome.err
+	}
+	result, err := call()
+	p.outcomes[key] = fakeOutcome{result: result, err: err}
+	return result, err
+}
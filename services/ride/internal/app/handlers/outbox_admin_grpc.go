@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	ridev1 "github.com/daffahilmyf/ride-hailing/proto/ride/v1"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/usecase"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type OutboxAdminServer struct {
+	ridev1.UnimplementedOutboxAdminServer
+	logger *zap.Logger
+	admin  *usecase.OutboxAdmin
+}
+
+func RegisterOutboxAdminServer(srv *grpc.Server, logger *zap.Logger, deps Dependencies) {
+	if deps.OutboxAdmin == nil {
+		return
+	}
+	ridev1.RegisterOutboxAdminServer(srv, &OutboxAdminServer{logger: logger, admin: deps.OutboxAdmin})
+}
+
+func (s *OutboxAdminServer) ListOutbox(ctx context.Context, req *ridev1.ListOutboxRequest) (*ridev1.ListOutboxResponse, error) {
+	page, err := s.admin.List(ctx, usecase.ListOutboxQuery{
+		Status:        req.GetStatus(),
+		Topic:         req.GetTopic(),
+		CreatedAfter:  fromEpoch(req.GetCreatedAfter()),
+		CreatedBefore: fromEpoch(req.GetCreatedBefore()),
+		PageSize:      int(req.GetPageSize()),
+		Cursor:        req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, mapOutboxError(err, "failed to list outbox")
+	}
+	messages := make([]*ridev1.OutboxMessage, 0, len(page.Messages))
+	for _, record := range page.Messages {
+		msg := toProtoOutboxMessage(record)
+		msg.Payload = ""
+		messages = append(messages, msg)
+	}
+	return &ridev1.ListOutboxResponse{Messages: messages, NextPageToken: page.NextCursor}, nil
+}
+
+func (s *OutboxAdminServer) GetOutboxMessage(ctx context.Context, req *ridev1.GetOutboxMessageRequest) (*ridev1.GetOutboxMessageResponse, error) {
+	record, err := s.admin.Get(ctx, req.GetId())
+	if err != nil {
+		return nil, mapOutboxError(err, "failed to get outbox message")
+	}
+	return &ridev1.GetOutboxMessageResponse{Message: toProtoOutboxMessage(record)}, nil
+}
+
+func (s *OutboxAdminServer) ReplayOutbox(ctx context.Context, req *ridev1.ReplayOutboxRequest) (*ridev1.ReplayOutboxResponse, error) {
+	replayed, err := s.admin.Replay(ctx, usecase.OutboxActionCmd{
+		IDs:           req.GetIds(),
+		Topic:         req.GetTopic(),
+		Status:        req.GetStatus(),
+		CreatedAfter:  fromEpoch(req.GetCreatedAfter()),
+		CreatedBefore: fromEpoch(req.GetCreatedBefore()),
+		Actor:         req.GetActor(),
+		Note:          req.GetNote(),
+	})
+	if err != nil {
+		return nil, mapOutboxError(err, "failed to replay outbox")
+	}
+	s.logger.Info("outbox.admin_replay", zap.String("actor", req.GetActor()), zap.Int64("rows", replayed), zap.String("note", req.GetNote()))
+	return &ridev1.ReplayOutboxResponse{Replayed: replayed}, nil
+}
+
+func (s *OutboxAdminServer) SkipOutbox(ctx context.Context, req *ridev1.SkipOutboxRequest) (*ridev1.SkipOutboxResponse, error) {
+	skipped, err := s.admin.Skip(ctx, usecase.OutboxActionCmd{IDs: req.GetIds(), Actor: req.GetActor(), Note: req.GetNote()})
+	if err != nil {
+		return nil, mapOutboxError(err, "failed to skip outbox")
+	}
+	s.logger.Info("outbox.admin_skip", zap.String("actor", req.GetActor()), zap.Int64("rows", skipped), zap.String("note", req.GetNote()))
+	return &ridev1.SkipOutboxResponse{Skipped: skipped}, nil
+}
+
+func (s *OutboxAdminServer) PurgeOutbox(ctx context.Context, req *ridev1.PurgeOutboxRequest) (*ridev1.PurgeOutboxResponse, error) {
+	purged, err := s.admin.Purge(ctx, usecase.OutboxActionCmd{IDs: req.GetIds(), Actor: req.GetActor(), Note: req.GetNote()})
+	if err != nil {
+		return nil, mapOutboxError(err, "failed to purge outbox")
+	}
+	s.logger.Info("outbox.admin_purge", zap.String("actor", req.GetActor()), zap.Int64("rows", purged), zap.String("note", req.GetNote()))
+	return &ridev1.PurgeOutboxResponse{Purged: purged}, nil
+}
+
+func (s *OutboxAdminServer) GetOutboxStats(ctx context.Context, req *ridev1.GetOutboxStatsRequest) (*ridev1.GetOutboxStatsResponse, error) {
+	stats, err := s.admin.Stats(ctx)
+	if err != nil {
+		return nil, mapOutboxError(err, "failed to get outbox stats")
+	}
+	resp := &ridev1.GetOutboxStatsResponse{}
+	for _, row := range stats.Statuses {
+		resp.Statuses = append(resp.Statuses, &ridev1.OutboxStatusStats{
+			Status:          row.Status,
+			Count:           row.Count,
+			OldestCreatedAt: row.Oldest.Unix(),
+		})
+	}
+	for _, row := range stats.Topics {
+		resp.Topics = append(resp.Topics, &ridev1.OutboxTopicBacklog{
+			Topic:      row.Topic,
+			Pending:    row.Pending,
+			Processing: row.Processing,
+			Failed:     row.Failed,
+		})
+	}
+	return resp, nil
+}
+
+func mapOutboxError(err error, msg string) error {
+	switch {
+	case errors.Is(err, outbound.ErrNotFound):
+		return status.Error(codes.NotFound, "outbox message not found")
+	case errors.Is(err, usecase.ErrInvalidOutboxSelection):
+		return status.Error(codes.InvalidArgument, "invalid outbox selection")
+	case errors.Is(err, usecase.ErrAuditNoteRequired):
+		return status.Error(codes.InvalidArgument, "audit note required")
+	case errors.Is(err, usecase.ErrInvalidCursor):
+		return status.Error(codes.InvalidArgument, "invalid cursor")
+	case errors.Is(err, domain.ErrInvalidActor):
+		return status.Error(codes.InvalidArgument, "invalid actor")
+	default:
+		return status.Error(codes.Internal, msg)
+	}
+}
+
+func toProtoOutboxMessage(record outbound.OutboxRecord) *ridev1.OutboxMessage {
+	msg := &ridev1.OutboxMessage{
+		Id:           record.ID,
+		Topic:        record.Topic,
+		AggregateId:  record.AggregateID,
+		Status:       record.Status,
+		AttemptCount: int32(record.AttemptCount),
+		LastError:    record.LastError,
+		Payload:      record.Payload,
+		AvailableAt:  record.AvailableAt.Unix(),
+		CreatedAt:    record.CreatedAt.Unix(),
+	}
+	if !record.LeaseUntil.IsZero() {
+		msg.LeaseUntil = record.LeaseUntil.Unix()
+	}
+	return msg
+}
+
+func fromEpoch(seconds int64) time.Time {
+	if seconds <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0).UTC()
+}
@@ -3,6 +3,8 @@ package handlers
 import (
 	"context"
 	"errors"
+	"strconv"
+	"strings"
 	"time"
 
 	ridev1 "github.com/daffahilmyf/ride-hailing/proto/ride/v1"
@@ -10,9 +12,11 @@ import (
 	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
 	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 type RideServer struct {
@@ -22,7 +26,8 @@ type RideServer struct {
 }
 
 type Dependencies struct {
-	Usecase *usecase.RideService
+	Usecase     *usecase.RideService
+	OutboxAdmin *usecase.OutboxAdmin
 }
 
 func RegisterRideServer(srv *grpc.Server, logger *zap.Logger, deps Dependencies) {
@@ -30,18 +35,73 @@ func RegisterRideServer(srv *grpc.Server, logger *zap.Logger, deps Dependencies)
 }
 
 func (s *RideServer) CreateRide(ctx context.Context, req *ridev1.CreateRideRequest) (*ridev1.CreateRideResponse, error) {
-	ride, err := s.usecase.CreateRide(ctx, usecase.CreateRideCmd{
+	cmd := usecase.CreateRideCmd{
 		RiderID:        req.GetRiderId(),
 		PickupLat:      req.GetPickupLat(),
 		PickupLng:      req.GetPickupLng(),
 		DropoffLat:     req.GetDropoffLat(),
 		DropoffLng:     req.GetDropoffLng(),
+		Product:        req.GetProduct(),
+		QuoteID:        req.GetQuoteId(),
+		Stops:          fromProtoLocations(req.GetStops()),
 		IdempotencyKey: req.GetIdempotencyKey(),
-	})
+	}
+	if req.GetPickupAt() > 0 {
+		cmd.PickupAt = time.Unix(req.GetPickupAt(), 0).UTC()
+	}
+	ride, err := s.usecase.CreateRide(ctx, cmd)
 	if err != nil {
 		return nil, mapError(err, "failed to create ride")
 	}
-	return &ridev1.CreateRideResponse{RideId: ride.ID, Status: string(ride.Status)}, nil
+	resp := &ridev1.CreateRideResponse{
+		RideId:     ride.ID,
+		Status:     string(ride.Status),
+		FareAmount: ride.FareAmount,
+		Currency:   ride.Currency,
+		Version:    ride.Version,
+	}
+	if ride.IsScheduled() {
+		resp.PickupAt = ride.PickupAt.Unix()
+	}
+	return resp, nil
+}
+
+func (s *RideServer) QuoteFare(ctx context.Context, req *ridev1.QuoteFareRequest) (*ridev1.QuoteFareResponse, error) {
+	signed, err := s.usecase.QuoteFare(ctx, usecase.QuoteFareCmd{
+		RiderID:    req.GetRiderId(),
+		Product:    req.GetProduct(),
+		PickupLat:  req.GetPickupLat(),
+		PickupLng:  req.GetPickupLng(),
+		DropoffLat: req.GetDropoffLat(),
+		DropoffLng: req.GetDropoffLng(),
+		Stops:      fromProtoLocations(req.GetStops()),
+	})
+	if err != nil {
+		return nil, mapError(err, "failed to quote fare")
+	}
+	fare := signed.Quote.Fare
+	legs := make([]*ridev1.FareLeg, 0, len(signed.Legs))
+	for _, leg := range signed.Legs {
+		legs = append(legs, &ridev1.FareLeg{DistanceMeters: leg.DistanceMeters, DurationSeconds: leg.DurationSeconds})
+	}
+	return &ridev1.QuoteFareResponse{
+		QuoteId: signed.Token,
+		Fare: &ridev1.FareBreakdown{
+			Product:         fare.Product,
+			Currency:        fare.Currency,
+			BaseFare:        fare.BaseFare,
+			DistanceFare:    fare.DistanceFare,
+			TimeFare:        fare.TimeFare,
+			BookingFee:      fare.BookingFee,
+			Total:           fare.Total,
+			DistanceMeters:  fare.DistanceMeters,
+			DurationSeconds: fare.DurationSeconds,
+			SurgeMultiplier: fare.SurgeMultiplier,
+			SurgeFare:       fare.SurgeFare,
+		},
+		ExpiresAt: signed.Quote.ExpiresAt.Unix(),
+		Legs:      legs,
+	}, nil
 }
 
 func (s *RideServer) StartMatching(ctx context.Context, req *ridev1.StartMatchingRequest) (*ridev1.StartMatchingResponse, error) {
@@ -61,31 +121,311 @@ func (s *RideServer) AssignDriver(ctx context.Context, req *ridev1.AssignDriverR
 	return &ridev1.AssignDriverResponse{RideId: ride.ID, DriverId: req.GetDriverId(), Status: string(ride.Status)}, nil
 }
 
+func (s *RideServer) RescheduleRide(ctx context.Context, req *ridev1.RescheduleRideRequest) (*ridev1.RescheduleRideResponse, error) {
+	if req.GetPickupAt() <= 0 {
+		return nil, mapError(domain.ErrInvalidPickupTime, "failed to reschedule ride")
+	}
+	ride, err := s.usecase.RescheduleRide(ctx, usecase.RescheduleRideCmd{
+		RideID:          req.GetRideId(),
+		RiderID:         req.GetRiderId(),
+		PickupAt:        time.Unix(req.GetPickupAt(), 0).UTC(),
+		IdempotencyKey:  req.GetIdempotencyKey(),
+		ExpectedVersion: req.GetExpectedVersion(),
+	})
+	if err != nil {
+		return nil, mapError(err, "failed to reschedule ride")
+	}
+	return &ridev1.RescheduleRideResponse{RideId: ride.ID, Status: string(ride.Status), PickupAt: ride.PickupAt.Unix(), Version: ride.Version}, nil
+}
+
 func (s *RideServer) CancelRide(ctx context.Context, req *ridev1.CancelRideRequest) (*ridev1.CancelRideResponse, error) {
-	key := req.GetRequestId()
-	ride, err := s.usecase.CancelRide(ctx, req.GetRideId(), req.GetReason(), key)
+	actor, err := domain.ParseActor(req.GetActor())
 	if err != nil {
 		return nil, mapError(err, "failed to cancel ride")
 	}
-	return &ridev1.CancelRideResponse{RideId: ride.ID, Status: string(ride.Status)}, nil
+	code, err := fromProtoCancelReason(req.GetReasonCode())
+	if err != nil {
+		return nil, mapError(err, "failed to cancel ride")
+	}
+	ride, err := s.usecase.CancelRide(ctx, usecase.CancelRideCmd{
+		RideID:          req.GetRideId(),
+		Reason:          req.GetReason(),
+		ReasonCode:      code,
+		Actor:           actor,
+		ActorID:         req.GetActorId(),
+		ConfirmToken:    req.GetConfirmToken(),
+		IdempotencyKey:  req.GetRequestId(),
+		ExpectedVersion: req.GetExpectedVersion(),
+	})
+	if err != nil {
+		return nil, mapError(err, "failed to cancel ride")
+	}
+	return &ridev1.CancelRideResponse{
+		RideId:     ride.ID,
+		Status:     string(ride.Status),
+		FeeAmount:  ride.CancellationFee,
+		Currency:   ride.Currency,
+		ReasonCode: toProtoCancelReason(ride.CancelReason),
+		Version:    ride.Version,
+	}, nil
 }
 
-func (s *RideServer) StartRide(ctx context.Context, req *ridev1.AssignDriverRequest) (*ridev1.AssignDriverResponse, error) {
-	key := req.GetRequestId()
-	ride, err := s.usecase.StartRide(ctx, req.GetRideId(), key)
+func (s *RideServer) DriverCancelRide(ctx context.Context, req *ridev1.DriverCancelRideRequest) (*ridev1.DriverCancelRideResponse, error) {
+	code, err := fromProtoCancelReason(req.GetReasonCode())
+	if err != nil {
+		return nil, mapError(err, "failed to cancel ride")
+	}
+	ride, err := s.usecase.DriverCancelRide(ctx, usecase.DriverCancelRideCmd{
+		RideID:          req.GetRideId(),
+		DriverID:        req.GetDriverId(),
+		Reason:          req.GetReason(),
+		ReasonCode:      code,
+		IdempotencyKey:  req.GetIdempotencyKey(),
+		ExpectedVersion: req.GetExpectedVersion(),
+	})
+	if err != nil {
+		return nil, mapError(err, "failed to cancel ride")
+	}
+	return &ridev1.DriverCancelRideResponse{RideId: ride.ID, Status: string(ride.Status), Version: ride.Version}, nil
+}
+
+func (s *RideServer) OverridePickupPin(ctx context.Context, req *ridev1.OverridePickupPinRequest) (*ridev1.OverridePickupPinResponse, error) {
+	ride, err := s.usecase.OverridePickupPIN(ctx, usecase.OverridePickupPINCmd{
+		RideID:         req.GetRideId(),
+		ActorID:        req.GetActorId(),
+		Reason:         req.GetReason(),
+		IdempotencyKey: req.GetIdempotencyKey(),
+	})
+	if err != nil {
+		return nil, mapError(err, "failed to override pickup pin")
+	}
+	return &ridev1.OverridePickupPinResponse{RideId: ride.ID, Status: string(ride.Status)}, nil
+}
+
+func (s *RideServer) RateRide(ctx context.Context, req *ridev1.RateRideRequest) (*ridev1.RateRideResponse, error) {
+	role, err := domain.ParseActor(req.GetRaterRole())
+	if err != nil {
+		return nil, mapError(err, "failed to rate ride")
+	}
+	rating, err := s.usecase.RateRide(ctx, usecase.RateRideCmd{
+		RideID:    req.GetRideId(),
+		RaterID:   req.GetRaterId(),
+		RaterRole: role,
+		Stars:     int(req.GetStars()),
+		Tags:      req.GetTags(),
+		Comment:   req.GetComment(),
+	})
+	if err != nil {
+		return nil, mapError(err, "failed to rate ride")
+	}
+	return &ridev1.RateRideResponse{
+		RideId:    rating.RideID,
+		RaterRole: string(rating.RaterRole),
+		Stars:     int32(rating.Stars),
+		Tags:      rating.Tags,
+		CreatedAt: rating.CreatedAt.Unix(),
+	}, nil
+}
+
+func (s *RideServer) GetRidePayment(ctx context.Context, req *ridev1.GetRidePaymentRequest) (*ridev1.GetRidePaymentResponse, error) {
+	payment, err := s.usecase.GetPayment(ctx, req.GetRideId())
+	if err != nil {
+		return nil, mapError(err, "failed to get payment")
+	}
+	return &ridev1.GetRidePaymentResponse{Payment: toProtoPayment(payment)}, nil
+}
+
+func (s *RideServer) RefundRidePayment(ctx context.Context, req *ridev1.RefundRidePaymentRequest) (*ridev1.RefundRidePaymentResponse, error) {
+	payment, err := s.usecase.RefundPayment(ctx, usecase.RefundPaymentCmd{
+		RideID:   req.GetRideId(),
+		RefundID: req.GetRefundId(),
+		Amount:   req.GetAmount(),
+		Reason:   req.GetReason(),
+	})
+	if err != nil {
+		return nil, mapError(err, "failed to refund payment")
+	}
+	return &ridev1.RefundRidePaymentResponse{Payment: toProtoPayment(payment)}, nil
+}
+
+func (s *RideServer) GetDriverEarnings(ctx context.Context, req *ridev1.GetDriverEarningsRequest) (*ridev1.GetDriverEarningsResponse, error) {
+	query := usecase.DriverEarningsQuery{
+		DriverID: req.GetDriverId(),
+		Period:   req.GetPeriod(),
+	}
+	if req.GetFrom() > 0 {
+		query.From = time.Unix(req.GetFrom(), 0).UTC()
+	}
+	if req.GetTo() > 0 {
+		query.To = time.Unix(req.GetTo(), 0).UTC()
+	}
+	report, err := s.usecase.DriverEarnings(ctx, query)
+	if err != nil {
+		return nil, mapError(err, "failed to get earnings")
+	}
+	summaries := make([]*ridev1.EarningsSummary, 0, len(report.Summaries))
+	for _, summary := range report.Summaries {
+		summaries = append(summaries, &ridev1.EarningsSummary{
+			PeriodStart: summary.PeriodStart.Unix(),
+			Currency:    summary.Currency,
+			Earnings:    summary.Earnings,
+			Tips:        summary.Tips,
+			Fees:        summary.Fees,
+			Payouts:     summary.Payouts,
+			Net:         summary.Net(),
+			Rides:       int32(summary.Rides),
+		})
+	}
+	return &ridev1.GetDriverEarningsResponse{
+		DriverId:  req.GetDriverId(),
+		Period:    string(report.Period),
+		From:      report.From.Unix(),
+		To:        report.To.Unix(),
+		Summaries: summaries,
+	}, nil
+}
+
+func toProtoPayment(payment domain.Payment) *ridev1.RidePayment {
+	return &ridev1.RidePayment{
+		PaymentId:      payment.ID,
+		RideId:         payment.RideID,
+		Status:         string(payment.Status),
+		Amount:         payment.Amount,
+		CapturedAmount: payment.CapturedAmount,
+		RefundedAmount: payment.RefundedAmount,
+		Currency:       payment.Currency,
+		UpdatedAt:      payment.UpdatedAt.Unix(),
+	}
+}
+
+func (s *RideServer) MarkDriverArrived(ctx context.Context, req *ridev1.MarkDriverArrivedRequest) (*ridev1.MarkDriverArrivedResponse, error) {
+	ride, err := s.usecase.MarkDriverArrived(ctx, usecase.DriverRideCmd{
+		RideID:          req.GetRideId(),
+		DriverID:        req.GetDriverId(),
+		IdempotencyKey:  req.GetIdempotencyKey(),
+		ExpectedVersion: req.GetExpectedVersion(),
+	})
+	if err != nil {
+		return nil, mapError(err, "failed to mark driver arrived")
+	}
+	return &ridev1.MarkDriverArrivedResponse{
+		RideId:    ride.ID,
+		DriverId:  req.GetDriverId(),
+		Status:    string(ride.Status),
+		Version:   ride.Version,
+		ArrivedAt: ride.ArrivedAt.Unix(),
+		NoShowAt:  s.usecase.Arrival.NoShowAt(ride).Unix(),
+	}, nil
+}
+
+func (s *RideServer) StartRide(ctx context.Context, req *ridev1.StartRideRequest) (*ridev1.StartRideResponse, error) {
+	ride, err := s.usecase.StartRide(ctx, usecase.DriverRideCmd{
+		RideID:          req.GetRideId(),
+		DriverID:        req.GetDriverId(),
+		IdempotencyKey:  req.GetIdempotencyKey(),
+		ExpectedVersion: req.GetExpectedVersion(),
+		PIN:             req.GetPin(),
+	})
 	if err != nil {
 		return nil, mapError(err, "failed to start ride")
 	}
-	return &ridev1.AssignDriverResponse{RideId: ride.ID, DriverId: req.GetDriverId(), Status: string(ride.Status)}, nil
+	return &ridev1.StartRideResponse{RideId: ride.ID, DriverId: req.GetDriverId(), Status: string(ride.Status), Version: ride.Version}, nil
 }
 
-func (s *RideServer) CompleteRide(ctx context.Context, req *ridev1.AssignDriverRequest) (*ridev1.AssignDriverResponse, error) {
-	key := req.GetRequestId()
-	ride, err := s.usecase.CompleteRide(ctx, req.GetRideId(), key)
+func (s *RideServer) CompleteRide(ctx context.Context, req *ridev1.CompleteRideRequest) (*ridev1.CompleteRideResponse, error) {
+	ride, err := s.usecase.CompleteRide(ctx, usecase.DriverRideCmd{
+		RideID:          req.GetRideId(),
+		DriverID:        req.GetDriverId(),
+		IdempotencyKey:  req.GetIdempotencyKey(),
+		ExpectedVersion: req.GetExpectedVersion(),
+	})
 	if err != nil {
 		return nil, mapError(err, "failed to complete ride")
 	}
-	return &ridev1.AssignDriverResponse{RideId: ride.ID, DriverId: req.GetDriverId(), Status: string(ride.Status)}, nil
+	return &ridev1.CompleteRideResponse{RideId: ride.ID, DriverId: req.GetDriverId(), Status: string(ride.Status), Version: ride.Version}, nil
+}
+
+func (s *RideServer) ArriveAtStop(ctx context.Context, req *ridev1.ArriveAtStopRequest) (*ridev1.ArriveAtStopResponse, error) {
+	ride, err := s.usecase.ArriveAtStop(ctx, usecase.StopActionCmd{
+		RideID:          req.GetRideId(),
+		DriverID:        req.GetDriverId(),
+		Seq:             int(req.GetSeq()),
+		IdempotencyKey:  req.GetIdempotencyKey(),
+		ExpectedVersion: req.GetExpectedVersion(),
+	})
+	if err != nil {
+		return nil, mapError(err, "failed to arrive at stop")
+	}
+	return &ridev1.ArriveAtStopResponse{RideId: ride.ID, Status: string(ride.Status), Stop: toProtoStopBySeq(ride, int(req.GetSeq())), Version: ride.Version}, nil
+}
+
+func (s *RideServer) DepartStop(ctx context.Context, req *ridev1.DepartStopRequest) (*ridev1.DepartStopResponse, error) {
+	ride, err := s.usecase.DepartStop(ctx, usecase.StopActionCmd{
+		RideID:          req.GetRideId(),
+		DriverID:        req.GetDriverId(),
+		Seq:             int(req.GetSeq()),
+		IdempotencyKey:  req.GetIdempotencyKey(),
+		ExpectedVersion: req.GetExpectedVersion(),
+	})
+	if err != nil {
+		return nil, mapError(err, "failed to depart stop")
+	}
+	return &ridev1.DepartStopResponse{RideId: ride.ID, Status: string(ride.Status), Stop: toProtoStopBySeq(ride, int(req.GetSeq())), Version: ride.Version}, nil
+}
+
+func (s *RideServer) GetRide(ctx context.Context, req *ridev1.GetRideRequest) (*ridev1.GetRideResponse, error) {
+	ride, err := s.usecase.GetRide(ctx, req.GetRideId())
+	if err != nil {
+		return nil, mapError(err, "failed to get ride")
+	}
+	return &ridev1.GetRideResponse{Ride: toProtoRide(ride)}, nil
+}
+
+func (s *RideServer) GetRideTimeline(ctx context.Context, req *ridev1.GetRideTimelineRequest) (*ridev1.GetRideTimelineResponse, error) {
+	events, err := s.usecase.GetRideTimeline(ctx, req.GetRideId())
+	if err != nil {
+		return nil, mapError(err, "failed to get ride timeline")
+	}
+	resp := &ridev1.GetRideTimelineResponse{
+		RideId: req.GetRideId(),
+		Events: make([]*ridev1.RideTimelineEvent, 0, len(events)),
+	}
+	for _, event := range events {
+		resp.Events = append(resp.Events, &ridev1.RideTimelineEvent{
+			FromStatus: string(event.FromStatus),
+			ToStatus:   string(event.ToStatus),
+			Actor:      string(event.Actor),
+			ActorId:    event.ActorID,
+			Reason:     event.Reason,
+			CreatedAt:  event.CreatedAt.Unix(),
+		})
+	}
+	return resp, nil
+}
+
+func (s *RideServer) ListRides(ctx context.Context, req *ridev1.ListRidesRequest) (*ridev1.ListRidesResponse, error) {
+	query := usecase.ListRidesQuery{
+		RiderID:  req.GetRiderId(),
+		DriverID: req.GetDriverId(),
+		Status:   req.GetStatus(),
+		PageSize: int(req.GetPageSize()),
+		Cursor:   req.GetCursor(),
+	}
+	if req.GetCreatedFrom() > 0 {
+		query.CreatedFrom = time.Unix(req.GetCreatedFrom(), 0).UTC()
+	}
+	if req.GetCreatedTo() > 0 {
+		query.CreatedTo = time.Unix(req.GetCreatedTo(), 0).UTC()
+	}
+	page, err := s.usecase.ListRides(ctx, query)
+	if err != nil {
+		return nil, mapError(err, "failed to list rides")
+	}
+	rides := make([]*ridev1.Ride, 0, len(page.Rides))
+	for _, ride := range page.Rides {
+		rides = append(rides, toProtoRide(ride))
+	}
+	return &ridev1.ListRidesResponse{Rides: rides, NextCursor: page.NextCursor}, nil
 }
 
 func (s *RideServer) CreateOffer(ctx context.Context, req *ridev1.CreateOfferRequest) (*ridev1.CreateOfferResponse, error) {
@@ -155,16 +495,269 @@ func (s *RideServer) ExpireOffer(ctx context.Context, req *ridev1.ExpireOfferReq
 	}, nil
 }
 
+func toProtoRide(ride domain.Ride) *ridev1.Ride {
+	out := &ridev1.Ride{
+		RideId:     ride.ID,
+		RiderId:    ride.RiderID,
+		Status:     string(ride.Status),
+		PickupLat:  ride.PickupLat,
+		PickupLng:  ride.PickupLng,
+		DropoffLat: ride.DropoffLat,
+		DropoffLng: ride.DropoffLng,
+		CreatedAt:  ride.CreatedAt.Unix(),
+		UpdatedAt:  ride.UpdatedAt.Unix(),
+		Product:    ride.Product,
+		FareAmount: ride.FareAmount,
+		Currency:   ride.Currency,
+		Version:    ride.Version,
+	}
+	if ride.DriverID != nil {
+		out.DriverId = *ride.DriverID
+	}
+	if ride.Status == domain.StatusCancelled {
+		out.CancellationFee = ride.CancellationFee
+		out.CancelReasonCode = toProtoCancelReason(ride.CancelReason)
+	}
+	if ride.IsScheduled() {
+		out.PickupAt = ride.PickupAt.Unix()
+	}
+	if !ride.ArrivedAt.IsZero() {
+		out.ArrivedAt = ride.ArrivedAt.Unix()
+	}
+	for _, stop := range ride.Stops {
+		out.Stops = append(out.Stops, toProtoStop(stop))
+	}
+	return out
+}
+
+func toProtoStop(stop domain.Stop) *ridev1.RideStop {
+	out := &ridev1.RideStop{Seq: int32(stop.Seq), Lat: stop.Lat, Lng: stop.Lng}
+	if !stop.ArrivedAt.IsZero() {
+		out.ArrivedAt = stop.ArrivedAt.Unix()
+	}
+	if !stop.DepartedAt.IsZero() {
+		out.DepartedAt = stop.DepartedAt.Unix()
+	}
+	return out
+}
+
+// toProtoStopBySeq returns the ride's stop numbered seq, or nil if it has none.
+func toProtoStopBySeq(ride domain.Ride, seq int) *ridev1.RideStop {
+	idx, ok := ride.StopIndex(seq)
+	if !ok {
+		return nil
+	}
+	return toProtoStop(ride.Stops[idx])
+}
+
+func fromProtoLocations(locations []*ridev1.Location) []domain.Point {
+	if len(locations) == 0 {
+		return nil
+	}
+	out := make([]domain.Point, 0, len(locations))
+	for _, loc := range locations {
+		out = append(out, domain.Point{Lat: loc.GetLat(), Lng: loc.GetLng()})
+	}
+	return out
+}
+
+const cancelReasonPrefix = "CANCELLATION_REASON_"
+
+// fromProtoCancelReason maps the wire enum onto the domain reason; the enum
+// names are the domain values with a prefix.
+func fromProtoCancelReason(code ridev1.CancellationReason) (domain.CancelReason, error) {
+	if code == ridev1.CancellationReason_CANCELLATION_REASON_UNSPECIFIED {
+		return domain.CancelOther, nil
+	}
+	return domain.ParseCancelReason(strings.TrimPrefix(code.String(), cancelReasonPrefix))
+}
+
+func toProtoCancelReason(reason domain.CancelReason) ridev1.CancellationReason {
+	if reason == "" {
+		return ridev1.CancellationReason_CANCELLATION_REASON_UNSPECIFIED
+	}
+	return ridev1.CancellationReason(ridev1.CancellationReason_value[cancelReasonPrefix+string(reason)])
+}
+
+// cancellationFeeError carries the fee quote and confirm token as ErrorInfo
+// metadata so the caller can show the fee and retry with the token.
+func cancellationFeeError(err *usecase.CancellationFeeError) error {
+	st := status.New(codes.FailedPrecondition, "cancellation fee required")
+	withDetails, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: "CANCELLATION_FEE_REQUIRED",
+		Domain: "ride-service",
+		Metadata: map[string]string{
+			"fee_amount":    strconv.FormatInt(err.Quote.Fee, 10),
+			"currency":      err.Quote.Currency,
+			"confirm_token": err.Token,
+			"expires_at":    strconv.FormatInt(err.Quote.ExpiresAt.Unix(), 10),
+		},
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// versionConflictError tells the caller which version the ride is at now, so
+// it can re-read the ride and retry against that version.
+func versionConflictError(err *outbound.VersionConflictError) error {
+	st := status.New(codes.Aborted, "ride version conflict")
+	withDetails, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: "VERSION_CONFLICT",
+		Domain: "ride-service",
+		Metadata: map[string]string{
+			"ride_id":         err.RideID,
+			"current_version": strconv.FormatInt(err.Current, 10),
+		},
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// activeRideError names the ride the rider or driver is already on, so the
+// client can resume it instead of starting another.
+func activeRideError(err *domain.ActiveRideError) error {
+	role := "rider"
+	if errors.Is(err.Err, domain.ErrDriverHasActiveRide) {
+		role = "driver"
+	}
+	st := status.New(codes.FailedPrecondition, "active ride exists")
+	withDetails, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: "ACTIVE_RIDE_EXISTS",
+		Domain: "ride-service",
+		Metadata: map[string]string{
+			"role":           role,
+			"active_ride_id": err.RideID,
+		},
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// requestInProgressRetryDelay is how long a duplicate is told to wait before
+// retrying a key whose first request is still running.
+const requestInProgressRetryDelay = time.Second
+
+// requestInProgressError tells a duplicate that the first request under its
+// idempotency key is still running: nothing failed, and retrying after the
+// delay returns that request's result.
+func requestInProgressError() error {
+	st := status.New(codes.Aborted, "request in progress")
+	withDetails, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: "REQUEST_IN_PROGRESS",
+		Domain: "ride-service",
+	}, &errdetails.RetryInfo{
+		RetryDelay: durationpb.New(requestInProgressRetryDelay),
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// domainError names the domain error in an ErrorInfo, so callers such as the
+// gateway can tell errors apart without matching on the message.
+func domainError(code codes.Code, reason string, msg string) error {
+	st := status.New(code, msg)
+	withDetails, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: "ride-service",
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
 func mapError(err error, msg string) error {
+	var feeErr *usecase.CancellationFeeError
+	if errors.As(err, &feeErr) {
+		return cancellationFeeError(feeErr)
+	}
+	var versionErr *outbound.VersionConflictError
+	if errors.As(err, &versionErr) {
+		return versionConflictError(versionErr)
+	}
+	var activeErr *domain.ActiveRideError
+	if errors.As(err, &activeErr) {
+		return activeRideError(activeErr)
+	}
 	switch {
 	case errors.Is(err, domain.ErrInvalidTransition):
-		return status.Error(codes.FailedPrecondition, "invalid transition")
+		return domainError(codes.FailedPrecondition, "INVALID_TRANSITION", "invalid transition")
 	case errors.Is(err, outbound.ErrNotFound):
-		return status.Error(codes.NotFound, "ride not found")
+		return domainError(codes.NotFound, "RIDE_NOT_FOUND", "ride not found")
 	case errors.Is(err, outbound.ErrConflict):
-		return status.Error(codes.Aborted, "state conflict")
+		return domainError(codes.Aborted, "STATE_CONFLICT", "state conflict")
+	case errors.Is(err, domain.ErrOfferExpired):
+		return domainError(codes.FailedPrecondition, "OFFER_EXPIRED", "offer expired")
 	case errors.Is(err, domain.ErrInvalidOfferTransition):
-		return status.Error(codes.FailedPrecondition, "invalid offer transition")
+		return domainError(codes.FailedPrecondition, "INVALID_OFFER_TRANSITION", "invalid offer transition")
+	case errors.Is(err, domain.ErrUnknownProduct):
+		return domainError(codes.InvalidArgument, "UNKNOWN_PRODUCT", "unknown product")
+	case errors.Is(err, domain.ErrInvalidQuote):
+		return domainError(codes.InvalidArgument, "INVALID_QUOTE", "invalid quote")
+	case errors.Is(err, domain.ErrQuoteExpired):
+		return domainError(codes.FailedPrecondition, "QUOTE_EXPIRED", "quote expired")
+	case errors.Is(err, usecase.ErrInvalidCursor):
+		return domainError(codes.InvalidArgument, "INVALID_CURSOR", "invalid cursor")
+	case errors.Is(err, domain.ErrInvalidActor):
+		return domainError(codes.InvalidArgument, "INVALID_ACTOR", "invalid actor")
+	case errors.Is(err, domain.ErrInvalidPickupTime):
+		return domainError(codes.InvalidArgument, "INVALID_PICKUP_TIME", "invalid pickup time")
+	case errors.Is(err, domain.ErrTooManyStops):
+		return domainError(codes.InvalidArgument, "TOO_MANY_STOPS", "too many stops")
+	case errors.Is(err, domain.ErrStopNotFound):
+		return domainError(codes.NotFound, "STOP_NOT_FOUND", "stop not found")
+	case errors.Is(err, domain.ErrStopOutOfOrder):
+		return domainError(codes.FailedPrecondition, "STOP_OUT_OF_ORDER", "stop out of order")
+	case errors.Is(err, domain.ErrDriverExcluded):
+		return domainError(codes.FailedPrecondition, "DRIVER_EXCLUDED", "driver excluded from ride")
+	case errors.Is(err, domain.ErrPickupPINRequired):
+		return domainError(codes.InvalidArgument, "PICKUP_PIN_REQUIRED", "pickup pin required")
+	case errors.Is(err, domain.ErrInvalidPickupPIN):
+		return domainError(codes.InvalidArgument, "INVALID_PICKUP_PIN", "invalid pickup pin")
+	case errors.Is(err, domain.ErrPickupPINLocked):
+		return domainError(codes.PermissionDenied, "PICKUP_PIN_LOCKED", "pickup pin locked")
+	case errors.Is(err, domain.ErrNoPickupPIN):
+		return domainError(codes.FailedPrecondition, "NO_PICKUP_PIN", "no pickup pin issued")
+	case errors.Is(err, domain.ErrNoShowTooEarly):
+		return domainError(codes.FailedPrecondition, "NO_SHOW_TOO_EARLY", "rider no-show too early")
+	case errors.Is(err, domain.ErrDriverMismatch):
+		return domainError(codes.PermissionDenied, "DRIVER_MISMATCH", "driver not assigned to ride")
+	case errors.Is(err, domain.ErrRiderMismatch):
+		return domainError(codes.PermissionDenied, "RIDER_MISMATCH", "ride not requested by rider")
+	case errors.Is(err, domain.ErrInvalidCancelReason):
+		return domainError(codes.InvalidArgument, "INVALID_CANCEL_REASON", "invalid cancel reason")
+	case errors.Is(err, domain.ErrInvalidRating):
+		return domainError(codes.InvalidArgument, "INVALID_RATING", "invalid rating")
+	case errors.Is(err, domain.ErrRatingWindowClosed):
+		return domainError(codes.FailedPrecondition, "RATING_WINDOW_CLOSED", "rating window closed")
+	case errors.Is(err, domain.ErrRatingNotPermitted):
+		return domainError(codes.FailedPrecondition, "RATING_NOT_PERMITTED", "rating not permitted")
+	case errors.Is(err, domain.ErrAlreadyRated):
+		return domainError(codes.AlreadyExists, "ALREADY_RATED", "ride already rated")
+	case errors.Is(err, domain.ErrPaymentDeclined):
+		return domainError(codes.FailedPrecondition, "PAYMENT_DECLINED", "payment declined")
+	case errors.Is(err, domain.ErrPaymentNotFound):
+		return domainError(codes.NotFound, "PAYMENT_NOT_FOUND", "payment not found")
+	case errors.Is(err, domain.ErrInvalidPaymentTransition):
+		return domainError(codes.FailedPrecondition, "INVALID_PAYMENT_TRANSITION", "invalid payment transition")
+	case errors.Is(err, domain.ErrInvalidRefund):
+		return domainError(codes.InvalidArgument, "INVALID_REFUND", "invalid refund")
+	case errors.Is(err, domain.ErrRefundMismatch):
+		return domainError(codes.FailedPrecondition, "REFUND_MISMATCH", "refund id reused with a different amount")
+	case errors.Is(err, domain.ErrInvalidEarningsPeriod):
+		return domainError(codes.InvalidArgument, "INVALID_EARNINGS_PERIOD", "invalid earnings period")
+	case errors.Is(err, usecase.ErrIdempotencyKeyReused):
+		return domainError(codes.FailedPrecondition, "IDEMPOTENCY_KEY_REUSED", "idempotency key reused")
+	case errors.Is(err, usecase.ErrRequestInProgress):
+		return requestInProgressError()
 	default:
 		return status.Error(codes.Internal, msg)
 	}
@@ -1,12 +1,36 @@
 package metrics
 
-import "sync/atomic"
+import (
+	"math"
+	"sync/atomic"
+	"time"
+)
+
+// LatencyBuckets are the upper bounds of the enqueue-to-publish histogram.
+// Anything slower lands in a final overflow bucket.
+var LatencyBuckets = [...]time.Duration{
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
 
 type OutboxMetrics struct {
 	claimed   atomic.Int64
 	published atomic.Int64
 	failed    atomic.Int64
 	dlq       atomic.Int64
+	reclaimed atomic.Int64
+
+	latency      [len(LatencyBuckets) + 1]atomic.Int64
+	latencyCount atomic.Int64
+	latencySum   atomic.Int64 // nanoseconds
 }
 
 func (m *OutboxMetrics) IncClaimed(n int) {
@@ -58,9 +82,96 @@ func (m *OutboxMetrics) IncDLQ() {
 	m.dlq.Add(1)
 }
 
+// AddDLQ counts messages dead-lettered outside the publish loop, such as
+// expired leases that had no attempts left.
+func (m *OutboxMetrics) AddDLQ(n int64) {
+	if m == nil {
+		return
+	}
+	m.dlq.Add(n)
+}
+
 func (m *OutboxMetrics) DLQ() int64 {
 	if m == nil {
 		return 0
 	}
 	return m.dlq.Load()
 }
+
+func (m *OutboxMetrics) IncReclaimed(n int64) {
+	if m == nil {
+		return
+	}
+	m.reclaimed.Add(n)
+}
+
+func (m *OutboxMetrics) Reclaimed() int64 {
+	if m == nil {
+		return 0
+	}
+	return m.reclaimed.Load()
+}
+
+// ObserveLatency records how long a message waited between enqueue and
+// publish.
+func (m *OutboxMetrics) ObserveLatency(d time.Duration) {
+	if m == nil {
+		return
+	}
+	if d < 0 {
+		d = 0
+	}
+	i := 0
+	for i < len(LatencyBuckets) && d > LatencyBuckets[i] {
+		i++
+	}
+	m.latency[i].Add(1)
+	m.latencyCount.Add(1)
+	m.latencySum.Add(int64(d))
+}
+
+// LatencyHistogram returns cumulative counts per bucket in LatencyBuckets
+// order, followed by the total count including overflow.
+func (m *OutboxMetrics) LatencyHistogram() []int64 {
+	out := make([]int64, len(LatencyBuckets)+1)
+	if m == nil {
+		return out
+	}
+	var running int64
+	for i := range out {
+		running += m.latency[i].Load()
+		out[i] = running
+	}
+	return out
+}
+
+func (m *OutboxMetrics) LatencyCount() int64 {
+	if m == nil {
+		return 0
+	}
+	return m.latencyCount.Load()
+}
+
+func (m *OutboxMetrics) LatencyMean() time.Duration {
+	if m == nil || m.latencyCount.Load() == 0 {
+		return 0
+	}
+	return time.Duration(m.latencySum.Load() / m.latencyCount.Load())
+}
+
+// LatencyQuantile estimates the q-th quantile as the upper bound of the
+// bucket it falls in. Overflow reports the largest bound.
+func (m *OutboxMetrics) LatencyQuantile(q float64) time.Duration {
+	hist := m.LatencyHistogram()
+	total := hist[len(hist)-1]
+	if total == 0 {
+		return 0
+	}
+	rank := max(int64(math.Ceil(q*float64(total))), 1)
+	for i, bound := range LatencyBuckets {
+		if hist[i] >= rank {
+			return bound
+		}
+	}
+	return LatencyBuckets[len(LatencyBuckets)-1]
+}
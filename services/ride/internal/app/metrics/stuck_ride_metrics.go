@@ -0,0 +1,64 @@
+package metrics
+
+import "sync"
+
+// StuckRideMetrics counts rides the SLA worker found waiting on matching for
+// too long, by the status they were stuck in. A rise in either count points
+// at matching not picking up ride.requested.
+type StuckRideMetrics struct {
+	mu           sync.Mutex
+	redispatched map[string]int64
+	timedOut     map[string]int64
+}
+
+func (m *StuckRideMetrics) IncRedispatched(status string) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.redispatched == nil {
+		m.redispatched = map[string]int64{}
+	}
+	m.redispatched[status]++
+}
+
+func (m *StuckRideMetrics) IncTimedOut(status string) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.timedOut == nil {
+		m.timedOut = map[string]int64{}
+	}
+	m.timedOut[status]++
+}
+
+// Redispatched returns a copy of the redispatch counts by status.
+func (m *StuckRideMetrics) Redispatched() map[string]int64 {
+	if m == nil {
+		return map[string]int64{}
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return copyCounts(m.redispatched)
+}
+
+// TimedOut returns a copy of the SYSTEM_TIMEOUT cancellation counts by status.
+func (m *StuckRideMetrics) TimedOut() map[string]int64 {
+	if m == nil {
+		return map[string]int64{}
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return copyCounts(m.timedOut)
+}
+
+func copyCounts(counts map[string]int64) map[string]int64 {
+	out := make(map[string]int64, len(counts))
+	for status, n := range counts {
+		out[status] = n
+	}
+	return out
+}
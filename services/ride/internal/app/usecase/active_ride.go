@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+// activeRide returns an *domain.ActiveRideError naming the ride userID already
+// holds, or nil when lookup finds none. The unique indexes behind the store
+// have the final say; checking first turns the common case away before a fare
+// is held or an offer is spent, and with the ride id to hand.
+func activeRide(ctx context.Context, lookup func(context.Context, string) (outbound.Ride, error), userID string, sentinel error) error {
+	row, err := lookup(ctx, userID)
+	if errors.Is(err, outbound.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return &domain.ActiveRideError{Err: sentinel, RideID: row.ID}
+}
+
+// resolveActiveRide fills in the ride behind an ActiveRideError the store
+// raised when two requests raced past the check. Its transaction is gone by
+// then, so the ride is looked up afresh; if it has ended since, err is
+// returned as is.
+func (s *RideService) resolveActiveRide(ctx context.Context, err error, riderID string, driverID string) error {
+	var active *domain.ActiveRideError
+	if !errors.As(err, &active) || active.RideID != "" {
+		return err
+	}
+	lookup, userID := s.Repo.GetActiveByRider, riderID
+	if errors.Is(active.Err, domain.ErrDriverHasActiveRide) {
+		lookup, userID = s.Repo.GetActiveByDriver, driverID
+	}
+	if userID == "" {
+		return err
+	}
+	var resolved *domain.ActiveRideError
+	if errors.As(activeRide(ctx, lookup, userID, active.Err), &resolved) {
+		return resolved
+	}
+	return err
+}
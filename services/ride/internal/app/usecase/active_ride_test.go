@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+func TestCreateRideRejectsSecondActiveRide(t *testing.T) {
+	repo := newFakeRideRepo()
+	svc := &RideService{Repo: repo, Outbox: &fakeOutboxRepo{}, OfferMetrics: &OfferMetrics{}}
+	ctx := context.Background()
+
+	first, err := svc.CreateRide(ctx, CreateRideCmd{RiderID: "r1", IdempotencyKey: "k1"})
+	if err != nil {
+		t.Fatalf("create error: %v", err)
+	}
+	_, err = svc.CreateRide(ctx, CreateRideCmd{RiderID: "r1", IdempotencyKey: "k2"})
+	var active *domain.ActiveRideError
+	if !errors.As(err, &active) || !errors.Is(err, domain.ErrRiderHasActiveRide) || active.RideID != first.ID {
+		t.Fatalf("expected the active ride %s back, got %v", first.ID, err)
+	}
+	if len(repo.store) != 1 {
+		t.Fatalf("expected no second ride, got %d", len(repo.store))
+	}
+	if _, err := svc.CreateRide(ctx, CreateRideCmd{RiderID: "r2"}); err != nil {
+		t.Fatalf("expected another rider unaffected, got %v", err)
+	}
+
+	if _, err := svc.CancelRide(ctx, CancelRideCmd{RideID: first.ID, Reason: "test"}); err != nil {
+		t.Fatalf("cancel error: %v", err)
+	}
+	if _, err := svc.CreateRide(ctx, CreateRideCmd{RiderID: "r1", IdempotencyKey: "k3"}); err != nil {
+		t.Fatalf("expected a new ride once the first ended, got %v", err)
+	}
+}
+
+func TestAcceptOfferRejectsBusyDriver(t *testing.T) {
+	svc, repo, _, offer := newOfferedRide(t, 10*time.Second)
+	driverID := "driver-1"
+	repo.store["ride-0"] = outbound.Ride{ID: "ride-0", RiderID: "r0", DriverID: &driverID, Status: string(domain.StatusInProgress)}
+
+	_, err := svc.AcceptOffer(context.Background(), OfferActionCmd{OfferID: offer.ID})
+	var active *domain.ActiveRideError
+	if !errors.As(err, &active) || !errors.Is(err, domain.ErrDriverHasActiveRide) || active.RideID != "ride-0" {
+		t.Fatalf("expected the driver's active ride back, got %v", err)
+	}
+	if got := repo.store["ride-1"]; got.Status != string(domain.StatusOffered) || got.DriverID != nil {
+		t.Fatalf("expected ride still offered, got %s / %v", got.Status, got.DriverID)
+	}
+}
+
+func TestDispatchScheduledRideWaitsForActiveRide(t *testing.T) {
+	repo := newFakeRideRepo()
+	clock := &fixedClock{now: time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)}
+	svc := &RideService{Repo: repo, Outbox: &fakeOutboxRepo{}, Clock: clock, Scheduling: domain.DefaultSchedulePolicy()}
+	ctx := context.Background()
+
+	scheduled, err := svc.CreateRide(ctx, CreateRideCmd{RiderID: "r1", PickupAt: clock.now.Add(2 * time.Hour)})
+	if err != nil {
+		t.Fatalf("create scheduled error: %v", err)
+	}
+	now, err := svc.CreateRide(ctx, CreateRideCmd{RiderID: "r1"})
+	if err != nil {
+		t.Fatalf("expected an immediate ride alongside a scheduled one, got %v", err)
+	}
+
+	clock.now = clock.now.Add(2 * time.Hour)
+	if run, err := svc.DispatchScheduledRides(ctx, 10); err != nil || run.Dispatched != 0 {
+		t.Fatalf("expected dispatch held while the rider is on a ride, got %d (%v)", run.Dispatched, err)
+	}
+	if _, err := svc.CancelRide(ctx, CancelRideCmd{RideID: now.ID, Reason: "test"}); err != nil {
+		t.Fatalf("cancel error: %v", err)
+	}
+	if run, err := svc.DispatchScheduledRides(ctx, 10); err != nil || run.Dispatched != 1 {
+		t.Fatalf("expected dispatch once the ride ended, got %d (%v)", run.Dispatched, err)
+	}
+	if got := repo.store[scheduled.ID].Status; got != string(domain.StatusMatching) {
+		t.Fatalf("expected scheduled ride matching, got %s", got)
+	}
+}
+
+func TestScheduledRideTimesOutWhileRiderBusy(t *testing.T) {
+	repo := newFakeRideRepo()
+	clock := &fixedClock{now: time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)}
+	outbox := &fakeOutboxRepo{}
+	svc := &RideService{Repo: repo, Outbox: outbox, Clock: clock, Scheduling: domain.DefaultSchedulePolicy()}
+	ctx := context.Background()
+
+	scheduled, err := svc.CreateRide(ctx, CreateRideCmd{RiderID: "r1", PickupAt: clock.now.Add(2 * time.Hour)})
+	if err != nil {
+		t.Fatalf("create scheduled error: %v", err)
+	}
+	if _, err := svc.CreateRide(ctx, CreateRideCmd{RiderID: "r1"}); err != nil {
+		t.Fatalf("create error: %v", err)
+	}
+
+	clock.now = svc.Scheduling.TimeoutAt(clock.now.Add(2 * time.Hour))
+	if run, err := svc.DispatchScheduledRides(ctx, 10); err != nil || run.Dispatched != 0 {
+		t.Fatalf("expected nothing dispatched, got %d (%v)", run.Dispatched, err)
+	}
+	stored := repo.store[scheduled.ID]
+	if stored.Status != string(domain.StatusCancelled) || stored.CancelReason == nil || *stored.CancelReason != string(domain.CancelSystemTimeout) {
+		t.Fatalf("expected the overdue scheduled ride timed out, got %+v", stored)
+	}
+	msg, ok := lastMessage(outbox, eventsv1.TopicRideCancelled)
+	if !ok || !strings.Contains(msg.Payload, `"reason":"scheduled_timeout"`) {
+		t.Fatalf("unexpected ride.cancelled: %s", msg.Payload)
+	}
+}
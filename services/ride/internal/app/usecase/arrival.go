@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+const (
+	arrivalGeofence = "geofence"
+	arrivalManual   = "manual"
+	reasonNoShow    = "rider_no_show"
+)
+
+// MarkDriverArrived lets the assigned driver say they are at the pickup when
+// the geofence has not caught it, e.g. on a poor GPS fix.
+func (s *RideService) MarkDriverArrived(ctx context.Context, cmd DriverRideCmd) (domain.Ride, error) {
+	return s.withIdempotency(ctx, idempotencyScope{Key: cmd.IdempotencyKey, Operation: "ride.driver_arrived", Caller: cmd.DriverID, Request: cmd.RideID}, func(repo outbound.RideRepo, outbox outbound.OutboxRepo) (domain.Ride, error) {
+		ride, err := s.loadRide(ctx, cmd.RideID, repo)
+		if err != nil {
+			return domain.Ride{}, err
+		}
+		if !ride.IsAssignedTo(cmd.DriverID) {
+			return domain.Ride{}, domain.ErrDriverMismatch
+		}
+		if ride.Status == domain.StatusDriverArrived {
+			return ride, nil
+		}
+		if err := checkVersion(ride, cmd.ExpectedVersion); err != nil {
+			return domain.Ride{}, err
+		}
+		return s.markArrived(ctx, repo, outbox, ride, statusChange{Actor: domain.ActorDriver, ActorID: cmd.DriverID, Reason: arrivalManual})
+	})
+}
+
+// HandleDriverLocation consumes driver.location.updated and marks the driver
+// arrived once a fix puts them inside the pickup radius of the ride they are
+// assigned to. Fixes from drivers without an assigned ride are dropped. Most
+// fixes land outside the radius, so the ride is read and checked outside any
+// transaction; only a fix inside it opens one, and the version check on the
+// write catches a ride that moved on in between.
+func (s *RideService) HandleDriverLocation(ctx context.Context, payload []byte) error {
+	var event eventsv1.DriverLocationUpdated
+	if _, err := eventsv1.Unmarshal(payload, &event); err != nil {
+		return err
+	}
+	driverID := event.GetDriverId()
+	if driverID == "" {
+		return nil
+	}
+	rows, err := s.Repo.List(ctx, outbound.RideFilter{DriverID: driverID, Status: string(domain.StatusDriverAssigned), Limit: 1})
+	if err != nil || len(rows) == 0 {
+		return err
+	}
+	ride := toDomainRide(rows[0])
+	if !s.Arrival.WithinRadius(ride, event.GetLat(), event.GetLng()) {
+		return nil
+	}
+	err = s.withTx(ctx, func(repo outbound.RideRepo, outbox outbound.OutboxRepo) error {
+		_, err := s.markArrived(ctx, repo, outbox, ride, statusChange{Actor: domain.ActorSystem, Reason: arrivalGeofence})
+		return err
+	})
+	// The ride moved on between the read and the write, most likely on the
+	// driver's own manual arrival; the next fix sees where it is now.
+	if errors.Is(err, outbound.ErrConflict) {
+		return nil
+	}
+	return err
+}
+
+// markArrived moves an assigned ride to DRIVER_ARRIVED and starts the rider's
+// wait; the event tells the rider when a no-show may be charged.
+func (s *RideService) markArrived(ctx context.Context, repo outbound.RideRepo, outbox outbound.OutboxRepo, ride domain.Ride, change statusChange) (domain.Ride, error) {
+	updated, err := ride.Transition(domain.StatusDriverArrived)
+	if err != nil {
+		return domain.Ride{}, err
+	}
+	now := s.now()
+	if err := repo.ArriveIfCurrent(ctx, ride.ID, ride.Version, string(ride.Status), now); err != nil {
+		return domain.Ride{}, err
+	}
+	if err := s.appendEvent(ctx, repo, ride.ID, ride.Status, updated.Status, change, now); err != nil {
+		return domain.Ride{}, err
+	}
+	updated.ArrivedAt = now
+	updated.UpdatedAt = now
+	if err := s.enqueueEvent(ctx, outbox, eventsv1.TopicRideDriverArrived, &eventsv1.RideDriverArrived{
+		RideId:    updated.ID,
+		RiderId:   updated.RiderID,
+		DriverId:  derefString(updated.DriverID),
+		Status:    string(updated.Status),
+		ArrivedAt: now.Unix(),
+		NoShowAt:  s.Arrival.NoShowAt(updated).Unix(),
+		Source:    change.Reason,
+	}); err != nil {
+		return domain.Ride{}, err
+	}
+	return updated, nil
+}
+
+// cancelNoShow ends a ride whose rider never came out once the driver has
+// waited the grace period at the pickup. The rider is charged the no-show fee
+// from the payment hold; the driver takes no strike.
+func (s *RideService) cancelNoShow(ctx context.Context, repo outbound.RideRepo, outbox outbound.OutboxRepo, ride domain.Ride, cmd DriverCancelRideCmd, reason string) (domain.Ride, error) {
+	now := s.now()
+	if err := s.Arrival.CheckNoShow(ride, now); err != nil {
+		return domain.Ride{}, err
+	}
+	updated, err := ride.Transition(domain.StatusCancelled)
+	if err != nil {
+		return domain.Ride{}, err
+	}
+	fee := s.Arrival.NoShowFee
+	updated.CancelReason = domain.CancelRiderNoShow
+	updated.CancellationFee = fee
+	updated.UpdatedAt = now
+
+	if err := repo.CancelIfCurrent(ctx, ride.ID, ride.Version, string(ride.Status), outbound.RideCancellation{Reason: string(domain.CancelRiderNoShow), Fee: fee}, now); err != nil {
+		return domain.Ride{}, err
+	}
+	if err := s.appendEvent(ctx, repo, ride.ID, ride.Status, updated.Status, statusChange{Actor: domain.ActorDriver, ActorID: cmd.DriverID, Reason: reason}, now); err != nil {
+		return domain.Ride{}, err
+	}
+	if err := s.requestCapture(ctx, repo, ride.ID, fee); err != nil {
+		return domain.Ride{}, err
+	}
+	if err := s.enqueueEvent(ctx, outbox, eventsv1.TopicRideCancelled, &eventsv1.RideCancelled{
+		RideId:     updated.ID,
+		Reason:     reason,
+		ReasonCode: string(domain.CancelRiderNoShow),
+		Actor:      string(domain.ActorDriver),
+		Status:     string(updated.Status),
+		RiderId:    updated.RiderID,
+		FeeAmount:  fee,
+		Currency:   updated.Currency,
+	}); err != nil {
+		return domain.Ride{}, err
+	}
+	return updated, nil
+}
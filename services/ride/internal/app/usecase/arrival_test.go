@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+)
+
+// acceptedRide assigns driver-1 to ride-1, whose pickup is at 0,0.
+func acceptedRide(t *testing.T) (*RideService, *fakeRideRepo, *fixedClock) {
+	t.Helper()
+	svc, repo, clock, offer := newOfferedRide(t, 10*time.Second)
+	svc.Arrival = domain.DefaultArrivalPolicy()
+	if _, err := svc.AcceptOffer(context.Background(), OfferActionCmd{OfferID: offer.ID}); err != nil {
+		t.Fatalf("accept error: %v", err)
+	}
+	return svc, repo, clock
+}
+
+func locationPayload(t *testing.T, driverID string, lat float64, lng float64) []byte {
+	t.Helper()
+	payload, err := eventsv1.Marshal(eventsv1.TopicDriverLocationUpdated, "location-service", "", "", &eventsv1.DriverLocationUpdated{DriverId: driverID, Lat: lat, Lng: lng}, time.Unix(0, 0), "loc-1")
+	if err != nil {
+		t.Fatalf("marshal location: %v", err)
+	}
+	return payload
+}
+
+func TestDriverArrivesOnGeofence(t *testing.T) {
+	svc, repo, clock := acceptedRide(t)
+	outbox := svc.Outbox.(*fakeOutboxRepo)
+	ctx := context.Background()
+
+	if err := svc.HandleDriverLocation(ctx, locationPayload(t, "driver-1", 0.01, 0)); err != nil {
+		t.Fatalf("far fix error: %v", err)
+	}
+	if err := svc.HandleDriverLocation(ctx, locationPayload(t, "driver-2", 0, 0)); err != nil {
+		t.Fatalf("other driver fix error: %v", err)
+	}
+	if got := repo.store["ride-1"].Status; got != string(domain.StatusDriverAssigned) {
+		t.Fatalf("expected driver still on the way, got %s", got)
+	}
+
+	if err := svc.HandleDriverLocation(ctx, locationPayload(t, "driver-1", 0.0005, 0)); err != nil {
+		t.Fatalf("near fix error: %v", err)
+	}
+	stored := repo.store["ride-1"]
+	if stored.Status != string(domain.StatusDriverArrived) || stored.ArrivedAt == nil || !stored.ArrivedAt.Equal(clock.now) {
+		t.Fatalf("expected driver arrived at %s, got %s / %v", clock.now, stored.Status, stored.ArrivedAt)
+	}
+	msg, ok := lastMessage(outbox, eventsv1.TopicRideDriverArrived)
+	if !ok {
+		t.Fatalf("expected ride.driver.arrived")
+	}
+	if !strings.Contains(msg.Payload, `"source":"geofence"`) || !strings.Contains(msg.Payload, `"no_show_at":1767269100`) {
+		t.Fatalf("unexpected ride.driver.arrived payload: %s", msg.Payload)
+	}
+	events, _ := svc.GetRideTimeline(ctx, "ride-1")
+	if got := events[len(events)-1]; got.Actor != domain.ActorSystem || got.Reason != arrivalGeofence || got.ToStatus != domain.StatusDriverArrived {
+		t.Fatalf("expected geofence arrival on the timeline, got %+v", got)
+	}
+
+	// Later fixes inside the radius must not restart the rider's wait.
+	clock.now = clock.now.Add(time.Minute)
+	if err := svc.HandleDriverLocation(ctx, locationPayload(t, "driver-1", 0, 0)); err != nil {
+		t.Fatalf("repeat fix error: %v", err)
+	}
+	if got := repo.store["ride-1"].ArrivedAt; !got.Equal(stored.ArrivedAt.UTC()) {
+		t.Fatalf("expected arrival time kept, got %v", got)
+	}
+}
+
+func TestDriverLocationOutsideGeofenceSkipsTransaction(t *testing.T) {
+	svc, repo, _ := acceptedRide(t)
+	svc.TxManager = failingCommitTx{svc: svc}
+	ctx := context.Background()
+
+	// Any transaction would fail on commit, so only fixes inside the radius
+	// may open one.
+	if err := svc.HandleDriverLocation(ctx, locationPayload(t, "driver-1", 0.01, 0)); err != nil {
+		t.Fatalf("expected a far fix handled without a transaction, got %v", err)
+	}
+	if err := svc.HandleDriverLocation(ctx, locationPayload(t, "driver-2", 0, 0)); err != nil {
+		t.Fatalf("expected an unassigned driver's fix handled without a transaction, got %v", err)
+	}
+	if got := repo.store["ride-1"].Status; got != string(domain.StatusDriverAssigned) {
+		t.Fatalf("expected driver still on the way, got %s", got)
+	}
+	if err := svc.HandleDriverLocation(ctx, locationPayload(t, "driver-1", 0, 0)); err == nil {
+		t.Fatalf("expected the near fix to write in a transaction")
+	}
+}
+
+func TestMarkDriverArrivedManually(t *testing.T) {
+	svc, repo, _ := acceptedRide(t)
+	ctx := context.Background()
+
+	if _, err := svc.MarkDriverArrived(ctx, DriverRideCmd{RideID: "ride-1", DriverID: "driver-2"}); !errors.Is(err, domain.ErrDriverMismatch) {
+		t.Fatalf("expected driver mismatch, got %v", err)
+	}
+	ride, err := svc.MarkDriverArrived(ctx, DriverRideCmd{RideID: "ride-1", DriverID: "driver-1"})
+	if err != nil {
+		t.Fatalf("mark arrived error: %v", err)
+	}
+	if ride.Status != domain.StatusDriverArrived || ride.ArrivedAt.IsZero() {
+		t.Fatalf("expected arrived ride, got %+v", ride)
+	}
+	again, err := svc.MarkDriverArrived(ctx, DriverRideCmd{RideID: "ride-1", DriverID: "driver-1"})
+	if err != nil || again.Version != ride.Version {
+		t.Fatalf("expected repeat to be a no-op, got %+v / %v", again, err)
+	}
+
+	started, err := svc.StartRide(ctx, DriverRideCmd{RideID: "ride-1", DriverID: "driver-1"})
+	if err != nil {
+		t.Fatalf("start ride error: %v", err)
+	}
+	if started.Status != domain.StatusInProgress || repo.store["ride-1"].Status != string(domain.StatusInProgress) {
+		t.Fatalf("expected ride in progress, got %s", started.Status)
+	}
+	if _, err := svc.MarkDriverArrived(ctx, DriverRideCmd{RideID: "ride-1", DriverID: "driver-1"}); !errors.Is(err, domain.ErrInvalidTransition) {
+		t.Fatalf("expected arrival after start to fail, got %v", err)
+	}
+}
+
+func TestRiderNoShowChargesFee(t *testing.T) {
+	svc, repo, _ := newPaymentService(t)
+	svc.Arrival = domain.DefaultArrivalPolicy()
+	clock := svc.Clock.(*fixedClock)
+	outbox := svc.Outbox.(*fakeOutboxRepo)
+	ctx := context.Background()
+	ride := createPaidRide(t, svc)
+
+	stored := repo.store[ride.ID]
+	driverID := "driver-1"
+	stored.DriverID = &driverID
+	stored.Status = string(domain.StatusDriverAssigned)
+	repo.store[ride.ID] = stored
+	noShow := DriverCancelRideCmd{RideID: ride.ID, DriverID: driverID, ReasonCode: domain.CancelRiderNoShow}
+
+	if _, err := svc.DriverCancelRide(ctx, noShow); !errors.Is(err, domain.ErrInvalidTransition) {
+		t.Fatalf("expected no-show before arrival to fail, got %v", err)
+	}
+	if _, err := svc.MarkDriverArrived(ctx, DriverRideCmd{RideID: ride.ID, DriverID: driverID}); err != nil {
+		t.Fatalf("mark arrived error: %v", err)
+	}
+	clock.now = clock.now.Add(svc.Arrival.GracePeriod - time.Second)
+	if _, err := svc.DriverCancelRide(ctx, noShow); !errors.Is(err, domain.ErrNoShowTooEarly) {
+		t.Fatalf("expected no-show inside the grace period to fail, got %v", err)
+	}
+
+	clock.now = clock.now.Add(time.Second)
+	cancelled, err := svc.DriverCancelRide(ctx, noShow)
+	if err != nil {
+		t.Fatalf("no-show error: %v", err)
+	}
+	fee := svc.Arrival.NoShowFee
+	if cancelled.Status != domain.StatusCancelled || cancelled.CancelReason != domain.CancelRiderNoShow || cancelled.CancellationFee != fee {
+		t.Fatalf("expected no-show cancellation with fee, got %+v", cancelled)
+	}
+	if got := repo.payments[ride.ID]; got.Status != string(domain.PaymentCapturePending) || got.CaptureAmount != fee {
+		t.Fatalf("expected no-show fee captured from the hold, got %+v", got)
+	}
+	if len(repo.strikes) != 0 {
+		t.Fatalf("expected no strike for a no-show, got %+v", repo.strikes)
+	}
+	msg, ok := lastMessage(outbox, eventsv1.TopicRideCancelled)
+	if !ok || !strings.Contains(msg.Payload, `"reason_code":"RIDER_NO_SHOW"`) || !strings.Contains(msg.Payload, `"reason":"rider_no_show"`) {
+		t.Fatalf("unexpected ride.cancelled: %s", msg.Payload)
+	}
+}
+
+func TestDriverCancelAfterArrivalReleasesRide(t *testing.T) {
+	svc, repo, _ := acceptedRide(t)
+	ctx := context.Background()
+	if _, err := svc.MarkDriverArrived(ctx, DriverRideCmd{RideID: "ride-1", DriverID: "driver-1"}); err != nil {
+		t.Fatalf("mark arrived error: %v", err)
+	}
+	released, err := svc.DriverCancelRide(ctx, DriverCancelRideCmd{RideID: "ride-1", DriverID: "driver-1", ReasonCode: domain.CancelDriverVehicleIssue})
+	if err != nil {
+		t.Fatalf("driver cancel error: %v", err)
+	}
+	if released.Status != domain.StatusMatching || repo.store["ride-1"].ArrivedAt != nil {
+		t.Fatalf("expected ride back in matching without an arrival, got %s / %v", released.Status, repo.store["ride-1"].ArrivedAt)
+	}
+}
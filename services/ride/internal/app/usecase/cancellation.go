@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+// CancellationFeeError is returned when a cancellation would be charged and the
+// caller has not confirmed the fee yet. Token confirms exactly this quote when
+// passed back on the retry.
+type CancellationFeeError struct {
+	Quote domain.CancellationQuote
+	Token string
+}
+
+func (e *CancellationFeeError) Error() string {
+	return domain.ErrCancellationFeeRequired.Error()
+}
+
+func (e *CancellationFeeError) Unwrap() error {
+	return domain.ErrCancellationFeeRequired
+}
+
+// confirmCancellationFee accepts a confirm token that still covers fee, and
+// otherwise quotes the fee with a fresh token.
+func (s *RideService) confirmCancellationFee(ride domain.Ride, fee int64, token string, now time.Time) error {
+	if token != "" {
+		if quote, err := s.CancelSigner.VerifyCancellation(token); err == nil && quote.Confirms(ride, fee, now) {
+			return nil
+		}
+	}
+	quote := domain.CancellationQuote{
+		RideID:    ride.ID,
+		RiderID:   ride.RiderID,
+		Fee:       fee,
+		Currency:  ride.Currency,
+		ExpiresAt: now.Add(s.Cancellation.ConfirmTTL),
+	}
+	signed, err := s.CancelSigner.SignCancellation(quote)
+	if err != nil {
+		return err
+	}
+	return &CancellationFeeError{Quote: quote, Token: signed}
+}
+
+// recordStrike counts a cancellation against the user's account and tells them
+// how many strikes they now have inside the policy window.
+func (s *RideService) recordStrike(ctx context.Context, repo outbound.RideRepo, outbox outbound.OutboxRepo, ride domain.Ride, role domain.Actor, userID string, reason domain.CancelReason, now time.Time) error {
+	if userID == "" {
+		return nil
+	}
+	if err := repo.AddStrike(ctx, outbound.CancellationStrike{
+		ID:        s.newID(),
+		UserID:    userID,
+		Role:      string(role),
+		RideID:    ride.ID,
+		Reason:    string(reason),
+		CreatedAt: now,
+	}); err != nil {
+		return err
+	}
+	strikes, err := repo.CountStrikes(ctx, userID, now.Add(-s.Cancellation.StrikeWindow))
+	if err != nil {
+		return err
+	}
+	event := &eventsv1.CancellationStrike{
+		RideId:        ride.ID,
+		UserId:        userID,
+		Role:          string(role),
+		ReasonCode:    string(reason),
+		Strikes:       int64(strikes),
+		WindowSeconds: int64(s.Cancellation.StrikeWindow.Seconds()),
+	}
+	// Notify routes on rider_id/driver_id, so the user is named under their role too.
+	if role == domain.ActorDriver {
+		event.DriverId = &userID
+	} else {
+		event.RiderId = &userID
+	}
+	return s.enqueueEvent(ctx, outbox, eventsv1.TopicCancellationStrike, event)
+}
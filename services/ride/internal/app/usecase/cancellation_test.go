@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+)
+
+func TestLateRiderCancelRequiresConfirmedFee(t *testing.T) {
+	svc, repo, clock, offer := newOfferedRide(t, 10*time.Second)
+	svc.Cancellation = domain.DefaultCancellationPolicy()
+	svc.CancelSigner = QuoteSigner{Secret: []byte("secret")}
+	outbox := svc.Outbox.(*fakeOutboxRepo)
+	ctx := context.Background()
+	if _, err := svc.AcceptOffer(ctx, OfferActionCmd{OfferID: offer.ID}); err != nil {
+		t.Fatalf("accept error: %v", err)
+	}
+	clock.now = clock.now.Add(svc.Cancellation.FreeWindow + time.Minute)
+	cmd := CancelRideCmd{RideID: "ride-1", ReasonCode: domain.CancelRiderChangedMind, Actor: domain.ActorRider, ActorID: "r1"}
+
+	if _, err := svc.CancelRide(ctx, CancelRideCmd{RideID: "ride-1", Actor: domain.ActorRider, ActorID: "r2"}); !errors.Is(err, domain.ErrRiderMismatch) {
+		t.Fatalf("expected rider mismatch, got %v", err)
+	}
+	_, err := svc.CancelRide(ctx, cmd)
+	var feeErr *CancellationFeeError
+	if !errors.As(err, &feeErr) || !errors.Is(err, domain.ErrCancellationFeeRequired) {
+		t.Fatalf("expected fee required, got %v", err)
+	}
+	if feeErr.Quote.Fee != svc.Cancellation.RiderFee || feeErr.Token == "" {
+		t.Fatalf("unexpected fee quote: %+v", feeErr)
+	}
+	if repo.store["ride-1"].Status != string(domain.StatusDriverAssigned) {
+		t.Fatalf("expected ride untouched until the fee is confirmed")
+	}
+
+	cmd.ConfirmToken = "forged." + feeErr.Token
+	if _, err := svc.CancelRide(ctx, cmd); !errors.Is(err, domain.ErrCancellationFeeRequired) {
+		t.Fatalf("expected forged token to be rejected, got %v", err)
+	}
+
+	cmd.ConfirmToken = feeErr.Token
+	ride, err := svc.CancelRide(ctx, cmd)
+	if err != nil {
+		t.Fatalf("confirmed cancel error: %v", err)
+	}
+	if ride.Status != domain.StatusCancelled || ride.CancellationFee != svc.Cancellation.RiderFee || ride.CancelReason != domain.CancelRiderChangedMind {
+		t.Fatalf("unexpected cancelled ride: %+v", ride)
+	}
+	stored := repo.store["ride-1"]
+	if stored.CancellationFee != svc.Cancellation.RiderFee || derefString(stored.CancelReason) != string(domain.CancelRiderChangedMind) {
+		t.Fatalf("expected fee and reason stored, got %+v", stored)
+	}
+	if len(repo.strikes) != 1 || repo.strikes[0].UserID != "r1" || repo.strikes[0].Role != string(domain.ActorRider) {
+		t.Fatalf("expected one rider strike, got %+v", repo.strikes)
+	}
+
+	n := len(outbox.messages)
+	if topics := outbox.messages[n-2].Topic + " " + outbox.messages[n-1].Topic; topics != "ride.cancellation.strike ride.cancelled" {
+		t.Fatalf("expected strike and cancelled events, got %v", topics)
+	}
+	var envelope struct {
+		Data struct {
+			ReasonCode string `json:"reason_code"`
+			FeeAmount  int64  `json:"fee_amount"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(outbox.messages[n-1].Payload), &envelope); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	if envelope.Data.ReasonCode != string(domain.CancelRiderChangedMind) || envelope.Data.FeeAmount != svc.Cancellation.RiderFee {
+		t.Fatalf("unexpected ride.cancelled payload: %+v", envelope.Data)
+	}
+}
+
+func TestEarlyCancelIsFree(t *testing.T) {
+	svc, repo, _, offer := newOfferedRide(t, 10*time.Second)
+	svc.Cancellation = domain.DefaultCancellationPolicy()
+	ctx := context.Background()
+	if _, err := svc.AcceptOffer(ctx, OfferActionCmd{OfferID: offer.ID}); err != nil {
+		t.Fatalf("accept error: %v", err)
+	}
+
+	ride, err := svc.CancelRide(ctx, CancelRideCmd{RideID: "ride-1", Actor: domain.ActorRider, ActorID: "r1"})
+	if err != nil {
+		t.Fatalf("cancel error: %v", err)
+	}
+	if ride.CancellationFee != 0 || ride.CancelReason != domain.CancelOther || len(repo.strikes) != 0 {
+		t.Fatalf("expected free cancellation without strike, got %+v / %v", ride, repo.strikes)
+	}
+}
+
+func TestSignedTokensDoNotCrossPurposes(t *testing.T) {
+	signer := QuoteSigner{Secret: []byte("secret")}
+	cancel, err := signer.SignCancellation(domain.CancellationQuote{RideID: "ride-1", Fee: 5000})
+	if err != nil {
+		t.Fatalf("sign cancellation error: %v", err)
+	}
+	if _, err := signer.Verify(cancel); !errors.Is(err, domain.ErrInvalidQuote) {
+		t.Fatalf("expected a confirm token rejected as a fare quote, got %v", err)
+	}
+	quote, err := signer.Sign(domain.Quote{ID: "quote-1"})
+	if err != nil {
+		t.Fatalf("sign quote error: %v", err)
+	}
+	if _, err := signer.VerifyCancellation(quote); !errors.Is(err, domain.ErrCancellationFeeRequired) {
+		t.Fatalf("expected a fare quote rejected as a confirm token, got %v", err)
+	}
+	if _, err := signer.VerifyCancellation(cancel); err != nil {
+		t.Fatalf("expected the confirm token to verify, got %v", err)
+	}
+}
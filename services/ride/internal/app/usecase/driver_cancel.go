@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+const reasonDriverCancelled = "driver_cancelled"
+
+type DriverCancelRideCmd struct {
+	RideID          string
+	DriverID        string
+	Reason          string
+	ReasonCode      domain.CancelReason
+	IdempotencyKey  string
+	ExpectedVersion int64
+}
+
+// DriverCancelRide lets the assigned driver back out before pickup. The rider
+// keeps the booking: the ride returns to matching and the driver is excluded
+// from being offered it again. The driver takes a cancellation strike.
+// Cancelling with RIDER_NO_SHOW instead ends the ride; see cancelNoShow.
+func (s *RideService) DriverCancelRide(ctx context.Context, cmd DriverCancelRideCmd) (domain.Ride, error) {
+	code := cmd.ReasonCode
+	if code == "" {
+		code = domain.CancelOther
+	}
+	reason := cmd.Reason
+	if reason == "" {
+		reason = reasonDriverCancelled
+		if code == domain.CancelRiderNoShow {
+			reason = reasonNoShow
+		}
+	}
+	return s.withIdempotency(ctx, idempotencyScope{Key: cmd.IdempotencyKey, Operation: "ride.driver_cancel", Caller: cmd.DriverID, Request: cmd}, func(repo outbound.RideRepo, outbox outbound.OutboxRepo) (domain.Ride, error) {
+		ride, err := s.loadRide(ctx, cmd.RideID, repo)
+		if err != nil {
+			return domain.Ride{}, err
+		}
+		if !ride.IsAssignedTo(cmd.DriverID) {
+			return domain.Ride{}, domain.ErrDriverMismatch
+		}
+		if err := checkVersion(ride, cmd.ExpectedVersion); err != nil {
+			return domain.Ride{}, err
+		}
+		if code == domain.CancelRiderNoShow {
+			return s.cancelNoShow(ctx, repo, outbox, ride, cmd, reason)
+		}
+		released, err := ride.ReleaseDriver()
+		if err != nil {
+			return domain.Ride{}, err
+		}
+
+		now := s.now()
+		if err := repo.ReleaseDriverIfCurrent(ctx, ride.ID, ride.Version, cmd.DriverID, string(ride.Status), string(released.Status), now); err != nil {
+			return domain.Ride{}, err
+		}
+		if err := s.appendEvent(ctx, repo, ride.ID, ride.Status, released.Status, statusChange{Actor: domain.ActorDriver, ActorID: cmd.DriverID, Reason: reason}, now); err != nil {
+			return domain.Ride{}, err
+		}
+		if err := repo.ExcludeDriver(ctx, outbound.DriverExclusion{RideID: ride.ID, DriverID: cmd.DriverID, Reason: reason, CreatedAt: now}); err != nil {
+			return domain.Ride{}, err
+		}
+		excluded, err := repo.ListExcludedDrivers(ctx, ride.ID)
+		if err != nil {
+			return domain.Ride{}, err
+		}
+		if s.Cancellation.Decide(ride, domain.ActorDriver, now).Strike {
+			if err := s.recordStrike(ctx, repo, outbox, ride, domain.ActorDriver, cmd.DriverID, code, now); err != nil {
+				return domain.Ride{}, err
+			}
+		}
+
+		// Matching re-dispatches from this event, so it carries the pickup
+		// and every driver excluded so far.
+		if err := s.enqueueEvent(ctx, outbox, eventsv1.TopicRideDriverCancelled, &eventsv1.RideDriverCancelled{
+			RideId:            released.ID,
+			RiderId:           released.RiderID,
+			DriverId:          cmd.DriverID,
+			Reason:            reason,
+			ReasonCode:        string(code),
+			Status:            string(released.Status),
+			PickupLat:         released.PickupLat,
+			PickupLng:         released.PickupLng,
+			Product:           released.Product,
+			ExcludedDriverIds: excluded,
+		}); err != nil {
+			return domain.Ride{}, err
+		}
+		released.UpdatedAt = now
+		return released, nil
+	})
+}
+
+// ensureNotExcluded rejects offers to drivers who already cancelled the ride.
+func ensureNotExcluded(ctx context.Context, repo outbound.RideRepo, rideID string, driverID string) error {
+	excluded, err := repo.ListExcludedDrivers(ctx, rideID)
+	if err != nil {
+		return err
+	}
+	for _, id := range excluded {
+		if id == driverID {
+			return domain.ErrDriverExcluded
+		}
+	}
+	return nil
+}
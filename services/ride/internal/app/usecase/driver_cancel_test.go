@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+)
+
+func TestDriverCancelReturnsRideToMatching(t *testing.T) {
+	svc, repo, _, offer := newOfferedRide(t, 10*time.Second)
+	outbox := svc.Outbox.(*fakeOutboxRepo)
+	ctx := context.Background()
+	if _, err := svc.AcceptOffer(ctx, OfferActionCmd{OfferID: offer.ID}); err != nil {
+		t.Fatalf("accept error: %v", err)
+	}
+
+	if _, err := svc.DriverCancelRide(ctx, DriverCancelRideCmd{RideID: "ride-1", DriverID: "driver-2"}); !errors.Is(err, domain.ErrDriverMismatch) {
+		t.Fatalf("expected driver mismatch, got %v", err)
+	}
+	ride, err := svc.DriverCancelRide(ctx, DriverCancelRideCmd{RideID: "ride-1", DriverID: "driver-1", Reason: "vehicle_issue"})
+	if err != nil {
+		t.Fatalf("driver cancel error: %v", err)
+	}
+	if ride.Status != domain.StatusMatching || ride.DriverID != nil {
+		t.Fatalf("expected unassigned matching ride, got %s / %v", ride.Status, ride.DriverID)
+	}
+	stored := repo.store["ride-1"]
+	if stored.Status != string(domain.StatusMatching) || stored.DriverID != nil {
+		t.Fatalf("expected stored ride back in matching, got %s / %v", stored.Status, stored.DriverID)
+	}
+
+	last := outbox.messages[len(outbox.messages)-1]
+	if last.Topic != "ride.driver.cancelled" {
+		t.Fatalf("expected ride.driver.cancelled, got %s", last.Topic)
+	}
+	var envelope struct {
+		Data struct {
+			RiderID  string   `json:"rider_id"`
+			Reason   string   `json:"reason"`
+			Excluded []string `json:"excluded_driver_ids"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(last.Payload), &envelope); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	if envelope.Data.RiderID != "r1" || envelope.Data.Reason != "vehicle_issue" || len(envelope.Data.Excluded) != 1 || envelope.Data.Excluded[0] != "driver-1" {
+		t.Fatalf("unexpected payload: %s", last.Payload)
+	}
+
+	if len(repo.strikes) != 1 || repo.strikes[0].UserID != "driver-1" || repo.strikes[0].Role != string(domain.ActorDriver) {
+		t.Fatalf("expected one driver strike, got %+v", repo.strikes)
+	}
+
+	events, _ := svc.GetRideTimeline(ctx, "ride-1")
+	if got := events[len(events)-1]; got.FromStatus != domain.StatusDriverAssigned || got.Actor != domain.ActorDriver || got.ActorID != "driver-1" {
+		t.Fatalf("unexpected timeline event: %+v", got)
+	}
+
+	// The driver who bailed is never offered the ride again; others are.
+	if _, err := svc.CreateOffer(ctx, StartMatchingCmd{RideID: "ride-1", DriverID: "driver-1"}); !errors.Is(err, domain.ErrDriverExcluded) {
+		t.Fatalf("expected excluded driver, got %v", err)
+	}
+	if _, err := svc.CreateOffer(ctx, StartMatchingCmd{RideID: "ride-1", DriverID: "driver-2"}); err != nil {
+		t.Fatalf("expected offer to another driver, got %v", err)
+	}
+	if _, err := svc.DriverCancelRide(ctx, DriverCancelRideCmd{RideID: "ride-1", DriverID: "driver-1"}); !errors.Is(err, domain.ErrDriverMismatch) {
+		t.Fatalf("expected released driver to lose the ride, got %v", err)
+	}
+}
@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+var (
+	ErrIdempotencyKeyReused = errors.New("idempotency key reused")
+	ErrRequestInProgress    = errors.New("request in progress")
+)
+
+const (
+	defaultIdempotencyLease = 30 * time.Second
+	idempotencyPollInterval = 50 * time.Millisecond
+)
+
+// IdempotencyPolicy bounds how long a reservation holds a key before another
+// request may take it over, and how long a duplicate waits for the first
+// request to finish before it is turned away.
+type IdempotencyPolicy struct {
+	Lease time.Duration
+	Wait  time.Duration
+}
+
+// idempotencyScope ties a key to the operation and caller that used it, and
+// carries the request so a reuse with a different request can be refused.
+type idempotencyScope struct {
+	Key       string
+	Operation string
+	Caller    string
+	Request   any
+}
+
+func (s idempotencyScope) name() string {
+	return s.Operation + ":" + s.Caller
+}
+
+func (s idempotencyScope) hash() (string, error) {
+	b, err := json.Marshal(s.Request)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (s *RideService) withIdempotency(ctx context.Context, scope idempotencyScope, fn func(repo outbound.RideRepo, outbox outbound.OutboxRepo) (domain.Ride, error)) (domain.Ride, error) {
+	return runIdempotent(ctx, s, scope, func(_ outbound.RideOfferRepo, repo outbound.RideRepo, outbox outbound.OutboxRepo) (domain.Ride, error) {
+		return fn(repo, outbox)
+	})
+}
+
+func (s *RideService) withIdempotencyOffer(ctx context.Context, scope idempotencyScope, fn func(offers outbound.RideOfferRepo, rides outbound.RideRepo, outbox outbound.OutboxRepo) (domain.RideOffer, error)) (domain.RideOffer, error) {
+	return runIdempotent(ctx, s, scope, fn)
+}
+
+// runIdempotent runs fn in a transaction at most once per scoped key. The key
+// is reserved as IN_PROGRESS before fn runs, so a concurrent duplicate waits
+// for the result instead of running fn again. The response is stored in the
+// same transaction as fn's writes; if fn fails the reservation is released so
+// the caller can retry with the same key. Both settle only the reservation
+// this call made: if its lease lapsed and a retry took the key over, the
+// retry's reservation is left to the retry.
+func runIdempotent[T any](ctx context.Context, s *RideService, scope idempotencyScope, fn func(offers outbound.RideOfferRepo, rides outbound.RideRepo, outbox outbound.OutboxRepo) (T, error)) (T, error) {
+	var zero T
+	if scope.Key == "" || s.Idempotency == nil {
+		return runInTx(s, func(offers outbound.RideOfferRepo, rides outbound.RideRepo, _ outbound.IdempotencyRepo, outbox outbound.OutboxRepo) (T, error) {
+			return fn(offers, rides, outbox)
+		})
+	}
+
+	hash, err := scope.hash()
+	if err != nil {
+		return zero, err
+	}
+	reservation, cached, done, err := reserveIdempotencyKey[T](ctx, s, scope, hash)
+	if err != nil || done {
+		return cached, err
+	}
+
+	result, err := runInTx(s, func(offers outbound.RideOfferRepo, rides outbound.RideRepo, idem outbound.IdempotencyRepo, outbox outbound.OutboxRepo) (T, error) {
+		result, err := fn(offers, rides, outbox)
+		if err != nil {
+			return zero, err
+		}
+		b, err := json.Marshal(result)
+		if err != nil {
+			return zero, err
+		}
+		if err := idem.Complete(ctx, reservation, string(b)); err != nil {
+			return zero, err
+		}
+		return result, nil
+	})
+	if err != nil {
+		// Best effort: a reservation left behind lapses after the lease.
+		_ = s.Idempotency.Release(ctx, reservation)
+		return zero, err
+	}
+	return result, nil
+}
+
+// reserveIdempotencyKey claims the key for this request and returns the
+// reservation to settle it with. done reports that the key already holds a
+// finished response, which is returned as cached.
+func reserveIdempotencyKey[T any](ctx context.Context, s *RideService, scope idempotencyScope, hash string) (reservation outbound.IdempotencyRecord, cached T, done bool, err error) {
+	policy := s.idempotencyPolicy()
+	// Waiting is measured on the wall clock; s.now only stamps the record.
+	deadline := time.Now().Add(policy.Wait)
+	for {
+		now := s.now()
+		record, reserved, err := s.Idempotency.Reserve(ctx, outbound.IdempotencyRecord{
+			Scope:       scope.name(),
+			Key:         scope.Key,
+			RequestHash: hash,
+			Status:      outbound.IdempotencyInProgress,
+			LockedUntil: now.Add(policy.Lease),
+			CreatedAt:   now,
+		}, now)
+		if err != nil {
+			return reservation, cached, false, err
+		}
+		if reserved {
+			return record, cached, false, nil
+		}
+		if record.RequestHash != hash {
+			return reservation, cached, false, ErrIdempotencyKeyReused
+		}
+		if record.Status == outbound.IdempotencyCompleted {
+			if err := json.Unmarshal([]byte(record.Response), &cached); err != nil {
+				return reservation, cached, false, err
+			}
+			return reservation, cached, true, nil
+		}
+		if !time.Now().Before(deadline) {
+			return reservation, cached, false, ErrRequestInProgress
+		}
+		select {
+		case <-ctx.Done():
+			return reservation, cached, false, ctx.Err()
+		case <-time.After(idempotencyPollInterval):
+		}
+	}
+}
+
+func (s *RideService) idempotencyPolicy() IdempotencyPolicy {
+	policy := s.IdempotencyPolicy
+	if policy.Lease <= 0 {
+		policy.Lease = defaultIdempotencyLease
+	}
+	return policy
+}
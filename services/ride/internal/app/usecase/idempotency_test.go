@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+type fakeIdempotencyRepo struct {
+	records     map[string]outbound.IdempotencyRecord
+	completeErr error
+}
+
+func newFakeIdempotencyRepo() *fakeIdempotencyRepo {
+	return &fakeIdempotencyRepo{records: map[string]outbound.IdempotencyRecord{}}
+}
+
+func (f *fakeIdempotencyRepo) Reserve(ctx context.Context, record outbound.IdempotencyRecord, now time.Time) (outbound.IdempotencyRecord, bool, error) {
+	existing, ok := f.records[record.Scope+"|"+record.Key]
+	if ok && !(existing.Status == outbound.IdempotencyInProgress && existing.RequestHash == record.RequestHash && existing.LockedUntil.Before(now)) {
+		return existing, false, nil
+	}
+	f.records[record.Scope+"|"+record.Key] = record
+	return record, true, nil
+}
+
+func (f *fakeIdempotencyRepo) Get(ctx context.Context, scope, key string) (outbound.IdempotencyRecord, bool, error) {
+	record, ok := f.records[scope+"|"+key]
+	return record, ok, nil
+}
+
+func (f *fakeIdempotencyRepo) Complete(ctx context.Context, reservation outbound.IdempotencyRecord, response string) error {
+	if f.completeErr != nil {
+		return f.completeErr
+	}
+	record, ok := f.held(reservation)
+	if !ok {
+		return outbound.ErrConflict
+	}
+	record.Status = outbound.IdempotencyCompleted
+	record.Response = response
+	f.records[reservation.Scope+"|"+reservation.Key] = record
+	return nil
+}
+
+func (f *fakeIdempotencyRepo) Release(ctx context.Context, reservation outbound.IdempotencyRecord) error {
+	if _, ok := f.held(reservation); ok {
+		delete(f.records, reservation.Scope+"|"+reservation.Key)
+	}
+	return nil
+}
+
+// held returns the stored record while reservation still holds it.
+func (f *fakeIdempotencyRepo) held(reservation outbound.IdempotencyRecord) (outbound.IdempotencyRecord, bool) {
+	record, ok := f.records[reservation.Scope+"|"+reservation.Key]
+	if !ok || record.Status != outbound.IdempotencyInProgress || !record.LockedUntil.Equal(reservation.LockedUntil) {
+		return outbound.IdempotencyRecord{}, false
+	}
+	return record, true
+}
+
+func newIdempotentService() (*RideService, *fakeRideRepo, *fakeIdempotencyRepo) {
+	repo := newFakeRideRepo()
+	idem := newFakeIdempotencyRepo()
+	svc := &RideService{Repo: repo, Idempotency: idem, Outbox: &fakeOutboxRepo{}, OfferMetrics: &OfferMetrics{}}
+	return svc, repo, idem
+}
+
+func TestIdempotencyReplaysAndRejectsReuse(t *testing.T) {
+	svc, repo, _ := newIdempotentService()
+	ctx := context.Background()
+	cmd := CreateRideCmd{RiderID: "r1", PickupLat: 1, PickupLng: 2, DropoffLat: 3, DropoffLng: 4, IdempotencyKey: "k1"}
+
+	first, err := svc.CreateRide(ctx, cmd)
+	if err != nil {
+		t.Fatalf("create error: %v", err)
+	}
+	replay, err := svc.CreateRide(ctx, cmd)
+	if err != nil || replay.ID != first.ID || len(repo.store) != 1 {
+		t.Fatalf("expected cached ride %s, got %s / %v (%d rides)", first.ID, replay.ID, err, len(repo.store))
+	}
+
+	changed := cmd
+	changed.DropoffLat = 5
+	if _, err := svc.CreateRide(ctx, changed); !errors.Is(err, ErrIdempotencyKeyReused) {
+		t.Fatalf("expected reused key rejected, got %v", err)
+	}
+
+	// The same key is independent for another rider and another operation.
+	other := cmd
+	other.RiderID = "r2"
+	if ride, err := svc.CreateRide(ctx, other); err != nil || ride.ID == first.ID {
+		t.Fatalf("expected a new ride for another rider, got %+v / %v", ride, err)
+	}
+	cancelled, err := svc.CancelRide(ctx, CancelRideCmd{RideID: first.ID, Reason: "test", Actor: domain.ActorRider, ActorID: "r1", IdempotencyKey: "k1"})
+	if err != nil || cancelled.Status != domain.StatusCancelled {
+		t.Fatalf("expected cancel under the same key, got %+v / %v", cancelled, err)
+	}
+}
+
+func TestIdempotencyInProgressDuplicate(t *testing.T) {
+	svc, repo, idem := newIdempotentService()
+	svc.Clock = &fixedClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	svc.IdempotencyPolicy = IdempotencyPolicy{Wait: 10 * time.Millisecond}
+	ctx := context.Background()
+	cmd := CreateRideCmd{RiderID: "r1", PickupLat: 1, PickupLng: 2, DropoffLat: 3, DropoffLng: 4, IdempotencyKey: "k1"}
+	scope := idempotencyScope{Key: cmd.IdempotencyKey, Operation: "ride.create", Caller: cmd.RiderID, Request: cmd}
+	hash, err := scope.hash()
+	if err != nil {
+		t.Fatalf("hash error: %v", err)
+	}
+	idem.records[scope.name()+"|k1"] = outbound.IdempotencyRecord{
+		Scope:       scope.name(),
+		Key:         "k1",
+		RequestHash: hash,
+		Status:      outbound.IdempotencyInProgress,
+		LockedUntil: svc.now().Add(time.Second),
+	}
+
+	if _, err := svc.CreateRide(ctx, cmd); !errors.Is(err, ErrRequestInProgress) {
+		t.Fatalf("expected duplicate turned away while in progress, got %v", err)
+	}
+	if len(repo.store) != 0 {
+		t.Fatalf("expected duplicate not executed, got %d rides", len(repo.store))
+	}
+
+	// Once the lease lapses the retry takes the key over.
+	svc.Clock = &fixedClock{now: svc.now().Add(2 * time.Second)}
+	if _, err := svc.CreateRide(ctx, cmd); err != nil {
+		t.Fatalf("expected lapsed reservation taken over, got %v", err)
+	}
+	if record := idem.records[scope.name()+"|k1"]; record.Status != outbound.IdempotencyCompleted {
+		t.Fatalf("expected key completed, got %s", record.Status)
+	}
+}
+
+func TestIdempotencyCompleteErrorSurfaces(t *testing.T) {
+	svc, _, idem := newIdempotentService()
+	idem.completeErr = errors.New("db down")
+	cmd := CreateRideCmd{RiderID: "r1", PickupLat: 1, PickupLng: 2, DropoffLat: 3, DropoffLng: 4, IdempotencyKey: "k1"}
+
+	if _, err := svc.CreateRide(context.Background(), cmd); err == nil || err.Error() != "db down" {
+		t.Fatalf("expected complete error returned, got %v", err)
+	}
+	if len(idem.records) != 0 {
+		t.Fatalf("expected reservation released, got %+v", idem.records)
+	}
+}
+
+func TestIdempotencyLapsedHolderCannotSettleTakenOverKey(t *testing.T) {
+	svc, _, idem := newIdempotentService()
+	clock := &fixedClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	svc.Clock = clock
+	svc.IdempotencyPolicy = IdempotencyPolicy{Lease: time.Second}
+	ctx := context.Background()
+	scope := idempotencyScope{Key: "k1", Operation: "ride.test", Caller: "r1", Request: "same"}
+
+	// takeOver lets the lease lapse while the first request is still running
+	// and has a retry of the same request reserve the key.
+	var retry outbound.IdempotencyRecord
+	takeOver := func() {
+		clock.now = clock.now.Add(2 * time.Second)
+		hash, _ := scope.hash()
+		var reserved bool
+		retry, reserved, _ = idem.Reserve(ctx, outbound.IdempotencyRecord{Scope: scope.name(), Key: "k1", RequestHash: hash, Status: outbound.IdempotencyInProgress, LockedUntil: clock.now.Add(time.Second)}, clock.now)
+		if !reserved {
+			t.Fatalf("expected the retry to take the lapsed key over")
+		}
+	}
+
+	_, err := svc.withIdempotency(ctx, scope, func(outbound.RideRepo, outbound.OutboxRepo) (domain.Ride, error) {
+		takeOver()
+		return domain.Ride{}, errors.New("boom")
+	})
+	if err == nil {
+		t.Fatalf("expected the failure back")
+	}
+	if record, ok := idem.records[scope.name()+"|k1"]; !ok || !record.LockedUntil.Equal(retry.LockedUntil) {
+		t.Fatalf("expected the retry's reservation kept, got %+v", record)
+	}
+
+	delete(idem.records, scope.name()+"|k1")
+	_, err = svc.withIdempotency(ctx, scope, func(outbound.RideRepo, outbound.OutboxRepo) (domain.Ride, error) {
+		takeOver()
+		return domain.Ride{ID: "ride-1"}, nil
+	})
+	if !errors.Is(err, outbound.ErrConflict) {
+		t.Fatalf("expected the lapsed holder's completion refused, got %v", err)
+	}
+	if record := idem.records[scope.name()+"|k1"]; record.Status != outbound.IdempotencyInProgress || !record.LockedUntil.Equal(retry.LockedUntil) {
+		t.Fatalf("expected the retry still holding the key, got %+v", record)
+	}
+}
@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+type DriverEarningsQuery struct {
+	DriverID string
+	Period   string
+	From     time.Time
+	To       time.Time
+}
+
+type EarningsReport struct {
+	Period    domain.EarningsPeriod
+	From      time.Time
+	To        time.Time
+	Summaries []domain.EarningsSummary
+}
+
+type PayoutCmd struct {
+	MinAmount int64
+	Limit     int
+	DryRun    bool
+}
+
+type PayoutBatch struct {
+	ID      string
+	Payouts []domain.Payout
+}
+
+// postRideCharge books a captured ride payment in the ledger. Whatever was
+// captured beyond the cancellation fee or fare is booked as a tip. Posting is
+// keyed on the ride, so a capture settled twice is only booked once.
+func (s *RideService) postRideCharge(ctx context.Context, repo outbound.RideRepo, payment domain.Payment) error {
+	if payment.CapturedAmount <= 0 {
+		return nil
+	}
+	ride, err := s.loadRide(ctx, payment.RideID, repo)
+	if err != nil {
+		return err
+	}
+	charge := domain.RideCharge{
+		RideID:   ride.ID,
+		RiderID:  ride.RiderID,
+		Product:  ride.Product,
+		Currency: payment.Currency,
+	}
+	if ride.DriverID != nil {
+		charge.DriverID = *ride.DriverID
+	}
+	if ride.Status == domain.StatusCancelled {
+		charge.Fee = min(payment.CapturedAmount, ride.CancellationFee)
+	} else {
+		charge.Fare = min(payment.CapturedAmount, ride.FareAmount)
+	}
+	charge.Tip = payment.CapturedAmount - charge.Fare - charge.Fee
+	posting, err := s.Commission.RidePosting(s.newID(), charge, s.now())
+	if err != nil {
+		return err
+	}
+	return s.postLedger(ctx, repo, posting)
+}
+
+// postRefund books the refund refundID. Unlike postLedger it hands
+// ErrConflict back, telling the caller this refund was already recorded.
+func (s *RideService) postRefund(ctx context.Context, repo outbound.RideRepo, payment domain.Payment, refundID string, amount int64) error {
+	posting, err := domain.RefundPosting(s.newID(), payment.RideID, payment.Currency, refundID, amount, s.now())
+	if err != nil {
+		return err
+	}
+	return repo.PostLedger(ctx, toOutboundPosting(posting))
+}
+
+// postLedger treats a reference that was already posted as done.
+func (s *RideService) postLedger(ctx context.Context, repo outbound.RideRepo, posting domain.LedgerPosting) error {
+	err := repo.PostLedger(ctx, toOutboundPosting(posting))
+	if errors.Is(err, outbound.ErrConflict) {
+		return nil
+	}
+	return err
+}
+
+// DriverEarnings summarises a driver's ledger by UTC day or ISO week.
+func (s *RideService) DriverEarnings(ctx context.Context, query DriverEarningsQuery) (EarningsReport, error) {
+	if query.DriverID == "" {
+		return EarningsReport{}, domain.ErrInvalidActor
+	}
+	period, from, to, err := domain.EarningsWindow(query.Period, query.From, query.To, s.now())
+	if err != nil {
+		return EarningsReport{}, err
+	}
+	rows, err := s.Repo.DriverEarnings(ctx, outbound.EarningsQuery{
+		DriverID: query.DriverID,
+		Period:   string(period),
+		From:     from,
+		To:       to,
+	})
+	if err != nil {
+		return EarningsReport{}, err
+	}
+	report := EarningsReport{Period: period, From: from, To: to, Summaries: make([]domain.EarningsSummary, 0, len(rows))}
+	for _, row := range rows {
+		report.Summaries = append(report.Summaries, domain.EarningsSummary{
+			PeriodStart: row.PeriodStart,
+			Currency:    row.Currency,
+			Earnings:    row.Earnings,
+			Tips:        row.Tips,
+			Fees:        row.Fees,
+			Payouts:     row.Payouts,
+			Rides:       row.Rides,
+		})
+	}
+	return report, nil
+}
+
+// RunPayouts settles every driver balance of at least MinAmount in one batch
+// and emits driver.payout.created for each payout. A dry run reports what
+// would be paid without posting anything or locking any account.
+func (s *RideService) RunPayouts(ctx context.Context, cmd PayoutCmd) (PayoutBatch, error) {
+	batch := PayoutBatch{ID: s.newID()}
+	payoutFor := func(balance outbound.AccountBalance, now time.Time) domain.Payout {
+		return domain.Payout{
+			ID:        s.newID(),
+			BatchID:   batch.ID,
+			DriverID:  balance.OwnerID,
+			Amount:    balance.Balance,
+			Currency:  balance.Currency,
+			CreatedAt: now,
+		}
+	}
+	if cmd.DryRun {
+		balances, err := s.Repo.DriverBalances(ctx, cmd.MinAmount, cmd.Limit)
+		if err != nil {
+			return PayoutBatch{}, err
+		}
+		now := s.now()
+		for _, balance := range balances {
+			batch.Payouts = append(batch.Payouts, payoutFor(balance, now))
+		}
+		return batch, nil
+	}
+	err := s.withTx(ctx, func(repo outbound.RideRepo, outbox outbound.OutboxRepo) error {
+		balances, err := repo.ClaimDriverBalances(ctx, cmd.MinAmount, cmd.Limit)
+		if err != nil {
+			return err
+		}
+		now := s.now()
+		for _, balance := range balances {
+			payout := payoutFor(balance, now)
+			batch.Payouts = append(batch.Payouts, payout)
+			posting, err := payout.Posting()
+			if err != nil {
+				return err
+			}
+			if err := repo.PostLedger(ctx, toOutboundPosting(posting)); err != nil {
+				return err
+			}
+			if err := s.enqueueEvent(ctx, outbox, eventsv1.TopicDriverPayoutCreated, &eventsv1.DriverPayoutCreated{
+				PayoutId: payout.ID,
+				BatchId:  payout.BatchID,
+				DriverId: payout.DriverID,
+				Amount:   payout.Amount,
+				Currency: payout.Currency,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return PayoutBatch{}, err
+	}
+	return batch, nil
+}
+
+func toOutboundPosting(posting domain.LedgerPosting) outbound.LedgerPosting {
+	entries := make([]outbound.LedgerEntry, 0, len(posting.Entries))
+	for _, entry := range posting.Entries {
+		entries = append(entries, outbound.LedgerEntry{
+			AccountID: entry.Account.ID,
+			OwnerType: string(entry.Account.OwnerType),
+			OwnerID:   entry.Account.OwnerID,
+			Direction: string(entry.Direction),
+			Kind:      string(entry.Kind),
+			Amount:    entry.Amount,
+		})
+	}
+	return outbound.LedgerPosting{
+		ID:        posting.ID,
+		Reference: posting.Reference,
+		RideID:    posting.RideID,
+		Currency:  posting.Currency,
+		Entries:   entries,
+		CreatedAt: posting.CreatedAt,
+	}
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+)
+
+func TestCapturedRideIsBookedAndPaidOut(t *testing.T) {
+	svc, repo, _ := newPaymentService(t)
+	svc.Commission = domain.CommissionPolicy{DefaultBps: 2000}
+	outbox := svc.Outbox.(*fakeOutboxRepo)
+	ctx := context.Background()
+	ride := createPaidRide(t, svc)
+
+	stored := repo.store[ride.ID]
+	driverID := "driver-1"
+	stored.DriverID = &driverID
+	stored.Status = string(domain.StatusInProgress)
+	repo.store[ride.ID] = stored
+	if _, err := svc.CompleteRide(ctx, DriverRideCmd{RideID: ride.ID, DriverID: driverID}); err != nil {
+		t.Fatalf("complete error: %v", err)
+	}
+	if _, err := svc.SettlePayments(ctx, 10); err != nil {
+		t.Fatalf("settle error: %v", err)
+	}
+	if len(repo.ledger) != 1 || repo.ledger[0].Reference != "ride:"+ride.ID+":charge" {
+		t.Fatalf("expected one ride charge posting, got %+v", repo.ledger)
+	}
+
+	commission := ride.FareAmount * 2000 / 10000
+	report, err := svc.DriverEarnings(ctx, DriverEarningsQuery{DriverID: driverID, Period: "DAILY"})
+	if err != nil {
+		t.Fatalf("earnings error: %v", err)
+	}
+	if len(report.Summaries) != 1 || report.Summaries[0].Earnings != ride.FareAmount-commission || report.Summaries[0].Rides != 1 {
+		t.Fatalf("unexpected earnings: %+v", report.Summaries)
+	}
+
+	dry, err := svc.RunPayouts(ctx, PayoutCmd{DryRun: true})
+	if err != nil || len(dry.Payouts) != 1 || len(repo.ledger) != 1 || repo.balancesClaimed != 0 {
+		t.Fatalf("expected dry run to post and lock nothing, got %+v / %v", dry, err)
+	}
+	batch, err := svc.RunPayouts(ctx, PayoutCmd{})
+	if err != nil || len(batch.Payouts) != 1 || batch.Payouts[0].Amount != ride.FareAmount-commission {
+		t.Fatalf("unexpected payout batch: %+v / %v", batch, err)
+	}
+	if topic := outbox.messages[len(outbox.messages)-1].Topic; topic != "driver.payout.created" {
+		t.Fatalf("expected driver.payout.created, got %s", topic)
+	}
+	if again, _ := svc.RunPayouts(ctx, PayoutCmd{}); len(again.Payouts) != 0 {
+		t.Fatalf("expected settled balance not paid twice, got %+v", again.Payouts)
+	}
+}
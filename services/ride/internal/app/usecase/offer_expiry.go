@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+// OfferSweep is what one expiry sweep did: how many offers it expired and how
+// long the oldest of them had already been expired when it was claimed.
+// Failures lists expired offers whose ride could not be put back to matching.
+type OfferSweep struct {
+	Expired  int
+	Lag      time.Duration
+	Failures []OfferFailure
+}
+
+// OfferFailure is an expired offer whose ride update failed and was skipped.
+type OfferFailure struct {
+	OfferID string
+	RideID  string
+	Err     error
+}
+
+// ExpireDueOffers expires up to limit offers whose time has run out, in one
+// transaction: the batch is claimed and marked EXPIRED by a single statement,
+// each ride still waiting on its offer goes back to MATCHING, and
+// ride.offer.expired is enqueued for every offer. Offers are claimed with SKIP
+// LOCKED, so every replica can sweep at once without racing on the same rows.
+// Each ride is updated under its own savepoint: one that fails is reported in
+// Failures and left to the stuck-ride sweep instead of failing the batch.
+func (s *RideService) ExpireDueOffers(ctx context.Context, limit int) (OfferSweep, error) {
+	now := s.now()
+	sweep, err := runInTxSavepoints(s, func(savepoint savepointFunc, offers outbound.RideOfferRepo, rides outbound.RideRepo, _ outbound.IdempotencyRepo, outbox outbound.OutboxRepo) (OfferSweep, error) {
+		rows, err := offers.ExpireDue(ctx, now.Unix(), limit)
+		if err != nil || len(rows) == 0 {
+			return OfferSweep{}, err
+		}
+		var failures []OfferFailure
+		for _, row := range rows {
+			offer := toDomainOffer(row)
+			err := savepoint("offer_expiry", func() error {
+				return s.applyOfferToRide(ctx, rides, outbox, offer)
+			})
+			// A ride that changed under us, e.g. cancelled by the rider, no
+			// longer waits on this offer; the offer is expired all the same.
+			if err != nil && !errors.Is(err, outbound.ErrConflict) {
+				failures = append(failures, OfferFailure{OfferID: offer.ID, RideID: offer.RideID, Err: err})
+			}
+			if err := s.enqueueEvent(ctx, outbox, eventsv1.TopicRideOfferExpired, offerUpdatedEvent(offer)); err != nil {
+				return OfferSweep{}, err
+			}
+		}
+		return OfferSweep{Expired: len(rows), Lag: now.Sub(time.Unix(rows[0].ExpiresAt, 0)), Failures: failures}, nil
+	})
+	if err != nil {
+		return OfferSweep{}, err
+	}
+	for i := 0; i < sweep.Expired; i++ {
+		s.OfferMetrics.IncExpired()
+	}
+	return sweep, nil
+}
+
+func offerUpdatedEvent(offer domain.RideOffer) *eventsv1.RideOfferUpdated {
+	return &eventsv1.RideOfferUpdated{
+		OfferId:  offer.ID,
+		RideId:   offer.RideID,
+		DriverId: offer.DriverID,
+		Status:   string(offer.Status),
+	}
+}
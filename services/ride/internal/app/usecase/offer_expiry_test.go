@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+func TestExpireDueOffers(t *testing.T) {
+	svc, repo, clock, offer := newOfferedRide(t, 10*time.Second)
+	outbox := svc.Outbox.(*fakeOutboxRepo)
+	ctx := context.Background()
+
+	clock.now = clock.now.Add(5 * time.Second)
+	if sweep, err := svc.ExpireDueOffers(ctx, 10); err != nil || sweep.Expired != 0 || sweep.Lag != 0 {
+		t.Fatalf("expected nothing due yet, got %+v / %v", sweep, err)
+	}
+
+	clock.now = clock.now.Add(8 * time.Second)
+	sweep, err := svc.ExpireDueOffers(ctx, 10)
+	if err != nil {
+		t.Fatalf("sweep error: %v", err)
+	}
+	if sweep.Expired != 1 || sweep.Lag != 3*time.Second {
+		t.Fatalf("expected one offer expired 3s late, got %+v", sweep)
+	}
+	if got := svc.Offers.(*fakeOfferRepo).store[offer.ID].Status; got != string(domain.OfferExpired) {
+		t.Fatalf("expected offer expired, got %s", got)
+	}
+	if got := repo.store["ride-1"].Status; got != string(domain.StatusMatching) {
+		t.Fatalf("expected ride back in matching, got %s", got)
+	}
+	msg, ok := lastMessage(outbox, eventsv1.TopicRideOfferExpired)
+	if !ok || !strings.Contains(msg.Payload, `"offer_id":"`+offer.ID+`"`) || !strings.Contains(msg.Payload, `"status":"EXPIRED"`) {
+		t.Fatalf("unexpected ride.offer.expired: %s", msg.Payload)
+	}
+	events, _ := svc.GetRideTimeline(ctx, "ride-1")
+	if got := events[len(events)-1]; got.Actor != domain.ActorSystem || got.Reason != "offer_expired" || got.ToStatus != domain.StatusMatching {
+		t.Fatalf("expected expiry on the timeline, got %+v", got)
+	}
+
+	if sweep, err := svc.ExpireDueOffers(ctx, 10); err != nil || sweep.Expired != 0 {
+		t.Fatalf("expected the offer expired only once, got %+v / %v", sweep, err)
+	}
+}
+
+func TestExpireDueOffersLeavesCancelledRide(t *testing.T) {
+	svc, repo, clock, _ := newOfferedRide(t, 10*time.Second)
+	ctx := context.Background()
+	if _, err := svc.CancelRide(ctx, CancelRideCmd{RideID: "ride-1", Reason: "test"}); err != nil {
+		t.Fatalf("cancel error: %v", err)
+	}
+
+	clock.now = clock.now.Add(time.Minute)
+	sweep, err := svc.ExpireDueOffers(ctx, 10)
+	if err != nil || sweep.Expired != 1 {
+		t.Fatalf("expected the offer expired, got %+v / %v", sweep, err)
+	}
+	if got := repo.store["ride-1"].Status; got != string(domain.StatusCancelled) {
+		t.Fatalf("expected ride to stay cancelled, got %s", got)
+	}
+}
+
+func TestExpireDueOffersSkipsFailingRide(t *testing.T) {
+	svc, repo, clock, offer := newOfferedRide(t, 10*time.Second)
+	offers := svc.Offers.(*fakeOfferRepo)
+	ctx := context.Background()
+	// Oldest first, so the broken offer comes up ahead of the healthy one.
+	broken := outbound.RideOffer{ID: "offer-broken", RideID: "ride-gone", DriverID: "driver-2", Status: string(domain.OfferPending), ExpiresAt: clock.now.Unix()}
+	if err := offers.Create(ctx, broken); err != nil {
+		t.Fatalf("create offer error: %v", err)
+	}
+
+	clock.now = clock.now.Add(time.Minute)
+	sweep, err := svc.ExpireDueOffers(ctx, 10)
+	if err != nil || sweep.Expired != 2 {
+		t.Fatalf("expected both offers expired, got %+v / %v", sweep, err)
+	}
+	if len(sweep.Failures) != 1 || sweep.Failures[0].OfferID != broken.ID || !errors.Is(sweep.Failures[0].Err, outbound.ErrNotFound) {
+		t.Fatalf("expected the broken offer reported, got %+v", sweep.Failures)
+	}
+	if got := repo.store["ride-1"].Status; got != string(domain.StatusMatching) {
+		t.Fatalf("expected the healthy ride back in matching, got %s", got)
+	}
+	if got := offers.store[offer.ID].Status; got != string(domain.OfferExpired) {
+		t.Fatalf("expected offer expired, got %s", got)
+	}
+}
@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+const (
+	defaultOutboxPageSize = 50
+	maxOutboxPageSize     = 500
+	outboxStatsTopics     = 20
+)
+
+var (
+	ErrInvalidOutboxSelection = errors.New("invalid outbox selection")
+	ErrAuditNoteRequired      = errors.New("audit note required")
+)
+
+var outboxStatuses = []string{"PENDING", "PROCESSING", "SENT", "FAILED", "SKIPPED"}
+
+// Rows an admin action may touch. Rows in PROCESSING belong to a worker and
+// are left alone until they settle or their lease is reclaimed. SENT rows are
+// not replayable: the row ID is the Nats-Msg-Id, so JetStream would drop the
+// copy as a duplicate, and the row's old seq would put it behind events the
+// consumers have already seen.
+var (
+	replayableStatuses = []string{"FAILED", "SKIPPED"}
+	skippableStatuses  = []string{"PENDING", "FAILED"}
+	purgeableStatuses  = []string{"PENDING", "FAILED", "SKIPPED"}
+)
+
+// OutboxAdmin is the operator tooling over the outbox, shared by the CLI and
+// the OutboxAdmin gRPC service.
+type OutboxAdmin struct {
+	Repo  outbound.OutboxAdminRepo
+	Clock Clock
+}
+
+type ListOutboxQuery struct {
+	Status        string
+	Topic         string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	PageSize      int
+	Cursor        string
+}
+
+type OutboxPage struct {
+	Messages   []outbound.OutboxRecord
+	NextCursor string
+}
+
+// OutboxActionCmd selects rows by IDs, or for replay by Topic and/or a
+// created_at range among rows in Status (FAILED when empty).
+type OutboxActionCmd struct {
+	IDs           []string
+	Topic         string
+	Status        string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	Actor         string
+	Note          string
+}
+
+func (a *OutboxAdmin) List(ctx context.Context, query ListOutboxQuery) (OutboxPage, error) {
+	if query.Status != "" && !slices.Contains(outboxStatuses, strings.ToUpper(query.Status)) {
+		return OutboxPage{}, ErrInvalidOutboxSelection
+	}
+	limit := query.PageSize
+	if limit <= 0 {
+		limit = defaultOutboxPageSize
+	}
+	limit = min(limit, maxOutboxPageSize)
+	filter := outbound.OutboxFilter{
+		Status:        strings.ToUpper(query.Status),
+		Topic:         query.Topic,
+		CreatedAfter:  query.CreatedAfter,
+		CreatedBefore: query.CreatedBefore,
+		Limit:         limit + 1,
+	}
+	if query.Cursor != "" {
+		seq, err := decodeOutboxCursor(query.Cursor)
+		if err != nil {
+			return OutboxPage{}, err
+		}
+		filter.AfterSeq = seq
+	}
+	rows, err := a.Repo.List(ctx, filter)
+	if err != nil {
+		return OutboxPage{}, err
+	}
+	page := OutboxPage{Messages: rows}
+	if len(rows) > limit {
+		page.Messages = rows[:limit]
+		page.NextCursor = encodeOutboxCursor(page.Messages[limit-1].Seq)
+	}
+	return page, nil
+}
+
+func (a *OutboxAdmin) Get(ctx context.Context, id string) (outbound.OutboxRecord, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return outbound.OutboxRecord{}, outbound.ErrNotFound
+	}
+	return a.Repo.Get(ctx, id)
+}
+
+// Replay sends rows through the publisher again with a fresh attempt budget.
+func (a *OutboxAdmin) Replay(ctx context.Context, cmd OutboxActionCmd) (int64, error) {
+	selection, err := a.selection(cmd, replayableStatuses, true)
+	if err != nil {
+		return 0, err
+	}
+	return a.Repo.Replay(ctx, selection, a.audit("REPLAY", cmd))
+}
+
+// Skip retires poison messages without publishing them. Later events of the
+// same ride are released.
+func (a *OutboxAdmin) Skip(ctx context.Context, cmd OutboxActionCmd) (int64, error) {
+	if strings.TrimSpace(cmd.Note) == "" {
+		return 0, ErrAuditNoteRequired
+	}
+	selection, err := a.selection(cmd, skippableStatuses, false)
+	if err != nil {
+		return 0, err
+	}
+	return a.Repo.Skip(ctx, selection, a.audit("SKIP", cmd))
+}
+
+// Purge deletes rows outright; their payload survives in the audit log.
+func (a *OutboxAdmin) Purge(ctx context.Context, cmd OutboxActionCmd) (int64, error) {
+	if strings.TrimSpace(cmd.Note) == "" {
+		return 0, ErrAuditNoteRequired
+	}
+	selection, err := a.selection(cmd, purgeableStatuses, false)
+	if err != nil {
+		return 0, err
+	}
+	return a.Repo.Purge(ctx, selection, a.audit("PURGE", cmd))
+}
+
+func (a *OutboxAdmin) Stats(ctx context.Context) (outbound.OutboxStats, error) {
+	return a.Repo.Stats(ctx, outboxStatsTopics)
+}
+
+// selection turns a command into the rows it may touch. Explicit IDs act on
+// any row in allowed; a topic or time range is only accepted when byRange is
+// set, and is limited to cmd.Status.
+func (a *OutboxAdmin) selection(cmd OutboxActionCmd, allowed []string, byRange bool) (outbound.OutboxSelection, error) {
+	if cmd.Actor == "" {
+		return outbound.OutboxSelection{}, domain.ErrInvalidActor
+	}
+	if len(cmd.IDs) > 0 {
+		for _, id := range cmd.IDs {
+			if _, err := uuid.Parse(id); err != nil {
+				return outbound.OutboxSelection{}, ErrInvalidOutboxSelection
+			}
+		}
+		return outbound.OutboxSelection{IDs: cmd.IDs, Statuses: allowed}, nil
+	}
+	if !byRange || (cmd.Topic == "" && cmd.CreatedAfter.IsZero() && cmd.CreatedBefore.IsZero()) {
+		return outbound.OutboxSelection{}, ErrInvalidOutboxSelection
+	}
+	status := strings.ToUpper(cmd.Status)
+	if status == "" {
+		status = "FAILED"
+	}
+	if !slices.Contains(allowed, status) {
+		return outbound.OutboxSelection{}, ErrInvalidOutboxSelection
+	}
+	return outbound.OutboxSelection{
+		Statuses:      []string{status},
+		Topic:         cmd.Topic,
+		CreatedAfter:  cmd.CreatedAfter,
+		CreatedBefore: cmd.CreatedBefore,
+	}, nil
+}
+
+func (a *OutboxAdmin) audit(action string, cmd OutboxActionCmd) outbound.OutboxAudit {
+	now := time.Now().UTC()
+	if a.Clock != nil {
+		now = a.Clock.Now().UTC()
+	}
+	return outbound.OutboxAudit{Action: action, Actor: cmd.Actor, Note: strings.TrimSpace(cmd.Note), At: now}
+}
+
+func encodeOutboxCursor(seq int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(seq, 10)))
+}
+
+func decodeOutboxCursor(value string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+	seq, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil || seq < 0 {
+		return 0, ErrInvalidCursor
+	}
+	return seq, nil
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+type fakeOutboxAdminRepo struct {
+	rows       []outbound.OutboxRecord
+	lastFilter outbound.OutboxFilter
+	selections []outbound.OutboxSelection
+	audits     []outbound.OutboxAudit
+}
+
+func (f *fakeOutboxAdminRepo) List(ctx context.Context, filter outbound.OutboxFilter) ([]outbound.OutboxRecord, error) {
+	f.lastFilter = filter
+	var out []outbound.OutboxRecord
+	for _, row := range f.rows {
+		if row.Seq > filter.AfterSeq && len(out) < filter.Limit {
+			out = append(out, row)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeOutboxAdminRepo) Get(ctx context.Context, id string) (outbound.OutboxRecord, error) {
+	for _, row := range f.rows {
+		if row.ID == id {
+			return row, nil
+		}
+	}
+	return outbound.OutboxRecord{}, outbound.ErrNotFound
+}
+
+func (f *fakeOutboxAdminRepo) act(selection outbound.OutboxSelection, audit outbound.OutboxAudit) (int64, error) {
+	f.selections = append(f.selections, selection)
+	f.audits = append(f.audits, audit)
+	return int64(len(selection.IDs)), nil
+}
+
+func (f *fakeOutboxAdminRepo) Replay(ctx context.Context, selection outbound.OutboxSelection, audit outbound.OutboxAudit) (int64, error) {
+	return f.act(selection, audit)
+}
+
+func (f *fakeOutboxAdminRepo) Skip(ctx context.Context, selection outbound.OutboxSelection, audit outbound.OutboxAudit) (int64, error) {
+	return f.act(selection, audit)
+}
+
+func (f *fakeOutboxAdminRepo) Purge(ctx context.Context, selection outbound.OutboxSelection, audit outbound.OutboxAudit) (int64, error) {
+	return f.act(selection, audit)
+}
+
+func (f *fakeOutboxAdminRepo) Stats(ctx context.Context, topicLimit int) (outbound.OutboxStats, error) {
+	return outbound.OutboxStats{}, nil
+}
+
+func TestOutboxAdminActions(t *testing.T) {
+	repo := &fakeOutboxAdminRepo{}
+	admin := &OutboxAdmin{Repo: repo, Clock: &fixedClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}}
+	ctx := context.Background()
+	id := uuid.NewString()
+
+	if _, err := admin.Skip(ctx, OutboxActionCmd{IDs: []string{id}, Actor: "ops"}); !errors.Is(err, ErrAuditNoteRequired) {
+		t.Fatalf("expected skip without a note rejected, got %v", err)
+	}
+	if _, err := admin.Purge(ctx, OutboxActionCmd{IDs: []string{id}, Actor: "ops", Note: "  "}); !errors.Is(err, ErrAuditNoteRequired) {
+		t.Fatalf("expected purge with a blank note rejected, got %v", err)
+	}
+	if _, err := admin.Replay(ctx, OutboxActionCmd{IDs: []string{id}}); !errors.Is(err, domain.ErrInvalidActor) {
+		t.Fatalf("expected replay without an actor rejected, got %v", err)
+	}
+	if _, err := admin.Replay(ctx, OutboxActionCmd{IDs: []string{"not-a-uuid"}, Actor: "ops"}); !errors.Is(err, ErrInvalidOutboxSelection) {
+		t.Fatalf("expected malformed id rejected, got %v", err)
+	}
+	if _, err := admin.Replay(ctx, OutboxActionCmd{Actor: "ops"}); !errors.Is(err, ErrInvalidOutboxSelection) {
+		t.Fatalf("expected unbounded replay rejected, got %v", err)
+	}
+	if _, err := admin.Skip(ctx, OutboxActionCmd{Topic: "ride.requested", Actor: "ops", Note: "poison"}); !errors.Is(err, ErrInvalidOutboxSelection) {
+		t.Fatalf("expected skip by topic rejected, got %v", err)
+	}
+	if _, err := admin.Replay(ctx, OutboxActionCmd{Topic: "ride.requested", Status: "PROCESSING", Actor: "ops"}); !errors.Is(err, ErrInvalidOutboxSelection) {
+		t.Fatalf("expected replay of in-flight rows rejected, got %v", err)
+	}
+	if _, err := admin.Replay(ctx, OutboxActionCmd{Topic: "ride.requested", Status: "SENT", Actor: "ops"}); !errors.Is(err, ErrInvalidOutboxSelection) {
+		t.Fatalf("expected replay of sent rows rejected, got %v", err)
+	}
+	if len(repo.selections) != 0 {
+		t.Fatalf("expected no rows touched, got %+v", repo.selections)
+	}
+
+	if _, err := admin.Replay(ctx, OutboxActionCmd{Topic: "ride.requested", Actor: "ops"}); err != nil {
+		t.Fatalf("replay by topic error: %v", err)
+	}
+	if got := repo.selections[0]; len(got.Statuses) != 1 || got.Statuses[0] != "FAILED" || got.Topic != "ride.requested" {
+		t.Fatalf("expected range replay limited to FAILED, got %+v", got)
+	}
+	if n, err := admin.Skip(ctx, OutboxActionCmd{IDs: []string{id}, Actor: "ops", Note: " poison "}); err != nil || n != 1 {
+		t.Fatalf("expected skip of one row, got %d / %v", n, err)
+	}
+	if audit := repo.audits[1]; audit.Action != "SKIP" || audit.Actor != "ops" || audit.Note != "poison" || !audit.At.Equal(admin.Clock.Now()) {
+		t.Fatalf("unexpected audit: %+v", audit)
+	}
+}
+
+func TestOutboxAdminListPages(t *testing.T) {
+	repo := &fakeOutboxAdminRepo{}
+	for seq := int64(1); seq <= 5; seq++ {
+		repo.rows = append(repo.rows, outbound.OutboxRecord{ID: uuid.NewString(), Seq: seq, Status: "FAILED"})
+	}
+	admin := &OutboxAdmin{Repo: repo}
+	ctx := context.Background()
+
+	first, err := admin.List(ctx, ListOutboxQuery{Status: "failed", PageSize: 2})
+	if err != nil || len(first.Messages) != 2 || first.NextCursor == "" || repo.lastFilter.Status != "FAILED" {
+		t.Fatalf("unexpected first page: %+v / %v", first, err)
+	}
+	var seen []int64
+	page := first
+	for {
+		for _, msg := range page.Messages {
+			seen = append(seen, msg.Seq)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		if page, err = admin.List(ctx, ListOutboxQuery{PageSize: 2, Cursor: page.NextCursor}); err != nil {
+			t.Fatalf("list error: %v", err)
+		}
+	}
+	if len(seen) != 5 || seen[4] != 5 {
+		t.Fatalf("expected every row once, got %v", seen)
+	}
+
+	if _, err := admin.List(ctx, ListOutboxQuery{Cursor: "!!"}); !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("expected bad cursor rejected, got %v", err)
+	}
+	if _, err := admin.List(ctx, ListOutboxQuery{Status: "LOST"}); !errors.Is(err, ErrInvalidOutboxSelection) {
+		t.Fatalf("expected unknown status rejected, got %v", err)
+	}
+	if _, err := admin.Get(ctx, "nope"); !errors.Is(err, outbound.ErrNotFound) {
+		t.Fatalf("expected malformed id not found, got %v", err)
+	}
+}
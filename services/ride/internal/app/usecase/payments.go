@@ -0,0 +1,348 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+// Payments wires the card processor into the ride flow. A nil *Payments turns
+// payments off: rides are created without a hold and nothing is charged.
+type Payments struct {
+	Provider    outbound.PaymentProvider
+	Name        string
+	MaxAttempts int
+}
+
+type RefundPaymentCmd struct {
+	RideID   string
+	RefundID string
+	Amount   int64
+	Reason   string
+}
+
+// authorizeRide places a hold for the ride's fare. The ride ID doubles as the
+// idempotency key, so a retried call cannot place a second hold.
+func (s *RideService) authorizeRide(ctx context.Context, ride domain.Ride) (domain.Payment, error) {
+	if s.Payments == nil || s.Payments.Provider == nil {
+		return domain.Payment{}, nil
+	}
+	result, err := s.Payments.Provider.Authorize(ctx, outbound.AuthorizeRequest{
+		IdempotencyKey: ride.ID,
+		CustomerID:     ride.RiderID,
+		Reference:      ride.ID,
+		Amount:         ride.FareAmount,
+		Currency:       ride.Currency,
+	})
+	if err != nil {
+		return domain.Payment{}, err
+	}
+	return domain.Payment{
+		ID:          s.newID(),
+		RideID:      ride.ID,
+		RiderID:     ride.RiderID,
+		Amount:      result.Amount,
+		Currency:    ride.Currency,
+		Status:      domain.PaymentAuthorized,
+		ProviderRef: result.ProviderRef,
+		CreatedAt:   ride.CreatedAt,
+		UpdatedAt:   ride.CreatedAt,
+	}, nil
+}
+
+func (s *RideService) savePayment(ctx context.Context, repo outbound.RideRepo, payment domain.Payment) error {
+	if payment.ID == "" {
+		return nil
+	}
+	return repo.CreatePayment(ctx, outbound.Payment{
+		ID:          payment.ID,
+		RideID:      payment.RideID,
+		RiderID:     payment.RiderID,
+		Amount:      payment.Amount,
+		Currency:    payment.Currency,
+		Status:      string(payment.Status),
+		Provider:    s.Payments.Name,
+		ProviderRef: payment.ProviderRef,
+		CreatedAt:   payment.CreatedAt,
+		UpdatedAt:   payment.UpdatedAt,
+	})
+}
+
+// releaseHold voids a hold whose ride was never stored. It is best effort: a
+// hold that cannot be voided lapses at the provider on its own.
+func (s *RideService) releaseHold(ctx context.Context, payment domain.Payment) {
+	if payment.ProviderRef == "" || s.Payments == nil || s.Payments.Provider == nil {
+		return
+	}
+	_, _ = s.Payments.Provider.Void(ctx, outbound.VoidRequest{
+		IdempotencyKey: payment.ID + ":void",
+		ProviderRef:    payment.ProviderRef,
+	})
+}
+
+// requestCapture marks the ride's hold for capture of amount, or for a void
+// when amount is zero. The payment worker settles it with the provider after
+// the ride transaction commits. Rides without a payment are left alone.
+func (s *RideService) requestCapture(ctx context.Context, repo outbound.RideRepo, rideID string, amount int64) error {
+	row, err := repo.GetPaymentByRide(ctx, rideID)
+	if errors.Is(err, outbound.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	payment := toDomainPayment(row)
+	if payment.Status != domain.PaymentAuthorized {
+		return nil
+	}
+	next, err := payment.RequestCapture(amount)
+	if err != nil {
+		return err
+	}
+	next.UpdatedAt = s.now()
+	return repo.UpdatePaymentIfCurrent(ctx, toOutboundPayment(next, row.Provider), string(payment.Status))
+}
+
+// SettlePayments captures or voids holds the ride flow asked for and books
+// captures in the ledger. Failed provider calls are retried on later runs
+// until MaxAttempts, after which the payment is parked as FAILED and
+// ride.payment.failed is emitted.
+//
+// The provider is called outside any transaction and each result is recorded
+// in its own, so a slow provider holds no row locks and one payment that
+// cannot be recorded does not undo the others. Such a payment stays pending
+// and the next run replays its provider call under the same idempotency key;
+// its error is joined into the returned one.
+func (s *RideService) SettlePayments(ctx context.Context, limit int) (int, error) {
+	if s.Payments == nil || s.Payments.Provider == nil {
+		return 0, nil
+	}
+	rows, err := s.Repo.ListPendingPayments(ctx, limit)
+	if err != nil {
+		return 0, err
+	}
+	settled := 0
+	var errs []error
+	for _, row := range rows {
+		payment := toDomainPayment(row)
+		topic, next, callErr := s.settlePayment(ctx, payment)
+		if callErr != nil {
+			next = payment.RecordFailure(callErr.Error(), s.Payments.MaxAttempts)
+			topic = ""
+			if next.Status == domain.PaymentFailed {
+				topic = eventsv1.TopicRidePaymentFailed
+			}
+		}
+		next.UpdatedAt = s.now()
+		err := s.recordSettlement(ctx, row, payment, next, topic)
+		// Another worker recorded this payment first.
+		if errors.Is(err, outbound.ErrConflict) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("payment %s: %w", payment.ID, err))
+			continue
+		}
+		if callErr == nil && topic != "" {
+			settled++
+		}
+	}
+	return settled, errors.Join(errs...)
+}
+
+// recordSettlement writes one provider outcome in its own transaction. The
+// write is guarded by the status the payment was read with, so of two workers
+// settling the same payment only the first records it.
+func (s *RideService) recordSettlement(ctx context.Context, row outbound.Payment, payment, next domain.Payment, topic string) error {
+	return s.withTx(ctx, func(repo outbound.RideRepo, outbox outbound.OutboxRepo) error {
+		if err := repo.UpdatePaymentIfCurrent(ctx, toOutboundPayment(next, row.Provider), string(payment.Status)); err != nil {
+			return err
+		}
+		if topic == eventsv1.TopicRidePaymentCaptured {
+			if err := s.postRideCharge(ctx, repo, next); err != nil {
+				return err
+			}
+		}
+		if topic == "" {
+			return nil
+		}
+		return s.enqueueEvent(ctx, outbox, topic, paymentEvent(next))
+	})
+}
+
+func (s *RideService) settlePayment(ctx context.Context, payment domain.Payment) (string, domain.Payment, error) {
+	switch payment.Status {
+	case domain.PaymentCapturePending:
+		if _, err := s.Payments.Provider.Capture(ctx, outbound.CaptureRequest{
+			IdempotencyKey: payment.ID + ":capture",
+			ProviderRef:    payment.ProviderRef,
+			Amount:         payment.CaptureAmount,
+		}); err != nil {
+			return "", payment, err
+		}
+		next, err := payment.Settle()
+		return eventsv1.TopicRidePaymentCaptured, next, err
+	case domain.PaymentVoidPending:
+		if _, err := s.Payments.Provider.Void(ctx, outbound.VoidRequest{
+			IdempotencyKey: payment.ID + ":void",
+			ProviderRef:    payment.ProviderRef,
+		}); err != nil {
+			return "", payment, err
+		}
+		next, err := payment.Settle()
+		return eventsv1.TopicRidePaymentVoided, next, err
+	default:
+		return "", payment, domain.ErrInvalidPaymentTransition
+	}
+}
+
+// RefundPayment returns part or all of a captured payment to the rider. The
+// caller's refund id keys both the provider call and the ledger posting, so
+// retrying a refund whose result was lost replays it instead of refunding
+// twice, and reusing the id for another amount is rejected. The provider is
+// called before the transaction that records the refund opens, so no payment
+// row is locked while it answers.
+func (s *RideService) RefundPayment(ctx context.Context, cmd RefundPaymentCmd) (domain.Payment, error) {
+	if s.Payments == nil || s.Payments.Provider == nil {
+		return domain.Payment{}, domain.ErrPaymentNotFound
+	}
+	if cmd.RefundID == "" {
+		return domain.Payment{}, domain.ErrInvalidRefund
+	}
+	payment, err := s.GetPayment(ctx, cmd.RideID)
+	if err != nil {
+		return domain.Payment{}, err
+	}
+	recorded, err := s.Repo.LedgerPosted(ctx, domain.RefundReference(payment.RideID, cmd.RefundID))
+	if err != nil {
+		return domain.Payment{}, err
+	}
+	if !recorded {
+		if _, err := payment.Refund(cmd.Amount); err != nil {
+			return domain.Payment{}, err
+		}
+	}
+	if err := s.refundWithProvider(ctx, payment, cmd); err != nil {
+		return domain.Payment{}, err
+	}
+	if recorded {
+		return payment, nil
+	}
+
+	var refunded domain.Payment
+	err = s.withTx(ctx, func(repo outbound.RideRepo, outbox outbound.OutboxRepo) error {
+		row, err := repo.GetPaymentByRide(ctx, cmd.RideID)
+		if err != nil {
+			return err
+		}
+		current := toDomainPayment(row)
+		// A concurrent retry of this refund recorded it first.
+		if err := s.postRefund(ctx, repo, current, cmd.RefundID, cmd.Amount); errors.Is(err, outbound.ErrConflict) {
+			refunded = current
+			return nil
+		} else if err != nil {
+			return err
+		}
+		refunded, err = current.Refund(cmd.Amount)
+		if err != nil {
+			return err
+		}
+		refunded.UpdatedAt = s.now()
+		if err := repo.UpdatePaymentIfCurrent(ctx, toOutboundPayment(refunded, row.Provider), string(current.Status)); err != nil {
+			return err
+		}
+		event := paymentEvent(refunded)
+		event.RefundAmount = &cmd.Amount
+		event.Reason = &cmd.Reason
+		return s.enqueueEvent(ctx, outbox, eventsv1.TopicRidePaymentRefunded, event)
+	})
+	if err != nil {
+		return domain.Payment{}, err
+	}
+	return refunded, nil
+}
+
+// refundWithProvider sends the refund under its caller-chosen key. A provider
+// that replays an earlier refund under that key answers with the earlier
+// amount, which is how a reused id with a different amount is caught.
+func (s *RideService) refundWithProvider(ctx context.Context, payment domain.Payment, cmd RefundPaymentCmd) error {
+	result, err := s.Payments.Provider.Refund(ctx, outbound.RefundRequest{
+		IdempotencyKey: payment.ID + ":refund:" + cmd.RefundID,
+		ProviderRef:    payment.ProviderRef,
+		Amount:         cmd.Amount,
+	})
+	if err != nil {
+		return err
+	}
+	if result.Amount != cmd.Amount {
+		return domain.ErrRefundMismatch
+	}
+	return nil
+}
+
+func (s *RideService) GetPayment(ctx context.Context, rideID string) (domain.Payment, error) {
+	row, err := s.Repo.GetPaymentByRide(ctx, rideID)
+	if errors.Is(err, outbound.ErrNotFound) {
+		return domain.Payment{}, domain.ErrPaymentNotFound
+	}
+	if err != nil {
+		return domain.Payment{}, err
+	}
+	return toDomainPayment(row), nil
+}
+
+func paymentEvent(payment domain.Payment) *eventsv1.RidePaymentUpdated {
+	return &eventsv1.RidePaymentUpdated{
+		RideId:         payment.RideID,
+		RiderId:        payment.RiderID,
+		PaymentId:      payment.ID,
+		Status:         string(payment.Status),
+		Amount:         payment.Amount,
+		CapturedAmount: payment.CapturedAmount,
+		RefundedAmount: payment.RefundedAmount,
+		Currency:       payment.Currency,
+	}
+}
+
+func toDomainPayment(row outbound.Payment) domain.Payment {
+	return domain.Payment{
+		ID:             row.ID,
+		RideID:         row.RideID,
+		RiderID:        row.RiderID,
+		Amount:         row.Amount,
+		CaptureAmount:  row.CaptureAmount,
+		CapturedAmount: row.CapturedAmount,
+		RefundedAmount: row.RefundedAmount,
+		Currency:       row.Currency,
+		Status:         domain.PaymentStatus(row.Status),
+		ProviderRef:    row.ProviderRef,
+		Attempts:       row.Attempts,
+		LastError:      row.LastError,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	}
+}
+
+func toOutboundPayment(payment domain.Payment, provider string) outbound.Payment {
+	return outbound.Payment{
+		ID:             payment.ID,
+		RideID:         payment.RideID,
+		RiderID:        payment.RiderID,
+		Amount:         payment.Amount,
+		CaptureAmount:  payment.CaptureAmount,
+		CapturedAmount: payment.CapturedAmount,
+		RefundedAmount: payment.RefundedAmount,
+		Currency:       payment.Currency,
+		Status:         string(payment.Status),
+		Provider:       provider,
+		ProviderRef:    payment.ProviderRef,
+		Attempts:       payment.Attempts,
+		LastError:      payment.LastError,
+		CreatedAt:      payment.CreatedAt,
+		UpdatedAt:      payment.UpdatedAt,
+	}
+}
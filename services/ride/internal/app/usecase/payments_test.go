@@ -0,0 +1,272 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/adapters/payment"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+// failingCommitTx runs against the service's fake repositories and fails on
+// Commit, like a transaction that loses its connection at the last step.
+type failingCommitTx struct {
+	svc *RideService
+}
+
+func (tx failingCommitTx) Begin() (outbound.Tx, error)               { return tx, nil }
+func (tx failingCommitTx) Commit() error                             { return errors.New("commit failed") }
+func (tx failingCommitTx) Rollback() error                           { return nil }
+func (tx failingCommitTx) Savepoint(_ string, fn func() error) error { return fn() }
+func (tx failingCommitTx) RideRepo() outbound.RideRepo               { return tx.svc.Repo }
+func (tx failingCommitTx) IdempotencyRepo() outbound.IdempotencyRepo { return tx.svc.Idempotency }
+func (tx failingCommitTx) OutboxRepo() outbound.OutboxRepo           { return tx.svc.Outbox }
+func (tx failingCommitTx) RideOfferRepo() outbound.RideOfferRepo     { return tx.svc.Offers }
+
+func newPaymentService(t *testing.T) (*RideService, *fakeRideRepo, *payment.FakeProvider) {
+	t.Helper()
+	clock := &fixedClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	svc, repo := newPricedService(clock)
+	provider := payment.NewFakeProvider(0)
+	svc.Payments = &Payments{Provider: provider, Name: payment.FakeProviderName, MaxAttempts: 3}
+	return svc, repo, provider
+}
+
+func createPaidRide(t *testing.T, svc *RideService) domain.Ride {
+	t.Helper()
+	ride, err := svc.CreateRide(context.Background(), CreateRideCmd{RiderID: "r1", PickupLat: -6.2, PickupLng: 106.8, DropoffLat: -6.25, DropoffLng: 106.85})
+	if err != nil {
+		t.Fatalf("create error: %v", err)
+	}
+	return ride
+}
+
+func TestCompletedRideCapturesHold(t *testing.T) {
+	svc, repo, provider := newPaymentService(t)
+	outbox := svc.Outbox.(*fakeOutboxRepo)
+	ctx := context.Background()
+	ride := createPaidRide(t, svc)
+
+	held := repo.payments[ride.ID]
+	if held.Status != string(domain.PaymentAuthorized) || held.Amount != ride.FareAmount || held.ProviderRef == "" {
+		t.Fatalf("expected fare held at creation, got %+v", held)
+	}
+
+	stored := repo.store[ride.ID]
+	driverID := "driver-1"
+	stored.DriverID = &driverID
+	stored.Status = string(domain.StatusInProgress)
+	repo.store[ride.ID] = stored
+	if _, err := svc.CompleteRide(ctx, DriverRideCmd{RideID: ride.ID, DriverID: driverID}); err != nil {
+		t.Fatalf("complete error: %v", err)
+	}
+	if got := repo.payments[ride.ID].Status; got != string(domain.PaymentCapturePending) {
+		t.Fatalf("expected capture requested on completion, got %s", got)
+	}
+	if provider.Captured(held.ProviderRef) != 0 {
+		t.Fatalf("capture must wait for the payment worker")
+	}
+
+	settled, err := svc.SettlePayments(ctx, 10)
+	if err != nil || settled != 1 {
+		t.Fatalf("expected one settled payment, got %d / %v", settled, err)
+	}
+	captured := repo.payments[ride.ID]
+	if captured.Status != string(domain.PaymentCaptured) || captured.CapturedAmount != ride.FareAmount || provider.Captured(held.ProviderRef) != ride.FareAmount {
+		t.Fatalf("expected fare captured, got %+v", captured)
+	}
+	if topic := outbox.messages[len(outbox.messages)-1].Topic; topic != "ride.payment.captured" {
+		t.Fatalf("expected ride.payment.captured, got %s", topic)
+	}
+	if settled, _ := svc.SettlePayments(ctx, 10); settled != 0 {
+		t.Fatalf("expected nothing left to settle, got %d", settled)
+	}
+
+	refunded, err := svc.RefundPayment(ctx, RefundPaymentCmd{RideID: ride.ID, RefundID: "refund-1", Amount: 1000, Reason: "detour"})
+	if err != nil || refunded.Status != domain.PaymentRefunded || refunded.RefundedAmount != 1000 {
+		t.Fatalf("unexpected refund: %+v / %v", refunded, err)
+	}
+	if _, err := svc.RefundPayment(ctx, RefundPaymentCmd{RideID: ride.ID, RefundID: "refund-2", Amount: ride.FareAmount}); !errors.Is(err, domain.ErrInvalidRefund) {
+		t.Fatalf("expected refund above the captured amount rejected, got %v", err)
+	}
+}
+
+// paymentWriteFailingRepo fails to record the payment of one ride.
+type paymentWriteFailingRepo struct {
+	*fakeRideRepo
+	rideID string
+}
+
+func (r paymentWriteFailingRepo) UpdatePaymentIfCurrent(ctx context.Context, payment outbound.Payment, currentStatus string) error {
+	if payment.RideID == r.rideID {
+		return errors.New("connection reset")
+	}
+	return r.fakeRideRepo.UpdatePaymentIfCurrent(ctx, payment, currentStatus)
+}
+
+func TestSettlePaymentsRecordsEachPaymentOnItsOwn(t *testing.T) {
+	svc, repo, provider := newPaymentService(t)
+	ctx := context.Background()
+	var rides []domain.Ride
+	for range 2 {
+		ride := createPaidRide(t, svc)
+		if _, err := svc.CancelRide(ctx, CancelRideCmd{RideID: ride.ID, Actor: domain.ActorRider, ActorID: "r1"}); err != nil {
+			t.Fatalf("cancel error: %v", err)
+		}
+		rides = append(rides, ride)
+	}
+	failing, other := rides[0], rides[1]
+	svc.Repo = paymentWriteFailingRepo{fakeRideRepo: repo, rideID: failing.ID}
+
+	settled, err := svc.SettlePayments(ctx, 10)
+	if err == nil || settled != 1 {
+		t.Fatalf("expected one payment settled and the other reported, got %d / %v", settled, err)
+	}
+	if got := repo.payments[other.ID].Status; got != string(domain.PaymentVoided) {
+		t.Fatalf("expected the other payment recorded, got %s", got)
+	}
+	if got := repo.payments[failing.ID].Status; got != string(domain.PaymentVoidPending) {
+		t.Fatalf("expected the failed payment left pending, got %s", got)
+	}
+
+	svc.Repo = repo
+	if settled, err := svc.SettlePayments(ctx, 10); err != nil || settled != 1 {
+		t.Fatalf("expected the next run to record the replayed void, got %d / %v", settled, err)
+	}
+	if voided := repo.payments[failing.ID]; voided.Status != string(domain.PaymentVoided) || !provider.Voided(voided.ProviderRef) {
+		t.Fatalf("expected the hold voided, got %+v", voided)
+	}
+}
+
+func TestRefundIsKeyedByRefundID(t *testing.T) {
+	svc, repo, _ := newPaymentService(t)
+	ctx := context.Background()
+	ride := createPaidRide(t, svc)
+	stored := repo.store[ride.ID]
+	driverID := "driver-1"
+	stored.DriverID = &driverID
+	stored.Status = string(domain.StatusInProgress)
+	repo.store[ride.ID] = stored
+	if _, err := svc.CompleteRide(ctx, DriverRideCmd{RideID: ride.ID, DriverID: driverID}); err != nil {
+		t.Fatalf("complete error: %v", err)
+	}
+	if _, err := svc.SettlePayments(ctx, 10); err != nil {
+		t.Fatalf("settle error: %v", err)
+	}
+
+	if _, err := svc.RefundPayment(ctx, RefundPaymentCmd{RideID: ride.ID, Amount: 500}); !errors.Is(err, domain.ErrInvalidRefund) {
+		t.Fatalf("expected a refund without an id rejected, got %v", err)
+	}
+	cmd := RefundPaymentCmd{RideID: ride.ID, RefundID: "refund-1", Amount: 500, Reason: "detour"}
+	if _, err := svc.RefundPayment(ctx, cmd); err != nil {
+		t.Fatalf("refund error: %v", err)
+	}
+	retried, err := svc.RefundPayment(ctx, cmd)
+	if err != nil || retried.RefundedAmount != 500 {
+		t.Fatalf("expected the retry to replay the refund, got %+v / %v", retried, err)
+	}
+	cmd.Amount = 700
+	if _, err := svc.RefundPayment(ctx, cmd); !errors.Is(err, domain.ErrRefundMismatch) {
+		t.Fatalf("expected the refund id reused for another amount rejected, got %v", err)
+	}
+	refunds := 0
+	for _, posting := range repo.ledger {
+		if posting.Reference == domain.RefundReference(ride.ID, "refund-1") {
+			refunds++
+		}
+	}
+	if got := repo.payments[ride.ID].RefundedAmount; got != 500 || refunds != 1 {
+		t.Fatalf("expected one refund of 500 booked, got %d across %d postings", got, refunds)
+	}
+
+	if _, err := svc.RefundPayment(ctx, RefundPaymentCmd{RideID: ride.ID, RefundID: "refund-2", Amount: 500}); err != nil {
+		t.Fatalf("expected a second refund under a new id, got %v", err)
+	}
+	if got := repo.payments[ride.ID].RefundedAmount; got != 1000 {
+		t.Fatalf("expected both refunds booked, got %d", got)
+	}
+}
+
+func TestCancelledRideVoidsHold(t *testing.T) {
+	svc, repo, provider := newPaymentService(t)
+	ctx := context.Background()
+	ride := createPaidRide(t, svc)
+
+	if _, err := svc.CancelRide(ctx, CancelRideCmd{RideID: ride.ID, Actor: domain.ActorRider, ActorID: "r1"}); err != nil {
+		t.Fatalf("cancel error: %v", err)
+	}
+	if got := repo.payments[ride.ID].Status; got != string(domain.PaymentVoidPending) {
+		t.Fatalf("expected void requested on cancellation, got %s", got)
+	}
+	if _, err := svc.SettlePayments(ctx, 10); err != nil {
+		t.Fatalf("settle error: %v", err)
+	}
+	voided := repo.payments[ride.ID]
+	if voided.Status != string(domain.PaymentVoided) || !provider.Voided(voided.ProviderRef) {
+		t.Fatalf("expected hold voided, got %+v", voided)
+	}
+}
+
+func TestDeclinedAuthorizationRejectsRide(t *testing.T) {
+	svc, repo, provider := newPaymentService(t)
+	provider.DeclineAbove = 1
+
+	_, err := svc.CreateRide(context.Background(), CreateRideCmd{RiderID: "r1", PickupLat: -6.2, PickupLng: 106.8, DropoffLat: -6.25, DropoffLng: 106.85})
+	if !errors.Is(err, domain.ErrPaymentDeclined) {
+		t.Fatalf("expected payment declined, got %v", err)
+	}
+	if len(repo.store) != 0 || len(repo.payments) != 0 {
+		t.Fatalf("expected no ride or payment stored")
+	}
+}
+
+func TestFailedCreateCommitVoidsHold(t *testing.T) {
+	svc, _, provider := newPaymentService(t)
+	svc.TxManager = failingCommitTx{svc: svc}
+	svc.IDGen = func() string { return "ride-1" }
+	ctx := context.Background()
+
+	_, err := svc.CreateRide(ctx, CreateRideCmd{RiderID: "r1", PickupLat: -6.2, PickupLng: 106.8, DropoffLat: -6.25, DropoffLng: 106.85})
+	if err == nil {
+		t.Fatalf("expected the commit failure back")
+	}
+	// Authorize is idempotent on the ride ID, so this returns the same hold.
+	hold, err := provider.Authorize(ctx, outbound.AuthorizeRequest{IdempotencyKey: "ride-1"})
+	if err != nil || !provider.Voided(hold.ProviderRef) {
+		t.Fatalf("expected the hold voided after the failed commit, got %+v / %v", hold, err)
+	}
+}
+
+func TestReplayedCreateVoidsSpareHold(t *testing.T) {
+	svc, repo, provider := newPaymentService(t)
+	svc.Idempotency = newFakeIdempotencyRepo()
+	var ids []string
+	svc.IDGen = func() string {
+		ids = append(ids, "id-"+strconv.Itoa(len(ids)+1))
+		return ids[len(ids)-1]
+	}
+	ctx := context.Background()
+	cmd := CreateRideCmd{RiderID: "r1", PickupLat: -6.2, PickupLng: 106.8, DropoffLat: -6.25, DropoffLng: 106.85, IdempotencyKey: "k1"}
+
+	first, err := svc.CreateRide(ctx, cmd)
+	if err != nil {
+		t.Fatalf("create error: %v", err)
+	}
+	// The replay draws a fresh ride ID, and its hold is keyed by it.
+	spareID := "id-" + strconv.Itoa(len(ids)+1)
+	replay, err := svc.CreateRide(ctx, cmd)
+	if err != nil || replay.ID != first.ID {
+		t.Fatalf("expected the first ride replayed, got %s / %v", replay.ID, err)
+	}
+	if held := repo.payments[first.ID]; provider.Voided(held.ProviderRef) {
+		t.Fatalf("expected the stored ride's hold kept")
+	}
+	spare, err := provider.Authorize(ctx, outbound.AuthorizeRequest{IdempotencyKey: spareID})
+	if err != nil || !provider.Voided(spare.ProviderRef) {
+		t.Fatalf("expected the replay's hold voided, got %+v / %v", spare, err)
+	}
+}
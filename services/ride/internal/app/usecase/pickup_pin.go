@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math/big"
+
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+const reasonPickupPINLocked = "pickup_pin_locked"
+
+// PickupPINs issues the PIN a rider reads out to the driver at pickup and
+// checks it when the driver starts the ride. Only an HMAC of the PIN, keyed by
+// Secret, is stored, and the PIN only leaves the service sealed to NotifyKey.
+// A nil *PickupPINs turns the check off.
+type PickupPINs struct {
+	Secret    []byte
+	NotifyKey *ecdh.PublicKey
+	Policy    domain.PickupPINPolicy
+	// Rand is the source PINs are drawn from; nil uses crypto/rand.
+	Rand io.Reader
+}
+
+type OverridePickupPINCmd struct {
+	RideID         string
+	ActorID        string
+	Reason         string
+	IdempotencyKey string
+}
+
+func (p *PickupPINs) generate() (string, error) {
+	source := p.Rand
+	if source == nil {
+		source = rand.Reader
+	}
+	length := p.Policy.Length
+	if length <= 0 {
+		length = domain.DefaultPickupPINPolicy().Length
+	}
+	digits := make([]byte, length)
+	for i := range digits {
+		n, err := rand.Int(source, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		digits[i] = byte('0' + n.Int64())
+	}
+	return string(digits), nil
+}
+
+// hash binds the PIN to its ride, so equal PINs on different rides do not
+// share a hash.
+func (p *PickupPINs) hash(rideID string, pin string) string {
+	h := hmac.New(sha256.New, p.Secret)
+	h.Write([]byte(rideID + ":" + pin))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (p *PickupPINs) matches(rideID string, pin string, hash string) bool {
+	return hmac.Equal([]byte(p.hash(rideID, pin)), []byte(hash))
+}
+
+func (p *PickupPINs) maxAttempts() int {
+	if p.Policy.MaxAttempts <= 0 {
+		return domain.DefaultPickupPINPolicy().MaxAttempts
+	}
+	return p.Policy.MaxAttempts
+}
+
+// issuePickupPIN gives a newly assigned ride a fresh PIN, replacing one left
+// over from a driver who cancelled. ride.pin.issued carries it sealed to
+// notify's key, so the outbox row, the admin tools that read it and the
+// stream's other consumers never see the PIN; notify opens it for the rider.
+func (s *RideService) issuePickupPIN(ctx context.Context, repo outbound.RideRepo, outbox outbound.OutboxRepo, ride domain.Ride) error {
+	if s.PickupPINs == nil {
+		return nil
+	}
+	pin, err := s.PickupPINs.generate()
+	if err != nil {
+		return err
+	}
+	sealed, err := eventsv1.SealPIN(s.PickupPINs.NotifyKey, ride.ID, pin)
+	if err != nil {
+		return err
+	}
+	now := s.now()
+	if err := repo.SavePickupPIN(ctx, outbound.PickupPIN{
+		RideID:    ride.ID,
+		Hash:      s.PickupPINs.hash(ride.ID, pin),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}); err != nil {
+		return err
+	}
+	return s.enqueueEvent(ctx, outbox, eventsv1.TopicRidePinIssued, &eventsv1.RidePinIssued{
+		RideId:    ride.ID,
+		RiderId:   ride.RiderID,
+		SealedPin: sealed,
+	})
+}
+
+// checkPickupPIN verifies the PIN before the driver starts the ride. It runs
+// in its own transaction, ahead of StartRide's, so a wrong entry is counted
+// even though the start itself fails. Rides assigned before PINs were issued
+// have none and start without one.
+func (s *RideService) checkPickupPIN(ctx context.Context, cmd DriverRideCmd) error {
+	if s.PickupPINs == nil {
+		return nil
+	}
+	var result error
+	err := s.withTx(ctx, func(repo outbound.RideRepo, outbox outbound.OutboxRepo) error {
+		row, err := repo.Get(ctx, cmd.RideID)
+		if err != nil {
+			return err
+		}
+		ride := toDomainRide(row)
+		// Anything else is StartRide's to reject or to treat as a retry.
+		if !ride.AwaitingPickup() || !ride.IsAssignedTo(cmd.DriverID) {
+			return nil
+		}
+		pinRow, err := repo.GetPickupPIN(ctx, ride.ID)
+		if errors.Is(err, outbound.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		pin := toDomainPickupPIN(pinRow)
+		switch {
+		case !pin.Required():
+			return nil
+		case pin.Locked():
+			result = domain.ErrPickupPINLocked
+			return nil
+		case cmd.PIN == "":
+			result = domain.ErrPickupPINRequired
+			return nil
+		case s.PickupPINs.matches(ride.ID, cmd.PIN, pin.Hash):
+			return nil
+		}
+
+		now := s.now()
+		failed, err := repo.RecordPickupPINFailure(ctx, ride.ID, s.PickupPINs.maxAttempts(), now)
+		if errors.Is(err, outbound.ErrConflict) {
+			// Another entry locked it first and raised the alarm.
+			result = domain.ErrPickupPINLocked
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		result = domain.ErrInvalidPickupPIN
+		if failed.LockedAt == nil {
+			return nil
+		}
+		result = domain.ErrPickupPINLocked
+		if err := s.appendEvent(ctx, repo, ride.ID, ride.Status, ride.Status, statusChange{Actor: domain.ActorDriver, ActorID: cmd.DriverID, Reason: reasonPickupPINLocked}, now); err != nil {
+			return err
+		}
+		return s.enqueueEvent(ctx, outbox, eventsv1.TopicRidePinFailed, &eventsv1.RidePinFailed{
+			RideId:         ride.ID,
+			RiderId:        ride.RiderID,
+			DriverId:       cmd.DriverID,
+			FailedAttempts: int32(failed.FailedAttempts),
+		})
+	})
+	if err != nil {
+		return err
+	}
+	return result
+}
+
+// OverridePickupPIN lets support start a ride whose rider cannot give the PIN
+// or whose PIN has locked. The override is kept on the ride timeline.
+func (s *RideService) OverridePickupPIN(ctx context.Context, cmd OverridePickupPINCmd) (domain.Ride, error) {
+	reason := "pickup_pin_override"
+	if cmd.Reason != "" {
+		reason += ": " + cmd.Reason
+	}
+	return s.withIdempotency(ctx, idempotencyScope{Key: cmd.IdempotencyKey, Operation: "ride.pickup_pin_override", Caller: cmd.ActorID, Request: cmd}, func(repo outbound.RideRepo, outbox outbound.OutboxRepo) (domain.Ride, error) {
+		ride, err := s.loadRide(ctx, cmd.RideID, repo)
+		if err != nil {
+			return domain.Ride{}, err
+		}
+		if !ride.AwaitingPickup() {
+			return domain.Ride{}, domain.ErrInvalidTransition
+		}
+		now := s.now()
+		if err := repo.OverridePickupPIN(ctx, ride.ID, cmd.ActorID, now); err != nil {
+			if errors.Is(err, outbound.ErrNotFound) {
+				return domain.Ride{}, domain.ErrNoPickupPIN
+			}
+			return domain.Ride{}, err
+		}
+		if err := s.appendEvent(ctx, repo, ride.ID, ride.Status, ride.Status, statusChange{Actor: domain.ActorAdmin, ActorID: cmd.ActorID, Reason: reason}, now); err != nil {
+			return domain.Ride{}, err
+		}
+		return ride, nil
+	})
+}
+
+func toDomainPickupPIN(row outbound.PickupPIN) domain.PickupPIN {
+	return domain.PickupPIN{
+		RideID:         row.RideID,
+		Hash:           row.Hash,
+		FailedAttempts: row.FailedAttempts,
+		LockedAt:       derefTime(row.LockedAt),
+		OverriddenAt:   derefTime(row.OverriddenAt),
+		OverriddenBy:   derefString(row.OverriddenBy),
+	}
+}
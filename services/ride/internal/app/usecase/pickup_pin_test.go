@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+// acceptWithPickupPIN assigns driver-1 to ride-1 with PINs switched on and
+// returns the PIN sent to the rider, opened with notify's key.
+func acceptWithPickupPIN(t *testing.T) (*RideService, *fakeRideRepo, *fakeOutboxRepo, string) {
+	t.Helper()
+	notifyKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc, repo, _, offer := newOfferedRide(t, 10*time.Second)
+	svc.PickupPINs = &PickupPINs{Secret: []byte("test-secret"), NotifyKey: notifyKey.PublicKey(), Policy: domain.PickupPINPolicy{Length: 4, MaxAttempts: 3}}
+	outbox := svc.Outbox.(*fakeOutboxRepo)
+	if _, err := svc.AcceptOffer(context.Background(), OfferActionCmd{OfferID: offer.ID}); err != nil {
+		t.Fatalf("accept error: %v", err)
+	}
+	msg, ok := lastMessage(outbox, "ride.pin.issued")
+	if !ok {
+		t.Fatalf("expected ride.pin.issued")
+	}
+	var event eventsv1.RidePinIssued
+	if _, err := eventsv1.Unmarshal([]byte(msg.Payload), &event); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	if event.GetRideId() != "ride-1" || event.GetRiderId() != "r1" {
+		t.Fatalf("unexpected pin payload: %s", msg.Payload)
+	}
+	pin, err := eventsv1.OpenPIN(notifyKey, "ride-1", event.GetSealedPin())
+	if err != nil || len(pin) != 4 {
+		t.Fatalf("expected a 4-digit PIN sealed for notify, got %q (%v)", pin, err)
+	}
+	return svc, repo, outbox, pin
+}
+
+func TestPickupPINNeverStoredInOutbox(t *testing.T) {
+	_, repo, outbox, pin := acceptWithPickupPIN(t)
+
+	for _, msg := range outbox.messages {
+		if strings.Contains(msg.Payload, `"pin"`) {
+			t.Fatalf("expected no PIN field in the %s outbox payload, got %s", msg.Topic, msg.Payload)
+		}
+	}
+	msg, _ := lastMessage(outbox, "ride.pin.issued")
+	var envelope struct {
+		Data map[string]string `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(msg.Payload), &envelope); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	for field, value := range envelope.Data {
+		if value == pin {
+			t.Fatalf("expected the PIN only in sealed form, got %s=%q", field, value)
+		}
+	}
+	if stored := repo.pins["ride-1"].Hash; stored == "" || stored == pin {
+		t.Fatalf("expected only a hash of the PIN stored, got %q", stored)
+	}
+}
+
+func TestStartRideRequiresPickupPIN(t *testing.T) {
+	svc, repo, _, pin := acceptWithPickupPIN(t)
+	ctx := context.Background()
+
+	stored := repo.pins["ride-1"]
+	if stored.Hash == "" || strings.Contains(stored.Hash, pin) {
+		t.Fatalf("expected only a hash of the pin to be stored, got %q", stored.Hash)
+	}
+
+	if _, err := svc.StartRide(ctx, DriverRideCmd{RideID: "ride-1", DriverID: "driver-1"}); !errors.Is(err, domain.ErrPickupPINRequired) {
+		t.Fatalf("expected pin required, got %v", err)
+	}
+	if got := repo.pins["ride-1"].FailedAttempts; got != 0 {
+		t.Fatalf("expected a missing pin not to count as an attempt, got %d", got)
+	}
+	if _, err := svc.StartRide(ctx, DriverRideCmd{RideID: "ride-1", DriverID: "driver-1", PIN: wrongPIN(pin)}); !errors.Is(err, domain.ErrInvalidPickupPIN) {
+		t.Fatalf("expected invalid pin, got %v", err)
+	}
+	if got := repo.pins["ride-1"].FailedAttempts; got != 1 {
+		t.Fatalf("expected one failed attempt, got %d", got)
+	}
+
+	ride, err := svc.StartRide(ctx, DriverRideCmd{RideID: "ride-1", DriverID: "driver-1", PIN: pin})
+	if err != nil {
+		t.Fatalf("start ride error: %v", err)
+	}
+	if ride.Status != domain.StatusInProgress {
+		t.Fatalf("expected in progress, got %s", ride.Status)
+	}
+}
+
+func TestPickupPINLocksAfterMaxAttempts(t *testing.T) {
+	svc, repo, outbox, pin := acceptWithPickupPIN(t)
+	ctx := context.Background()
+
+	for i := 1; i <= 3; i++ {
+		_, err := svc.StartRide(ctx, DriverRideCmd{RideID: "ride-1", DriverID: "driver-1", PIN: wrongPIN(pin)})
+		want := domain.ErrInvalidPickupPIN
+		if i == 3 {
+			want = domain.ErrPickupPINLocked
+		}
+		if !errors.Is(err, want) {
+			t.Fatalf("attempt %d: expected %v, got %v", i, want, err)
+		}
+	}
+	if _, err := svc.StartRide(ctx, DriverRideCmd{RideID: "ride-1", DriverID: "driver-1", PIN: pin}); !errors.Is(err, domain.ErrPickupPINLocked) {
+		t.Fatalf("expected a locked pin to refuse even the right pin, got %v", err)
+	}
+	if repo.store["ride-1"].Status != string(domain.StatusDriverAssigned) {
+		t.Fatalf("expected ride still awaiting pickup, got %s", repo.store["ride-1"].Status)
+	}
+
+	var failed int
+	for _, msg := range outbox.messages {
+		if msg.Topic == "ride.pin.failed" {
+			failed++
+			if !strings.Contains(msg.Payload, `"failed_attempts":3`) || !strings.Contains(msg.Payload, `"driver_id":"driver-1"`) {
+				t.Fatalf("unexpected ride.pin.failed payload: %s", msg.Payload)
+			}
+		}
+	}
+	if failed != 1 {
+		t.Fatalf("expected one ride.pin.failed, got %d", failed)
+	}
+	events, _ := svc.GetRideTimeline(ctx, "ride-1")
+	if got := events[len(events)-1]; got.Reason != reasonPickupPINLocked || got.ToStatus != domain.StatusDriverAssigned {
+		t.Fatalf("expected lock on the timeline, got %+v", got)
+	}
+}
+
+func TestOverridePickupPIN(t *testing.T) {
+	svc, _, _, pin := acceptWithPickupPIN(t)
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		_, _ = svc.StartRide(ctx, DriverRideCmd{RideID: "ride-1", DriverID: "driver-1", PIN: wrongPIN(pin)})
+	}
+
+	if _, err := svc.OverridePickupPIN(ctx, OverridePickupPINCmd{RideID: "ride-1", ActorID: "agent-1", Reason: "rider phone dead"}); err != nil {
+		t.Fatalf("override error: %v", err)
+	}
+	events, _ := svc.GetRideTimeline(ctx, "ride-1")
+	got := events[len(events)-1]
+	if got.Actor != domain.ActorAdmin || got.ActorID != "agent-1" || got.Reason != "pickup_pin_override: rider phone dead" {
+		t.Fatalf("expected override on the timeline, got %+v", got)
+	}
+
+	ride, err := svc.StartRide(ctx, DriverRideCmd{RideID: "ride-1", DriverID: "driver-1"})
+	if err != nil {
+		t.Fatalf("start ride after override error: %v", err)
+	}
+	if ride.Status != domain.StatusInProgress {
+		t.Fatalf("expected in progress, got %s", ride.Status)
+	}
+
+	if _, err := svc.OverridePickupPIN(ctx, OverridePickupPINCmd{RideID: "ride-1", ActorID: "agent-1"}); !errors.Is(err, domain.ErrInvalidTransition) {
+		t.Fatalf("expected override of a started ride to fail, got %v", err)
+	}
+}
+
+func wrongPIN(pin string) string {
+	if pin == "0000" {
+		return "1111"
+	}
+	return "0000"
+}
+
+func lastMessage(outbox *fakeOutboxRepo, topic string) (outbound.OutboxMessage, bool) {
+	for i := len(outbox.messages) - 1; i >= 0; i-- {
+		if outbox.messages[i].Topic == topic {
+			return outbox.messages[i], true
+		}
+	}
+	return outbound.OutboxMessage{}, false
+}
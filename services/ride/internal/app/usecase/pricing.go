@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	matchingv1 "github.com/daffahilmyf/ride-hailing/proto/matching/v1"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+var ErrPricingDisabled = errors.New("pricing not configured")
+
+const defaultAvgSpeedKmh = 24
+
+type Pricing struct {
+	Products       map[string]domain.FareRule
+	DefaultProduct string
+	Currency       string
+	AvgSpeedKmh    float64
+	QuoteTTL       time.Duration
+	Signer         QuoteSigner
+	Surge          outbound.SurgeService
+}
+
+type QuoteFareCmd struct {
+	RiderID    string
+	Product    string
+	PickupLat  float64
+	PickupLng  float64
+	DropoffLat float64
+	DropoffLng float64
+	Stops      []domain.Point
+}
+
+type SignedQuote struct {
+	Token string
+	Quote domain.Quote
+	Legs  []domain.Leg
+}
+
+// estimate prices a route from pickup through every stop to dropoff; distance
+// and time are summed over the legs.
+func (p *Pricing) estimate(ctx context.Context, product string, route []domain.Point) (domain.Fare, []domain.Leg, error) {
+	if product == "" {
+		product = p.DefaultProduct
+	}
+	rule, ok := p.Products[product]
+	if !ok {
+		return domain.Fare{}, nil, domain.ErrUnknownProduct
+	}
+	legs := p.legs(route)
+	var distance, seconds int64
+	for _, leg := range legs {
+		distance += leg.DistanceMeters
+		seconds += leg.DurationSeconds
+	}
+	pickup := route[0]
+	fare := rule.Estimate(float64(distance), time.Duration(seconds)*time.Second).WithSurge(p.surgeAt(ctx, pickup.Lat, pickup.Lng))
+	fare.Product = product
+	fare.Currency = p.Currency
+	return fare, legs, nil
+}
+
+func (p *Pricing) legs(route []domain.Point) []domain.Leg {
+	speed := float64(defaultAvgSpeedKmh)
+	if p != nil && p.AvgSpeedKmh > 0 {
+		speed = p.AvgSpeedKmh
+	}
+	return domain.RouteLegs(route, speed)
+}
+
+// surgeAt fails open: if matching cannot be reached the trip is priced
+// without surge rather than blocking quotes and bookings.
+func (p *Pricing) surgeAt(ctx context.Context, lat, lng float64) float64 {
+	if p.Surge == nil {
+		return 1
+	}
+	resp, err := p.Surge.GetSurge(ctx, &matchingv1.GetSurgeRequest{Lat: lat, Lng: lng})
+	if err != nil {
+		return 1
+	}
+	return resp.GetMultiplier()
+}
+
+func (s *RideService) QuoteFare(ctx context.Context, cmd QuoteFareCmd) (SignedQuote, error) {
+	if s.Pricing == nil {
+		return SignedQuote{}, ErrPricingDisabled
+	}
+	if len(cmd.Stops) > domain.MaxStops {
+		return SignedQuote{}, domain.ErrTooManyStops
+	}
+	route := domain.TripRoute(domain.Point{Lat: cmd.PickupLat, Lng: cmd.PickupLng}, cmd.Stops, domain.Point{Lat: cmd.DropoffLat, Lng: cmd.DropoffLng})
+	fare, legs, err := s.Pricing.estimate(ctx, cmd.Product, route)
+	if err != nil {
+		return SignedQuote{}, err
+	}
+	ttl := s.Pricing.QuoteTTL
+	if ttl <= 0 {
+		ttl = 2 * time.Minute
+	}
+	quote := domain.Quote{
+		ID:         s.newID(),
+		RiderID:    cmd.RiderID,
+		PickupLat:  cmd.PickupLat,
+		PickupLng:  cmd.PickupLng,
+		DropoffLat: cmd.DropoffLat,
+		DropoffLng: cmd.DropoffLng,
+		Stops:      cmd.Stops,
+		Fare:       fare,
+		ExpiresAt:  s.now().Add(ttl).Truncate(time.Second),
+	}
+	token, err := s.Pricing.Signer.Sign(quote)
+	if err != nil {
+		return SignedQuote{}, err
+	}
+	return SignedQuote{Token: token, Quote: quote, Legs: legs}, nil
+}
+
+// priceRide resolves the fare for a new ride: a quote token locks the amount
+// the rider was shown, otherwise the trip is priced at request time.
+func (s *RideService) priceRide(ctx context.Context, cmd CreateRideCmd) (domain.Fare, string, error) {
+	if s.Pricing == nil {
+		return domain.Fare{}, "", nil
+	}
+	if cmd.QuoteID == "" {
+		route := domain.TripRoute(domain.Point{Lat: cmd.PickupLat, Lng: cmd.PickupLng}, cmd.Stops, domain.Point{Lat: cmd.DropoffLat, Lng: cmd.DropoffLng})
+		fare, _, err := s.Pricing.estimate(ctx, cmd.Product, route)
+		return fare, "", err
+	}
+	quote, err := s.Pricing.Signer.Verify(cmd.QuoteID)
+	if err != nil {
+		return domain.Fare{}, "", err
+	}
+	if !quote.Covers(cmd.RiderID, cmd.PickupLat, cmd.PickupLng, cmd.DropoffLat, cmd.DropoffLng, cmd.Stops) {
+		return domain.Fare{}, "", domain.ErrInvalidQuote
+	}
+	if cmd.Product != "" && cmd.Product != quote.Fare.Product {
+		return domain.Fare{}, "", domain.ErrInvalidQuote
+	}
+	if !s.now().Before(quote.ExpiresAt) {
+		return domain.Fare{}, "", domain.ErrQuoteExpired
+	}
+	return quote.Fare, quote.ID, nil
+}
@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	matchingv1 "github.com/daffahilmyf/ride-hailing/proto/matching/v1"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"google.golang.org/grpc"
+)
+
+type fakeSurge struct {
+	multiplier float64
+	err        error
+}
+
+func (f fakeSurge) GetSurge(ctx context.Context, in *matchingv1.GetSurgeRequest, opts ...grpc.CallOption) (*matchingv1.GetSurgeResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &matchingv1.GetSurgeResponse{Multiplier: f.multiplier}, nil
+}
+
+func newPricedService(clock *fixedClock) (*RideService, *fakeRideRepo) {
+	repo := newFakeRideRepo()
+	svc := &RideService{
+		Repo:         repo,
+		Outbox:       &fakeOutboxRepo{},
+		OfferMetrics: &OfferMetrics{},
+		Clock:        clock,
+		Pricing: &Pricing{
+			Products: map[string]domain.FareRule{
+				"standard": {BaseFare: 5000, PerKm: 2500, PerMinute: 300, MinimumFare: 10000, BookingFee: 2000},
+			},
+			DefaultProduct: "standard",
+			Currency:       "IDR",
+			AvgSpeedKmh:    24,
+			QuoteTTL:       2 * time.Minute,
+			Signer:         QuoteSigner{Secret: []byte("test-secret")},
+		},
+	}
+	return svc, repo
+}
+
+func TestCreateRideWithQuoteLocksFare(t *testing.T) {
+	clock := &fixedClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	svc, repo := newPricedService(clock)
+
+	signed, err := svc.QuoteFare(context.Background(), QuoteFareCmd{RiderID: "r1", PickupLat: -6.2, PickupLng: 106.8, DropoffLat: -6.25, DropoffLng: 106.85})
+	if err != nil {
+		t.Fatalf("quote error: %v", err)
+	}
+	if signed.Quote.Fare.Total <= 0 {
+		t.Fatalf("expected positive fare")
+	}
+
+	// Tariff changes after the quote must not affect the booked amount.
+	svc.Pricing.Products["standard"] = domain.FareRule{BaseFare: 99999}
+	clock.now = clock.now.Add(time.Minute)
+
+	ride, err := svc.CreateRide(context.Background(), CreateRideCmd{RiderID: "r1", PickupLat: -6.2, PickupLng: 106.8, DropoffLat: -6.25, DropoffLng: 106.85, QuoteID: signed.Token})
+	if err != nil {
+		t.Fatalf("create error: %v", err)
+	}
+	stored := repo.store[ride.ID]
+	if stored.FareAmount != signed.Quote.Fare.Total {
+		t.Fatalf("expected fare %d, got %d", signed.Quote.Fare.Total, stored.FareAmount)
+	}
+	if stored.QuoteID == nil || *stored.QuoteID != signed.Quote.ID {
+		t.Fatalf("expected quote id persisted")
+	}
+}
+
+func TestCreateRideRejectsBadQuotes(t *testing.T) {
+	clock := &fixedClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	svc, _ := newPricedService(clock)
+
+	signed, err := svc.QuoteFare(context.Background(), QuoteFareCmd{RiderID: "r1", PickupLat: 1, PickupLng: 2, DropoffLat: 3, DropoffLng: 4})
+	if err != nil {
+		t.Fatalf("quote error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		cmd     CreateRideCmd
+		advance time.Duration
+		wantErr error
+	}{
+		{"tampered", CreateRideCmd{RiderID: "r1", PickupLat: 1, PickupLng: 2, DropoffLat: 3, DropoffLng: 4, QuoteID: signed.Token + "x"}, 0, domain.ErrInvalidQuote},
+		{"other_rider", CreateRideCmd{RiderID: "r2", PickupLat: 1, PickupLng: 2, DropoffLat: 3, DropoffLng: 4, QuoteID: signed.Token}, 0, domain.ErrInvalidQuote},
+		{"other_trip", CreateRideCmd{RiderID: "r1", PickupLat: 1, PickupLng: 2, DropoffLat: 5, DropoffLng: 6, QuoteID: signed.Token}, 0, domain.ErrInvalidQuote},
+		{"expired", CreateRideCmd{RiderID: "r1", PickupLat: 1, PickupLng: 2, DropoffLat: 3, DropoffLng: 4, QuoteID: signed.Token}, 3 * time.Minute, domain.ErrQuoteExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock.now = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC).Add(tt.advance)
+			if _, err := svc.CreateRide(context.Background(), tt.cmd); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestQuoteFareUnknownProduct(t *testing.T) {
+	svc, _ := newPricedService(&fixedClock{now: time.Now().UTC()})
+	if _, err := svc.QuoteFare(context.Background(), QuoteFareCmd{RiderID: "r1", Product: "helicopter"}); !errors.Is(err, domain.ErrUnknownProduct) {
+		t.Fatalf("expected unknown product, got %v", err)
+	}
+}
+
+func TestQuoteFareAppliesSurge(t *testing.T) {
+	svc, _ := newPricedService(&fixedClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)})
+	cmd := QuoteFareCmd{RiderID: "r1", PickupLat: -6.2, PickupLng: 106.8, DropoffLat: -6.25, DropoffLng: 106.85}
+
+	plain, err := svc.QuoteFare(context.Background(), cmd)
+	if err != nil {
+		t.Fatalf("quote error: %v", err)
+	}
+
+	svc.Pricing.Surge = fakeSurge{multiplier: 2}
+	surged, err := svc.QuoteFare(context.Background(), cmd)
+	if err != nil {
+		t.Fatalf("quote error: %v", err)
+	}
+	fee := plain.Quote.Fare.BookingFee
+	if surged.Quote.Fare.Total-fee != 2*(plain.Quote.Fare.Total-fee) {
+		t.Fatalf("expected trip portion doubled, got %d from %d", surged.Quote.Fare.Total, plain.Quote.Fare.Total)
+	}
+	if surged.Quote.Fare.SurgeMultiplier != 2 {
+		t.Fatalf("expected multiplier recorded on fare")
+	}
+
+	svc.Pricing.Surge = fakeSurge{err: errors.New("matching down")}
+	fallback, err := svc.QuoteFare(context.Background(), cmd)
+	if err != nil {
+		t.Fatalf("expected surge failure to fail open, got %v", err)
+	}
+	if fallback.Quote.Fare.Total != plain.Quote.Fare.Total {
+		t.Fatalf("expected unsurged fare, got %d", fallback.Quote.Fare.Total)
+	}
+}
+
+func TestQuoteFarePricesEveryLeg(t *testing.T) {
+	clock := &fixedClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	svc, repo := newPricedService(clock)
+	base := QuoteFareCmd{RiderID: "r1", PickupLat: -6.2, PickupLng: 106.8, DropoffLat: -6.2, DropoffLng: 106.9}
+
+	direct, err := svc.QuoteFare(context.Background(), base)
+	if err != nil {
+		t.Fatalf("quote error: %v", err)
+	}
+	detour := base
+	detour.Stops = []domain.Point{{Lat: -6.25, Lng: 106.85}}
+	multi, err := svc.QuoteFare(context.Background(), detour)
+	if err != nil {
+		t.Fatalf("quote error: %v", err)
+	}
+	if len(multi.Legs) != 2 || multi.Quote.Fare.DistanceMeters != multi.Legs[0].DistanceMeters+multi.Legs[1].DistanceMeters {
+		t.Fatalf("expected fare distance to be the sum of two legs, got %+v", multi.Legs)
+	}
+	if multi.Quote.Fare.Total <= direct.Quote.Fare.Total {
+		t.Fatalf("expected detour to cost more: %d <= %d", multi.Quote.Fare.Total, direct.Quote.Fare.Total)
+	}
+
+	// A quote only covers the exact stop list it was priced for.
+	cmd := CreateRideCmd{RiderID: "r1", PickupLat: base.PickupLat, PickupLng: base.PickupLng, DropoffLat: base.DropoffLat, DropoffLng: base.DropoffLng, QuoteID: multi.Token}
+	if _, err := svc.CreateRide(context.Background(), cmd); !errors.Is(err, domain.ErrInvalidQuote) {
+		t.Fatalf("expected quote without stops to be rejected, got %v", err)
+	}
+	cmd.Stops = detour.Stops
+	ride, err := svc.CreateRide(context.Background(), cmd)
+	if err != nil {
+		t.Fatalf("create error: %v", err)
+	}
+	if got := repo.store[ride.ID]; got.FareAmount != multi.Quote.Fare.Total || len(got.Stops) != 1 {
+		t.Fatalf("expected multi-stop fare %d with one stop, got %d / %d stops", multi.Quote.Fare.Total, got.FareAmount, len(got.Stops))
+	}
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+type RateRideCmd struct {
+	RideID    string
+	RaterID   string
+	RaterRole domain.Actor
+	Stars     int
+	Tags      []string
+	Comment   string
+}
+
+// RateRide stores one side's rating of a completed ride and emits ride.rated so
+// the user service can fold it into the ratee's profile. The comment stays in
+// the ride service; only the stars and tags travel on the event.
+func (s *RideService) RateRide(ctx context.Context, cmd RateRideCmd) (domain.Rating, error) {
+	var rating domain.Rating
+	err := s.withTx(ctx, func(repo outbound.RideRepo, outbox outbound.OutboxRepo) error {
+		ride, err := s.loadRide(ctx, cmd.RideID, repo)
+		if err != nil {
+			return err
+		}
+		rating, err = s.Ratings.NewRating(ride, cmd.RaterRole, cmd.RaterID, cmd.Stars, cmd.Tags, cmd.Comment, s.now())
+		if err != nil {
+			return err
+		}
+		if err := repo.AddRating(ctx, outbound.RideRating{
+			RideID:    rating.RideID,
+			RaterRole: string(rating.RaterRole),
+			RaterID:   rating.RaterID,
+			RateeID:   rating.RateeID,
+			Stars:     rating.Stars,
+			Tags:      rating.Tags,
+			Comment:   rating.Comment,
+			CreatedAt: rating.CreatedAt,
+		}); err != nil {
+			if errors.Is(err, outbound.ErrConflict) {
+				return domain.ErrAlreadyRated
+			}
+			return err
+		}
+		tags := rating.Tags
+		return s.enqueueEvent(ctx, outbox, eventsv1.TopicRideRated, &eventsv1.RideRated{
+			RideId:    ride.ID,
+			RiderId:   ride.RiderID,
+			DriverId:  derefString(ride.DriverID),
+			RaterRole: string(rating.RaterRole),
+			RaterId:   rating.RaterID,
+			RateeRole: string(rating.RateeRole()),
+			RateeId:   rating.RateeID,
+			Stars:     int32(rating.Stars),
+			Tags:      tags,
+			CreatedAt: rating.CreatedAt.Unix(),
+		})
+	})
+	if err != nil {
+		return domain.Rating{}, err
+	}
+	return rating, nil
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+)
+
+func TestRateRideOncePerSide(t *testing.T) {
+	svc, repo, clock, offer := newOfferedRide(t, 10*time.Second)
+	svc.Ratings = domain.DefaultRatingPolicy()
+	outbox := svc.Outbox.(*fakeOutboxRepo)
+	ctx := context.Background()
+	if _, err := svc.AcceptOffer(ctx, OfferActionCmd{OfferID: offer.ID}); err != nil {
+		t.Fatalf("accept error: %v", err)
+	}
+	rate := RateRideCmd{RideID: "ride-1", RaterID: "r1", RaterRole: domain.ActorRider, Stars: 4, Tags: []string{"friendly"}, Comment: "smooth trip"}
+	if _, err := svc.RateRide(ctx, rate); !errors.Is(err, domain.ErrRatingNotPermitted) {
+		t.Fatalf("expected rating before completion to be refused, got %v", err)
+	}
+	if _, err := svc.StartRide(ctx, DriverRideCmd{RideID: "ride-1", DriverID: "driver-1"}); err != nil {
+		t.Fatalf("start error: %v", err)
+	}
+	if _, err := svc.CompleteRide(ctx, DriverRideCmd{RideID: "ride-1", DriverID: "driver-1"}); err != nil {
+		t.Fatalf("complete error: %v", err)
+	}
+	if repo.store["ride-1"].CompletedAt == nil {
+		t.Fatalf("expected completed_at to be stored")
+	}
+
+	rating, err := svc.RateRide(ctx, rate)
+	if err != nil {
+		t.Fatalf("rate error: %v", err)
+	}
+	if rating.RateeID != "driver-1" || rating.Stars != 4 {
+		t.Fatalf("unexpected rating: %+v", rating)
+	}
+	if _, err := svc.RateRide(ctx, rate); !errors.Is(err, domain.ErrAlreadyRated) {
+		t.Fatalf("expected second rider rating to be refused, got %v", err)
+	}
+
+	msg := outbox.messages[len(outbox.messages)-1]
+	if msg.Topic != "ride.rated" {
+		t.Fatalf("expected ride.rated, got %s", msg.Topic)
+	}
+	var envelope struct {
+		Data map[string]any `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(msg.Payload), &envelope); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	if envelope.Data["ratee_id"] != "driver-1" || envelope.Data["ratee_role"] != "driver" || envelope.Data["stars"] != float64(4) {
+		t.Fatalf("unexpected ride.rated payload: %v", envelope.Data)
+	}
+	if _, ok := envelope.Data["comment"]; ok {
+		t.Fatalf("comment must not be published")
+	}
+
+	clock.now = clock.now.Add(svc.Ratings.Window + time.Minute)
+	if _, err := svc.RateRide(ctx, RateRideCmd{RideID: "ride-1", RaterID: "driver-1", RaterRole: domain.ActorDriver, Stars: 5}); !errors.Is(err, domain.ErrRatingWindowClosed) {
+		t.Fatalf("expected window closed, got %v", err)
+	}
+}
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+const (
+	defaultRidePageSize = 20
+	maxRidePageSize     = 100
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+type ListRidesQuery struct {
+	RiderID     string
+	DriverID    string
+	Status      string
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	PageSize    int
+	Cursor      string
+}
+
+type RidePage struct {
+	Rides      []domain.Ride
+	NextCursor string
+}
+
+func (s *RideService) GetRide(ctx context.Context, rideID string) (domain.Ride, error) {
+	row, err := s.Repo.Get(ctx, rideID)
+	if err != nil {
+		return domain.Ride{}, err
+	}
+	return toDomainRide(row), nil
+}
+
+// GetRideTimeline returns the ride's status transitions, oldest first.
+func (s *RideService) GetRideTimeline(ctx context.Context, rideID string) ([]domain.RideEvent, error) {
+	if _, err := s.Repo.Get(ctx, rideID); err != nil {
+		return nil, err
+	}
+	rows, err := s.Repo.ListEvents(ctx, rideID)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]domain.RideEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, domain.RideEvent{
+			ID:         row.ID,
+			RideID:     row.RideID,
+			FromStatus: domain.RideStatus(row.FromStatus),
+			ToStatus:   domain.RideStatus(row.ToStatus),
+			Actor:      domain.Actor(row.Actor),
+			ActorID:    derefString(row.ActorID),
+			Reason:     row.Reason,
+			CreatedAt:  row.CreatedAt,
+		})
+	}
+	return events, nil
+}
+
+func (s *RideService) ListRides(ctx context.Context, query ListRidesQuery) (RidePage, error) {
+	limit := query.PageSize
+	if limit <= 0 {
+		limit = defaultRidePageSize
+	}
+	if limit > maxRidePageSize {
+		limit = maxRidePageSize
+	}
+
+	filter := outbound.RideFilter{
+		RiderID:     query.RiderID,
+		DriverID:    query.DriverID,
+		Status:      query.Status,
+		CreatedFrom: query.CreatedFrom,
+		CreatedTo:   query.CreatedTo,
+		Limit:       limit + 1,
+	}
+	if query.Cursor != "" {
+		cursor, err := decodeRideCursor(query.Cursor)
+		if err != nil {
+			return RidePage{}, err
+		}
+		filter.After = &cursor
+	}
+
+	rows, err := s.Repo.List(ctx, filter)
+	if err != nil {
+		return RidePage{}, err
+	}
+
+	page := RidePage{}
+	if len(rows) > limit {
+		rows = rows[:limit]
+		last := rows[len(rows)-1]
+		page.NextCursor = encodeRideCursor(outbound.RideCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+	page.Rides = make([]domain.Ride, 0, len(rows))
+	for _, row := range rows {
+		page.Rides = append(page.Rides, toDomainRide(row))
+	}
+	return page, nil
+}
+
+func encodeRideCursor(cursor outbound.RideCursor) string {
+	raw := strconv.FormatInt(cursor.CreatedAt.UnixNano(), 10) + ":" + cursor.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeRideCursor(value string) (outbound.RideCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return outbound.RideCursor{}, ErrInvalidCursor
+	}
+	nanos, id, ok := strings.Cut(string(raw), ":")
+	if !ok || id == "" {
+		return outbound.RideCursor{}, ErrInvalidCursor
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return outbound.RideCursor{}, ErrInvalidCursor
+	}
+	return outbound.RideCursor{CreatedAt: time.Unix(0, n).UTC(), ID: id}, nil
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+func TestListRidesPaginates(t *testing.T) {
+	repo := newFakeRideRepo()
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		id := fmt.Sprintf("ride-%d", i)
+		repo.store[id] = outbound.Ride{ID: id, RiderID: "r1", Status: "COMPLETED", CreatedAt: base.Add(time.Duration(i) * time.Minute)}
+	}
+	// Same timestamp as ride-4 to exercise the id tie-breaker.
+	repo.store["ride-5"] = outbound.Ride{ID: "ride-5", RiderID: "r1", Status: "COMPLETED", CreatedAt: base.Add(4 * time.Minute)}
+	repo.store["other"] = outbound.Ride{ID: "other", RiderID: "r2", Status: "COMPLETED", CreatedAt: base}
+	svc := &RideService{Repo: repo}
+
+	var got []string
+	cursor := ""
+	for pages := 0; pages < 10; pages++ {
+		page, err := svc.ListRides(context.Background(), ListRidesQuery{RiderID: "r1", PageSize: 4, Cursor: cursor})
+		if err != nil {
+			t.Fatalf("list error: %v", err)
+		}
+		for _, ride := range page.Rides {
+			got = append(got, ride.ID)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+
+	want := []string{"ride-5", "ride-4", "ride-3", "ride-2", "ride-1", "ride-0"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestListRidesInvalidCursor(t *testing.T) {
+	svc := &RideService{Repo: newFakeRideRepo()}
+	if _, err := svc.ListRides(context.Background(), ListRidesQuery{Cursor: "not-a-cursor"}); !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("expected invalid cursor, got %v", err)
+	}
+}
+
+func TestGetRideTimelineRecordsEveryTransition(t *testing.T) {
+	repo := newFakeRideRepo()
+	svc := &RideService{Repo: repo, Offers: &fakeOfferRepo{}, Outbox: &fakeOutboxRepo{}, OfferMetrics: &OfferMetrics{}}
+	ctx := context.Background()
+
+	ride, err := svc.CreateRide(ctx, CreateRideCmd{RiderID: "r1", PickupLat: 1, PickupLng: 2, DropoffLat: 3, DropoffLng: 4})
+	if err != nil {
+		t.Fatalf("create error: %v", err)
+	}
+	if _, err := svc.StartMatching(ctx, ride.ID, ""); err != nil {
+		t.Fatalf("matching error: %v", err)
+	}
+	offer, err := svc.CreateOffer(ctx, StartMatchingCmd{RideID: ride.ID, DriverID: "d1", OfferTTL: time.Minute})
+	if err != nil {
+		t.Fatalf("offer error: %v", err)
+	}
+	if _, err := svc.AcceptOffer(ctx, OfferActionCmd{OfferID: offer.ID}); err != nil {
+		t.Fatalf("accept error: %v", err)
+	}
+	if _, err := svc.CancelRide(ctx, CancelRideCmd{RideID: ride.ID, Reason: "changed_mind", Actor: domain.ActorRider, ActorID: "r1"}); err != nil {
+		t.Fatalf("cancel error: %v", err)
+	}
+
+	events, err := svc.GetRideTimeline(ctx, ride.ID)
+	if err != nil {
+		t.Fatalf("timeline error: %v", err)
+	}
+	want := []struct {
+		from, to domain.RideStatus
+		actor    domain.Actor
+		actorID  string
+		reason   string
+	}{
+		{"", domain.StatusRequested, domain.ActorRider, "r1", ""},
+		{domain.StatusRequested, domain.StatusMatching, domain.ActorMatching, "", ""},
+		{domain.StatusMatching, domain.StatusOffered, domain.ActorMatching, "", "offer_sent"},
+		{domain.StatusOffered, domain.StatusDriverAssigned, domain.ActorDriver, "d1", "offer_accepted"},
+		{domain.StatusDriverAssigned, domain.StatusCancelled, domain.ActorRider, "r1", "changed_mind"},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("expected %d events, got %d", len(want), len(events))
+	}
+	for i, w := range want {
+		got := events[i]
+		if got.FromStatus != w.from || got.ToStatus != w.to || got.Actor != w.actor || got.ActorID != w.actorID || got.Reason != w.reason {
+			t.Fatalf("event %d: expected %+v, got %+v", i, w, got)
+		}
+	}
+}
+
+func TestGetRideTimelineUnknownRide(t *testing.T) {
+	svc := &RideService{Repo: newFakeRideRepo()}
+	if _, err := svc.GetRideTimeline(context.Background(), "missing"); !errors.Is(err, outbound.ErrNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
@@ -2,26 +2,40 @@ package usecase
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
 
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
 	userv1 "github.com/daffahilmyf/ride-hailing/proto/user/v1"
 	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
 	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
 )
 
 type RideService struct {
-	Repo         outbound.RideRepo
-	Idempotency  outbound.IdempotencyRepo
-	TxManager    outbound.TxManager
-	Outbox       outbound.OutboxRepo
-	Offers       outbound.RideOfferRepo
-	UserClient   outbound.UserService
-	OfferMetrics *OfferMetrics
-	Clock        Clock
-	IDGen        IDGenerator
+	Repo              outbound.RideRepo
+	Idempotency       outbound.IdempotencyRepo
+	TxManager         outbound.TxManager
+	Outbox            outbound.OutboxRepo
+	Offers            outbound.RideOfferRepo
+	UserClient        outbound.UserService
+	OfferMetrics      *OfferMetrics
+	Pricing           *Pricing
+	Scheduling        domain.SchedulePolicy
+	Cancellation      domain.CancellationPolicy
+	CancelSigner      QuoteSigner
+	Ratings           domain.RatingPolicy
+	Payments          *Payments
+	Commission        domain.CommissionPolicy
+	PickupPINs        *PickupPINs
+	Arrival           domain.ArrivalPolicy
+	StuckRides        domain.StuckRidePolicy
+	IdempotencyPolicy IdempotencyPolicy
+	Clock             Clock
+	IDGen             IDGenerator
 }
 
 type CreateRideCmd struct {
@@ -30,6 +44,10 @@ type CreateRideCmd struct {
 	PickupLng      float64
 	DropoffLat     float64
 	DropoffLng     float64
+	Product        string
+	QuoteID        string
+	PickupAt       time.Time
+	Stops          []domain.Point
 	IdempotencyKey string
 }
 
@@ -40,22 +58,93 @@ type StartMatchingCmd struct {
 	IdempotencyKey string
 }
 
+type CancelRideCmd struct {
+	RideID         string
+	Reason         string
+	ReasonCode     domain.CancelReason
+	Actor          domain.Actor
+	ActorID        string
+	ConfirmToken   string
+	IdempotencyKey string
+	// ExpectedVersion, when set, is the version the caller last read; the
+	// cancel fails with a version conflict if the ride has moved on since.
+	ExpectedVersion int64
+}
+
+// DriverRideCmd is a trip step taken by the assigned driver.
+type DriverRideCmd struct {
+	RideID          string
+	DriverID        string
+	IdempotencyKey  string
+	ExpectedVersion int64
+	// PIN is the pickup PIN the rider read out; only starting a ride needs it.
+	PIN string
+}
+
+// statusChange describes who moved a ride and why, for the ride timeline.
+type statusChange struct {
+	Actor   domain.Actor
+	ActorID string
+	Reason  string
+}
+
 type OfferActionCmd struct {
 	OfferID        string
 	IdempotencyKey string
 }
 
 func (s *RideService) CreateRide(ctx context.Context, cmd CreateRideCmd) (domain.Ride, error) {
-	return s.withIdempotency(ctx, cmd.IdempotencyKey, func(repo outbound.RideRepo, idem outbound.IdempotencyRepo, outbox outbound.OutboxRepo) (domain.Ride, error) {
-		now := s.now()
-		ride := domain.Ride{
-			ID:         s.newID(),
-			RiderID:    cmd.RiderID,
-			Status:     domain.StatusRequested,
-			PickupLat:  cmd.PickupLat,
-			PickupLng:  cmd.PickupLng,
-			DropoffLat: cmd.DropoffLat,
-			DropoffLng: cmd.DropoffLng,
+	stops, err := domain.NewStops(cmd.Stops)
+	if err != nil {
+		return domain.Ride{}, err
+	}
+	if !cmd.PickupAt.IsZero() {
+		if err := s.Scheduling.Validate(cmd.PickupAt, s.now()); err != nil {
+			return domain.Ride{}, err
+		}
+	}
+	// Priced before the transaction opens since surge is a call to matching.
+	fare, quoteID, err := s.priceRide(ctx, cmd)
+	if err != nil {
+		return domain.Ride{}, err
+	}
+	now := s.now()
+	ride := domain.Ride{
+		ID:         s.newID(),
+		RiderID:    cmd.RiderID,
+		Status:     domain.StatusRequested,
+		PickupLat:  cmd.PickupLat,
+		PickupLng:  cmd.PickupLng,
+		DropoffLat: cmd.DropoffLat,
+		DropoffLng: cmd.DropoffLng,
+		Product:    fare.Product,
+		FareAmount: fare.Total,
+		Currency:   fare.Currency,
+		QuoteID:    quoteID,
+		PickupAt:   cmd.PickupAt.UTC(),
+		Stops:      stops,
+		Version:    1,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if ride.IsScheduled() {
+		ride.Status = domain.StatusScheduled
+	} else {
+		ride.PickupAt = time.Time{}
+	}
+	// The card is held before the transaction opens so no provider call sits
+	// inside it. Whenever the ride does not end up stored under this ID, the
+	// hold is voided again: on any error, a failed commit included, and when
+	// the idempotency key replays an earlier ride.
+	hold, err := s.authorizeRide(ctx, ride)
+	if err != nil {
+		return domain.Ride{}, err
+	}
+	created, err := s.withIdempotency(ctx, idempotencyScope{Key: cmd.IdempotencyKey, Operation: "ride.create", Caller: cmd.RiderID, Request: cmd}, func(repo outbound.RideRepo, outbox outbound.OutboxRepo) (domain.Ride, error) {
+		if !ride.IsScheduled() {
+			if err := activeRide(ctx, repo.GetActiveByRider, ride.RiderID, domain.ErrRiderHasActiveRide); err != nil {
+				return domain.Ride{}, err
+			}
 		}
 
 		err := repo.Create(ctx, outbound.Ride{
@@ -67,78 +156,203 @@ func (s *RideService) CreateRide(ctx context.Context, cmd CreateRideCmd) (domain
 			PickupLng:  ride.PickupLng,
 			DropoffLat: ride.DropoffLat,
 			DropoffLng: ride.DropoffLng,
+			Product:    ride.Product,
+			FareAmount: ride.FareAmount,
+			Currency:   ride.Currency,
+			QuoteID:    optionalString(ride.QuoteID),
+			PickupAt:   optionalTime(ride.PickupAt),
+			Stops:      toOutboundStops(ride.Stops),
+			Version:    ride.Version,
 			CreatedAt:  now,
 			UpdatedAt:  now,
 		})
 		if err != nil {
 			return domain.Ride{}, err
 		}
-		if err := s.enqueueEvent(ctx, outbox, "ride.requested", map[string]any{
-			"ride_id":     ride.ID,
-			"rider_id":    ride.RiderID,
-			"pickup_lat":  ride.PickupLat,
-			"pickup_lng":  ride.PickupLng,
-			"dropoff_lat": ride.DropoffLat,
-			"dropoff_lng": ride.DropoffLng,
-		}); err != nil {
+		if err := s.appendEvent(ctx, repo, ride.ID, "", ride.Status, statusChange{Actor: domain.ActorRider, ActorID: ride.RiderID}, now); err != nil {
+			return domain.Ride{}, err
+		}
+		if err := s.savePayment(ctx, repo, hold); err != nil {
+			return domain.Ride{}, err
+		}
+		if ride.IsScheduled() {
+			// Matching only hears about a scheduled ride once the scheduler
+			// dispatches it; until then riders and notify see ride.scheduled.
+			if err := s.enqueueEvent(ctx, outbox, eventsv1.TopicRideScheduled, &eventsv1.RideScheduled{
+				RideId:     ride.ID,
+				RiderId:    ride.RiderID,
+				PickupAt:   ride.PickupAt.Unix(),
+				PickupLat:  ride.PickupLat,
+				PickupLng:  ride.PickupLng,
+				DropoffLat: ride.DropoffLat,
+				DropoffLng: ride.DropoffLng,
+				Stops:      eventPoints(ride.StopPoints()),
+				Product:    ride.Product,
+				FareAmount: ride.FareAmount,
+				Currency:   ride.Currency,
+			}); err != nil {
+				return domain.Ride{}, err
+			}
+			return ride, nil
+		}
+		if err := s.enqueueEvent(ctx, outbox, eventsv1.TopicRideRequested, rideRequestedEvent(ride, fare.SurgeMultiplier)); err != nil {
 			return domain.Ride{}, err
 		}
 		return ride, nil
 	})
+	if err != nil || created.ID != ride.ID {
+		s.releaseHold(ctx, hold)
+	}
+	return created, s.resolveActiveRide(ctx, err, cmd.RiderID, "")
 }
 
-func (s *RideService) CancelRide(ctx context.Context, id string, reason string, idempotencyKey string) (domain.Ride, error) {
-	return s.withIdempotency(ctx, idempotencyKey, func(repo outbound.RideRepo, _ outbound.IdempotencyRepo, outbox outbound.OutboxRepo) (domain.Ride, error) {
-		ride, err := s.loadRide(ctx, id, repo)
+func (s *RideService) CancelRide(ctx context.Context, cmd CancelRideCmd) (domain.Ride, error) {
+	actor := cmd.Actor
+	if actor == "" {
+		actor = domain.ActorSystem
+	}
+	code := cmd.ReasonCode
+	if code == "" {
+		code = domain.CancelOther
+	}
+	reason := cmd.Reason
+	if reason == "" {
+		reason = string(code)
+	}
+	return s.withIdempotency(ctx, idempotencyScope{Key: cmd.IdempotencyKey, Operation: "ride.cancel", Caller: cmd.ActorID, Request: cmd}, func(repo outbound.RideRepo, outbox outbound.OutboxRepo) (domain.Ride, error) {
+		ride, err := s.loadRide(ctx, cmd.RideID, repo)
 		if err != nil {
 			return domain.Ride{}, err
 		}
+		if actor == domain.ActorRider && !ride.IsRequestedBy(cmd.ActorID) {
+			return domain.Ride{}, domain.ErrRiderMismatch
+		}
+		if ride.Status == domain.StatusCancelled {
+			return ride, nil
+		}
+		if err := checkVersion(ride, cmd.ExpectedVersion); err != nil {
+			return domain.Ride{}, err
+		}
 
 		updated, err := ride.Transition(domain.StatusCancelled)
 		if err != nil {
 			return domain.Ride{}, err
 		}
-		if err := repo.UpdateStatusIfCurrent(ctx, updated.ID, string(ride.Status), string(updated.Status), s.now()); err != nil {
+		now := s.now()
+		decision := s.Cancellation.Decide(ride, actor, now)
+		if decision.Fee > 0 {
+			if err := s.confirmCancellationFee(ride, decision.Fee, cmd.ConfirmToken, now); err != nil {
+				return domain.Ride{}, err
+			}
+		}
+		updated.CancelReason = code
+		updated.CancellationFee = decision.Fee
+		updated.UpdatedAt = now
+
+		if err := repo.CancelIfCurrent(ctx, ride.ID, ride.Version, string(ride.Status), outbound.RideCancellation{Reason: string(code), Fee: decision.Fee}, now); err != nil {
+			return domain.Ride{}, err
+		}
+		if err := s.appendEvent(ctx, repo, ride.ID, ride.Status, updated.Status, statusChange{Actor: actor, ActorID: cmd.ActorID, Reason: reason}, now); err != nil {
+			return domain.Ride{}, err
+		}
+		// The hold is released, or only the cancellation fee is taken from it.
+		if err := s.requestCapture(ctx, repo, ride.ID, decision.Fee); err != nil {
 			return domain.Ride{}, err
 		}
-		if err := s.enqueueEvent(ctx, outbox, "ride.cancelled", map[string]string{
-			"ride_id":  updated.ID,
-			"reason":   reason,
-			"status":   string(updated.Status),
-			"rider_id": updated.RiderID,
+		if decision.Strike {
+			userID := ride.RiderID
+			if actor == domain.ActorDriver {
+				userID = derefString(ride.DriverID)
+			}
+			if err := s.recordStrike(ctx, repo, outbox, ride, actor, userID, code, now); err != nil {
+				return domain.Ride{}, err
+			}
+		}
+		if err := s.enqueueEvent(ctx, outbox, eventsv1.TopicRideCancelled, &eventsv1.RideCancelled{
+			RideId:     updated.ID,
+			Reason:     reason,
+			ReasonCode: string(code),
+			Actor:      string(actor),
+			Status:     string(updated.Status),
+			RiderId:    updated.RiderID,
+			FeeAmount:  updated.CancellationFee,
+			Currency:   updated.Currency,
 		}); err != nil {
 			return domain.Ride{}, err
 		}
-		_ = reason
 		return updated, nil
 	})
 }
 
 func (s *RideService) StartMatching(ctx context.Context, rideID string, idempotencyKey string) (domain.Ride, error) {
-	return s.withIdempotency(ctx, idempotencyKey, func(repo outbound.RideRepo, _ outbound.IdempotencyRepo, outbox outbound.OutboxRepo) (domain.Ride, error) {
+	return s.withIdempotency(ctx, idempotencyScope{Key: idempotencyKey, Operation: "ride.start_matching", Request: rideID}, func(repo outbound.RideRepo, outbox outbound.OutboxRepo) (domain.Ride, error) {
 		ride, err := s.loadRide(ctx, rideID, repo)
 		if err != nil {
 			return domain.Ride{}, err
 		}
-		updated, err := ride.Transition(domain.StatusMatching)
-		if err != nil {
-			return domain.Ride{}, err
-		}
-		if err := repo.UpdateStatusIfCurrent(ctx, updated.ID, string(ride.Status), string(updated.Status), s.now()); err != nil {
-			return domain.Ride{}, err
+		if ride.Status == domain.StatusMatching {
+			return ride, nil
 		}
-		if err := s.enqueueEvent(ctx, outbox, "ride.matching.started", map[string]string{
-			"ride_id": updated.ID,
-			"status":  string(updated.Status),
-		}); err != nil {
+		return s.startMatching(ctx, repo, outbox, ride, statusChange{Actor: domain.ActorMatching})
+	})
+}
+
+// startMatching moves a requested or scheduled ride into matching. A scheduled
+// ride has not been announced to matching yet, so it also gets its
+// ride.requested event here.
+func (s *RideService) startMatching(ctx context.Context, repo outbound.RideRepo, outbox outbound.OutboxRepo, ride domain.Ride, change statusChange) (domain.Ride, error) {
+	updated, err := ride.Transition(domain.StatusMatching)
+	if err != nil {
+		return domain.Ride{}, err
+	}
+	if err := s.updateStatus(ctx, repo, ride, updated, change); err != nil {
+		return domain.Ride{}, err
+	}
+	if err := s.enqueueEvent(ctx, outbox, eventsv1.TopicRideMatchingStarted, &eventsv1.RideStatusChanged{
+		RideId: updated.ID,
+		Status: string(updated.Status),
+	}); err != nil {
+		return domain.Ride{}, err
+	}
+	if ride.Status == domain.StatusScheduled {
+		if err := s.enqueueEvent(ctx, outbox, eventsv1.TopicRideRequested, rideRequestedEvent(updated, 1)); err != nil {
 			return domain.Ride{}, err
 		}
-		return updated, nil
-	})
+	}
+	return updated, nil
+}
+
+func rideRequestedEvent(ride domain.Ride, surgeMultiplier float64) *eventsv1.RideRequested {
+	event := &eventsv1.RideRequested{
+		RideId:          ride.ID,
+		RiderId:         ride.RiderID,
+		PickupLat:       ride.PickupLat,
+		PickupLng:       ride.PickupLng,
+		DropoffLat:      ride.DropoffLat,
+		DropoffLng:      ride.DropoffLng,
+		Stops:           eventPoints(ride.StopPoints()),
+		Product:         ride.Product,
+		FareAmount:      ride.FareAmount,
+		Currency:        ride.Currency,
+		SurgeMultiplier: surgeMultiplier,
+	}
+	if ride.IsScheduled() {
+		pickupAt := ride.PickupAt.Unix()
+		event.PickupAt = &pickupAt
+	}
+	return event
+}
+
+func eventPoints(points []domain.Point) []*eventsv1.Point {
+	out := make([]*eventsv1.Point, 0, len(points))
+	for _, p := range points {
+		out = append(out, &eventsv1.Point{Lat: p.Lat, Lng: p.Lng})
+	}
+	return out
 }
 
 func (s *RideService) AssignDriver(ctx context.Context, rideID, driverID string, idempotencyKey string) (domain.Ride, error) {
-	return s.withIdempotency(ctx, idempotencyKey, func(repo outbound.RideRepo, _ outbound.IdempotencyRepo, outbox outbound.OutboxRepo) (domain.Ride, error) {
+	assigned, err := s.withIdempotency(ctx, idempotencyScope{Key: idempotencyKey, Operation: "ride.assign_driver", Caller: driverID, Request: rideID}, func(repo outbound.RideRepo, outbox outbound.OutboxRepo) (domain.Ride, error) {
 		ride, err := s.loadRide(ctx, rideID, repo)
 		if err != nil {
 			return domain.Ride{}, err
@@ -150,36 +364,56 @@ func (s *RideService) AssignDriver(ctx context.Context, rideID, driverID string,
 		}
 		next.DriverID = &driverID
 
-		if err := repo.AssignDriverIfCurrent(ctx, next.ID, driverID, string(ride.Status), string(next.Status), s.now()); err != nil {
+		if err := s.assignDriver(ctx, repo, ride, next, statusChange{Actor: domain.ActorSystem}); err != nil {
 			return domain.Ride{}, err
 		}
-		if err := s.enqueueEvent(ctx, outbox, "ride.driver.assigned", map[string]string{
-			"ride_id":   next.ID,
-			"driver_id": driverID,
-			"status":    string(next.Status),
+		if err := s.enqueueEvent(ctx, outbox, eventsv1.TopicRideDriverAssigned, &eventsv1.RideStatusChanged{
+			RideId:   next.ID,
+			DriverId: &driverID,
+			Status:   string(next.Status),
 		}); err != nil {
 			return domain.Ride{}, err
 		}
+		if err := s.issuePickupPIN(ctx, repo, outbox, next); err != nil {
+			return domain.Ride{}, err
+		}
 		return next, nil
 	})
+	return assigned, s.resolveActiveRide(ctx, err, "", driverID)
 }
 
-func (s *RideService) StartRide(ctx context.Context, rideID string, idempotencyKey string) (domain.Ride, error) {
-	return s.withIdempotency(ctx, idempotencyKey, func(repo outbound.RideRepo, _ outbound.IdempotencyRepo, outbox outbound.OutboxRepo) (domain.Ride, error) {
-		ride, err := s.loadRide(ctx, rideID, repo)
+// StartRide moves the ride into progress once the driver has entered the
+// rider's pickup PIN.
+func (s *RideService) StartRide(ctx context.Context, cmd DriverRideCmd) (domain.Ride, error) {
+	if err := s.checkPickupPIN(ctx, cmd); err != nil {
+		return domain.Ride{}, err
+	}
+	return s.withIdempotency(ctx, idempotencyScope{Key: cmd.IdempotencyKey, Operation: "ride.start", Caller: cmd.DriverID, Request: cmd.RideID}, func(repo outbound.RideRepo, outbox outbound.OutboxRepo) (domain.Ride, error) {
+		ride, err := s.loadRide(ctx, cmd.RideID, repo)
 		if err != nil {
 			return domain.Ride{}, err
 		}
+		if !ride.IsAssignedTo(cmd.DriverID) {
+			return domain.Ride{}, domain.ErrDriverMismatch
+		}
+		if ride.Status == domain.StatusInProgress {
+			return ride, nil
+		}
+		if err := checkVersion(ride, cmd.ExpectedVersion); err != nil {
+			return domain.Ride{}, err
+		}
 		updated, err := ride.Transition(domain.StatusInProgress)
 		if err != nil {
 			return domain.Ride{}, err
 		}
-		if err := repo.UpdateStatusIfCurrent(ctx, updated.ID, string(ride.Status), string(updated.Status), s.now()); err != nil {
+		if err := s.updateStatus(ctx, repo, ride, updated, statusChange{Actor: domain.ActorDriver, ActorID: cmd.DriverID}); err != nil {
 			return domain.Ride{}, err
 		}
-		if err := s.enqueueEvent(ctx, outbox, "ride.in_progress", map[string]string{
-			"ride_id": updated.ID,
-			"status":  string(updated.Status),
+		if err := s.enqueueEvent(ctx, outbox, eventsv1.TopicRideInProgress, &eventsv1.RideStatusChanged{
+			RideId:   updated.ID,
+			DriverId: &cmd.DriverID,
+			RiderId:  &updated.RiderID,
+			Status:   string(updated.Status),
 		}); err != nil {
 			return domain.Ride{}, err
 		}
@@ -187,22 +421,41 @@ func (s *RideService) StartRide(ctx context.Context, rideID string, idempotencyK
 	})
 }
 
-func (s *RideService) CompleteRide(ctx context.Context, rideID string, idempotencyKey string) (domain.Ride, error) {
-	return s.withIdempotency(ctx, idempotencyKey, func(repo outbound.RideRepo, _ outbound.IdempotencyRepo, outbox outbound.OutboxRepo) (domain.Ride, error) {
-		ride, err := s.loadRide(ctx, rideID, repo)
+func (s *RideService) CompleteRide(ctx context.Context, cmd DriverRideCmd) (domain.Ride, error) {
+	return s.withIdempotency(ctx, idempotencyScope{Key: cmd.IdempotencyKey, Operation: "ride.complete", Caller: cmd.DriverID, Request: cmd.RideID}, func(repo outbound.RideRepo, outbox outbound.OutboxRepo) (domain.Ride, error) {
+		ride, err := s.loadRide(ctx, cmd.RideID, repo)
 		if err != nil {
 			return domain.Ride{}, err
 		}
+		if !ride.IsAssignedTo(cmd.DriverID) {
+			return domain.Ride{}, domain.ErrDriverMismatch
+		}
+		if ride.Status == domain.StatusCompleted {
+			return ride, nil
+		}
+		if err := checkVersion(ride, cmd.ExpectedVersion); err != nil {
+			return domain.Ride{}, err
+		}
 		updated, err := ride.Transition(domain.StatusCompleted)
 		if err != nil {
 			return domain.Ride{}, err
 		}
-		if err := repo.UpdateStatusIfCurrent(ctx, updated.ID, string(ride.Status), string(updated.Status), s.now()); err != nil {
+		now := s.now()
+		if err := repo.CompleteIfCurrent(ctx, updated.ID, ride.Version, string(ride.Status), now); err != nil {
+			return domain.Ride{}, err
+		}
+		if err := s.appendEvent(ctx, repo, updated.ID, ride.Status, updated.Status, statusChange{Actor: domain.ActorDriver, ActorID: cmd.DriverID}, now); err != nil {
+			return domain.Ride{}, err
+		}
+		updated.CompletedAt = now
+		if err := s.requestCapture(ctx, repo, updated.ID, updated.FareAmount); err != nil {
 			return domain.Ride{}, err
 		}
-		if err := s.enqueueEvent(ctx, outbox, "ride.completed", map[string]string{
-			"ride_id": updated.ID,
-			"status":  string(updated.Status),
+		if err := s.enqueueEvent(ctx, outbox, eventsv1.TopicRideCompleted, &eventsv1.RideStatusChanged{
+			RideId:   updated.ID,
+			DriverId: &cmd.DriverID,
+			RiderId:  &updated.RiderID,
+			Status:   string(updated.Status),
 		}); err != nil {
 			return domain.Ride{}, err
 		}
@@ -211,7 +464,22 @@ func (s *RideService) CompleteRide(ctx context.Context, rideID string, idempoten
 }
 
 func (s *RideService) CreateOffer(ctx context.Context, cmd StartMatchingCmd) (domain.RideOffer, error) {
-	return s.withIdempotencyOffer(ctx, cmd.IdempotencyKey, func(offers outbound.RideOfferRepo, idem outbound.IdempotencyRepo, outbox outbound.OutboxRepo) (domain.RideOffer, error) {
+	return s.withIdempotencyOffer(ctx, idempotencyScope{Key: cmd.IdempotencyKey, Operation: "offer.create", Request: cmd}, func(offers outbound.RideOfferRepo, rides outbound.RideRepo, outbox outbound.OutboxRepo) (domain.RideOffer, error) {
+		ride, err := s.loadRide(ctx, cmd.RideID, rides)
+		if err != nil {
+			return domain.RideOffer{}, err
+		}
+		if ride.Status != domain.StatusMatching {
+			return domain.RideOffer{}, domain.ErrInvalidTransition
+		}
+		offered, err := ride.Transition(domain.StatusOffered)
+		if err != nil {
+			return domain.RideOffer{}, err
+		}
+		if err := ensureNotExcluded(ctx, rides, ride.ID, cmd.DriverID); err != nil {
+			return domain.RideOffer{}, err
+		}
+
 		ttl := cmd.OfferTTL
 		if ttl <= 0 {
 			ttl = 15 * time.Second
@@ -227,12 +495,15 @@ func (s *RideService) CreateOffer(ctx context.Context, cmd StartMatchingCmd) (do
 		}); err != nil {
 			return domain.RideOffer{}, err
 		}
+		if err := s.updateStatus(ctx, rides, ride, offered, statusChange{Actor: domain.ActorMatching, Reason: "offer_sent"}); err != nil {
+			return domain.RideOffer{}, err
+		}
 		s.OfferMetrics.IncCreated()
-		if err := s.enqueueEvent(ctx, outbox, "ride.offer.sent", map[string]string{
-			"ride_id":   offer.RideID,
-			"driver_id": offer.DriverID,
-			"offer_id":  offer.ID,
-			"status":    string(offer.Status),
+		if err := s.enqueueEvent(ctx, outbox, eventsv1.TopicRideOfferSent, &eventsv1.RideOfferUpdated{
+			RideId:   offer.RideID,
+			DriverId: offer.DriverID,
+			OfferId:  offer.ID,
+			Status:   string(offer.Status),
 		}); err != nil {
 			return domain.RideOffer{}, err
 		}
@@ -241,7 +512,13 @@ func (s *RideService) CreateOffer(ctx context.Context, cmd StartMatchingCmd) (do
 }
 
 func (s *RideService) AcceptOffer(ctx context.Context, cmd OfferActionCmd) (domain.RideOffer, error) {
-	return s.updateOffer(ctx, cmd, domain.OfferAccepted, "ride.offer.accepted")
+	offer, err := s.updateOffer(ctx, cmd, domain.OfferAccepted, "ride.offer.accepted")
+	if errors.Is(err, domain.ErrDriverHasActiveRide) {
+		if row, getErr := s.Offers.Get(ctx, cmd.OfferID); getErr == nil {
+			err = s.resolveActiveRide(ctx, err, "", row.DriverID)
+		}
+	}
+	return offer, err
 }
 
 func (s *RideService) DeclineOffer(ctx context.Context, cmd OfferActionCmd) (domain.RideOffer, error) {
@@ -262,132 +539,146 @@ func (s *RideService) loadRide(ctx context.Context, id string, repo outbound.Rid
 		_, _ = s.UserClient.GetUserProfile(ctx, &userv1.GetUserProfileRequest{UserId: rideRow.RiderID})
 	}
 
-	return domain.Ride{
-		ID:         rideRow.ID,
-		RiderID:    rideRow.RiderID,
-		DriverID:   rideRow.DriverID,
-		Status:     domain.RideStatus(rideRow.Status),
-		PickupLat:  rideRow.PickupLat,
-		PickupLng:  rideRow.PickupLng,
-		DropoffLat: rideRow.DropoffLat,
-		DropoffLng: rideRow.DropoffLng,
-	}, nil
-}
-
-func (s *RideService) withIdempotency(ctx context.Context, key string, fn func(repo outbound.RideRepo, idem outbound.IdempotencyRepo, outbox outbound.OutboxRepo) (domain.Ride, error)) (domain.Ride, error) {
-	if key != "" && s.Idempotency != nil {
-		if val, ok, err := s.Idempotency.Get(ctx, key); err == nil && ok {
-			var ride domain.Ride
-			if err := json.Unmarshal([]byte(val), &ride); err == nil {
-				return ride, nil
-			}
-		}
-	}
+	return toDomainRide(rideRow), nil
+}
 
-	repo := s.Repo
-	idem := s.Idempotency
-	outbox := s.Outbox
-	var tx outbound.Tx
-	if s.TxManager != nil {
-		var err error
-		tx, err = s.TxManager.Begin()
-		if err != nil {
-			return domain.Ride{}, err
-		}
-		repo = tx.RideRepo()
-		idem = tx.IdempotencyRepo()
-		outbox = tx.OutboxRepo()
-		defer func() {
-			if tx != nil {
-				_ = tx.Rollback()
-			}
-		}()
+// checkVersion rejects a change the caller made against a version of the ride
+// it no longer holds; zero means the caller did not ask for the check.
+func checkVersion(ride domain.Ride, expected int64) error {
+	if expected == 0 || expected == ride.Version {
+		return nil
 	}
+	return &outbound.VersionConflictError{RideID: ride.ID, Current: ride.Version}
+}
 
-	ride, err := fn(repo, idem, outbox)
-	if err != nil {
-		return domain.Ride{}, err
+func toDomainRide(row outbound.Ride) domain.Ride {
+	return domain.Ride{
+		ID:              row.ID,
+		RiderID:         row.RiderID,
+		DriverID:        row.DriverID,
+		Status:          domain.RideStatus(row.Status),
+		PickupLat:       row.PickupLat,
+		PickupLng:       row.PickupLng,
+		DropoffLat:      row.DropoffLat,
+		DropoffLng:      row.DropoffLng,
+		Product:         row.Product,
+		FareAmount:      row.FareAmount,
+		Currency:        row.Currency,
+		QuoteID:         derefString(row.QuoteID),
+		PickupAt:        derefTime(row.PickupAt),
+		Stops:           toDomainStops(row.Stops),
+		AssignedAt:      derefTime(row.AssignedAt),
+		ArrivedAt:       derefTime(row.ArrivedAt),
+		CompletedAt:     derefTime(row.CompletedAt),
+		CancellationFee: row.CancellationFee,
+		CancelReason:    domain.CancelReason(derefString(row.CancelReason)),
+		Redispatches:    row.Redispatches,
+		Version:         row.Version,
+		CreatedAt:       row.CreatedAt,
+		UpdatedAt:       row.UpdatedAt,
 	}
+}
 
-	if key != "" && idem != nil {
-		if b, err := json.Marshal(ride); err == nil {
-			_ = idem.Save(ctx, key, string(b))
-		}
+func toDomainOffer(row outbound.RideOffer) domain.RideOffer {
+	return domain.RideOffer{
+		ID:        row.ID,
+		RideID:    row.RideID,
+		DriverID:  row.DriverID,
+		Status:    domain.RideOfferStatus(row.Status),
+		ExpiresAt: time.Unix(row.ExpiresAt, 0).UTC(),
+		CreatedAt: time.Unix(row.CreatedAt, 0).UTC(),
 	}
+}
 
-	if tx != nil {
-		if err := tx.Commit(); err != nil {
-			return domain.Ride{}, err
-		}
+// enqueueEvent writes event to the outbox under its topic's contract, so a
+// message that does not belong on topic fails here rather than in a consumer.
+func (s *RideService) enqueueEvent(ctx context.Context, outbox outbound.OutboxRepo, topic string, event proto.Message) error {
+	if outbox == nil {
+		return nil
 	}
-	return ride, nil
+	traceID := getStringFromContext(ctx, "trace_id")
+	requestID := getStringFromContext(ctx, "request_id")
+	payload, err := eventsv1.Marshal(topic, "ride-service", traceID, requestID, event, s.now(), s.newID())
+	if err != nil {
+		return err
+	}
+	return outbox.Enqueue(ctx, outbound.OutboxMessage{
+		ID:          s.newID(),
+		Topic:       topic,
+		Payload:     string(payload),
+		AggregateID: eventAggregate(event),
+	})
 }
 
-func (s *RideService) withIdempotencyOffer(ctx context.Context, key string, fn func(offers outbound.RideOfferRepo, idem outbound.IdempotencyRepo, outbox outbound.OutboxRepo) (domain.RideOffer, error)) (domain.RideOffer, error) {
-	if key != "" && s.Idempotency != nil {
-		if val, ok, err := s.Idempotency.Get(ctx, key); err == nil && ok {
-			var offer domain.RideOffer
-			if err := json.Unmarshal([]byte(val), &offer); err == nil {
-				return offer, nil
-			}
-		}
+// eventAggregate keys ride events on their ride so the outbox publishes them
+// in order. Events about no ride, such as payouts, are unordered.
+func eventAggregate(event proto.Message) string {
+	if e, ok := event.(interface{ GetRideId() string }); ok {
+		return e.GetRideId()
 	}
+	return ""
+}
 
-	offers := s.Offers
-	idem := s.Idempotency
-	outbox := s.Outbox
-	var tx outbound.Tx
-	if s.TxManager != nil {
-		var err error
-		tx, err = s.TxManager.Begin()
-		if err != nil {
-			return domain.RideOffer{}, err
-		}
-		offers = tx.RideOfferRepo()
-		idem = tx.IdempotencyRepo()
-		outbox = tx.OutboxRepo()
-		defer func() {
-			if tx != nil {
-				_ = tx.Rollback()
-			}
-		}()
+func toDomainStops(rows []outbound.RideStop) []domain.Stop {
+	if len(rows) == 0 {
+		return nil
+	}
+	stops := make([]domain.Stop, 0, len(rows))
+	for _, row := range rows {
+		stops = append(stops, domain.Stop{
+			Seq:        row.Seq,
+			Lat:        row.Lat,
+			Lng:        row.Lng,
+			ArrivedAt:  derefTime(row.ArrivedAt),
+			DepartedAt: derefTime(row.DepartedAt),
+		})
 	}
+	return stops
+}
 
-	offer, err := fn(offers, idem, outbox)
-	if err != nil {
-		return domain.RideOffer{}, err
+func toOutboundStops(stops []domain.Stop) []outbound.RideStop {
+	if len(stops) == 0 {
+		return nil
 	}
+	rows := make([]outbound.RideStop, 0, len(stops))
+	for _, s := range stops {
+		rows = append(rows, outbound.RideStop{
+			Seq:        s.Seq,
+			Lat:        s.Lat,
+			Lng:        s.Lng,
+			ArrivedAt:  optionalTime(s.ArrivedAt),
+			DepartedAt: optionalTime(s.DepartedAt),
+		})
+	}
+	return rows
+}
 
-	if key != "" && idem != nil {
-		if b, err := json.Marshal(offer); err == nil {
-			_ = idem.Save(ctx, key, string(b))
-		}
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
 	}
+	return &value
+}
 
-	if tx != nil {
-		if err := tx.Commit(); err != nil {
-			return domain.RideOffer{}, err
-		}
+func derefString(value *string) string {
+	if value == nil {
+		return ""
 	}
-	return offer, nil
+	return *value
 }
 
-func (s *RideService) enqueueEvent(ctx context.Context, outbox outbound.OutboxRepo, topic string, payload any) error {
-	if outbox == nil {
+func optionalTime(value time.Time) *time.Time {
+	if value.IsZero() {
 		return nil
 	}
-	traceID := getStringFromContext(ctx, "trace_id")
-	requestID := getStringFromContext(ctx, "request_id")
-	envelope := domain.NewEventEnvelopeWith(topic, "ride-service", traceID, requestID, payload, s.now(), s.newID())
-	event, err := domain.NewOutboxEventWith(topic, envelope, s.now(), s.newID())
-	if err != nil {
-		return err
+	return &value
+}
+
+func derefTime(value *time.Time) time.Time {
+	if value == nil {
+		return time.Time{}
 	}
-	return outbox.Enqueue(ctx, outbound.OutboxMessage{
-		ID:      event.ID,
-		Topic:   event.Topic,
-		Payload: string(event.Payload),
-	})
+	return value.UTC()
 }
 
 func getStringFromContext(ctx context.Context, key string) string {
@@ -417,18 +708,17 @@ func (s *RideService) newID() string {
 }
 
 func (s *RideService) updateOffer(ctx context.Context, cmd OfferActionCmd, next domain.RideOfferStatus, topic string) (domain.RideOffer, error) {
-	return s.withIdempotencyOffer(ctx, cmd.IdempotencyKey, func(offers outbound.RideOfferRepo, _ outbound.IdempotencyRepo, outbox outbound.OutboxRepo) (domain.RideOffer, error) {
+	return s.withIdempotencyOffer(ctx, idempotencyScope{Key: cmd.IdempotencyKey, Operation: "offer." + strings.ToLower(string(next)), Request: cmd}, func(offers outbound.RideOfferRepo, rides outbound.RideRepo, outbox outbound.OutboxRepo) (domain.RideOffer, error) {
 		row, err := offers.Get(ctx, cmd.OfferID)
 		if err != nil {
 			return domain.RideOffer{}, err
 		}
-		offer := domain.RideOffer{
-			ID:        row.ID,
-			RideID:    row.RideID,
-			DriverID:  row.DriverID,
-			Status:    domain.RideOfferStatus(row.Status),
-			ExpiresAt: time.Unix(row.ExpiresAt, 0).UTC(),
-			CreatedAt: time.Unix(row.CreatedAt, 0).UTC(),
+		offer := toDomainOffer(row)
+		if offer.Status == next {
+			return offer, nil
+		}
+		if next == domain.OfferAccepted && offer.IsExpired(s.now()) {
+			return domain.RideOffer{}, domain.ErrOfferExpired
 		}
 
 		updated, err := offer.Transition(next)
@@ -438,6 +728,9 @@ func (s *RideService) updateOffer(ctx context.Context, cmd OfferActionCmd, next
 		if err := offers.UpdateStatusIfCurrent(ctx, updated.ID, string(offer.Status), string(updated.Status)); err != nil {
 			return domain.RideOffer{}, err
 		}
+		if err := s.applyOfferToRide(ctx, rides, outbox, updated); err != nil {
+			return domain.RideOffer{}, err
+		}
 		switch next {
 		case domain.OfferAccepted:
 			s.OfferMetrics.IncAccepted()
@@ -446,14 +739,95 @@ func (s *RideService) updateOffer(ctx context.Context, cmd OfferActionCmd, next
 		case domain.OfferExpired:
 			s.OfferMetrics.IncExpired()
 		}
-		if err := s.enqueueEvent(ctx, outbox, topic, map[string]string{
-			"offer_id":  updated.ID,
-			"ride_id":   updated.RideID,
-			"driver_id": updated.DriverID,
-			"status":    string(updated.Status),
+		if err := s.enqueueEvent(ctx, outbox, topic, &eventsv1.RideOfferUpdated{
+			OfferId:  updated.ID,
+			RideId:   updated.RideID,
+			DriverId: updated.DriverID,
+			Status:   string(updated.Status),
 		}); err != nil {
 			return domain.RideOffer{}, err
 		}
 		return updated, nil
 	})
 }
+
+// applyOfferToRide moves the ride to match the offer outcome: an accepted offer
+// assigns the driver, a declined or expired one hands the ride back to matching.
+func (s *RideService) applyOfferToRide(ctx context.Context, rides outbound.RideRepo, outbox outbound.OutboxRepo, offer domain.RideOffer) error {
+	ride, err := s.loadRide(ctx, offer.RideID, rides)
+	if err != nil {
+		return err
+	}
+	switch offer.Status {
+	case domain.OfferAccepted:
+		if ride.Status != domain.StatusOffered {
+			return domain.ErrInvalidTransition
+		}
+		assigned, err := ride.Transition(domain.StatusDriverAssigned)
+		if err != nil {
+			return err
+		}
+		assigned.DriverID = &offer.DriverID
+		if err := s.assignDriver(ctx, rides, ride, assigned, statusChange{Actor: domain.ActorDriver, ActorID: offer.DriverID, Reason: "offer_accepted"}); err != nil {
+			return err
+		}
+		if err := s.enqueueEvent(ctx, outbox, eventsv1.TopicRideDriverAssigned, &eventsv1.RideStatusChanged{
+			RideId:   ride.ID,
+			DriverId: &offer.DriverID,
+			Status:   string(assigned.Status),
+		}); err != nil {
+			return err
+		}
+		return s.issuePickupPIN(ctx, rides, outbox, assigned)
+	case domain.OfferDeclined, domain.OfferExpired:
+		// The ride may already have moved on (e.g. cancelled); only an
+		// outstanding offer puts it back into matching.
+		if ride.Status != domain.StatusOffered {
+			return nil
+		}
+		matching, err := ride.Transition(domain.StatusMatching)
+		if err != nil {
+			return err
+		}
+		change := statusChange{Actor: domain.ActorDriver, ActorID: offer.DriverID, Reason: "offer_declined"}
+		if offer.Status == domain.OfferExpired {
+			change = statusChange{Actor: domain.ActorSystem, Reason: "offer_expired"}
+		}
+		return s.updateStatus(ctx, rides, ride, matching, change)
+	}
+	return nil
+}
+
+// updateStatus moves the ride from its loaded status and appends the change to
+// the ride timeline; both writes go through repo and so share its transaction.
+func (s *RideService) updateStatus(ctx context.Context, repo outbound.RideRepo, from domain.Ride, to domain.Ride, change statusChange) error {
+	now := s.now()
+	if err := repo.UpdateStatusIfCurrent(ctx, to.ID, from.Version, string(from.Status), string(to.Status), now); err != nil {
+		return err
+	}
+	return s.appendEvent(ctx, repo, to.ID, from.Status, to.Status, change, now)
+}
+
+func (s *RideService) assignDriver(ctx context.Context, repo outbound.RideRepo, from domain.Ride, to domain.Ride, change statusChange) error {
+	if err := activeRide(ctx, repo.GetActiveByDriver, derefString(to.DriverID), domain.ErrDriverHasActiveRide); err != nil {
+		return err
+	}
+	now := s.now()
+	if err := repo.AssignDriverIfCurrent(ctx, to.ID, from.Version, derefString(to.DriverID), string(from.Status), string(to.Status), now); err != nil {
+		return err
+	}
+	return s.appendEvent(ctx, repo, to.ID, from.Status, to.Status, change, now)
+}
+
+func (s *RideService) appendEvent(ctx context.Context, repo outbound.RideRepo, rideID string, from domain.RideStatus, to domain.RideStatus, change statusChange, at time.Time) error {
+	return repo.AppendEvent(ctx, outbound.RideEvent{
+		ID:         s.newID(),
+		RideID:     rideID,
+		FromStatus: string(from),
+		ToStatus:   string(to),
+		Actor:      string(change.Actor),
+		ActorID:    optionalString(change.ActorID),
+		Reason:     change.Reason,
+		CreatedAt:  at,
+	})
+}
@@ -2,35 +2,58 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
 	"testing"
 	"time"
 
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
 	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
 	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
 )
 
 type fakeRideRepo struct {
-	store map[string]outbound.Ride
+	store    map[string]outbound.Ride
+	events   []outbound.RideEvent
+	reminded map[string]time.Time
+	excluded map[string][]string
+	strikes  []outbound.CancellationStrike
+	ratings  []outbound.RideRating
+	payments map[string]outbound.Payment
+	ledger   []outbound.LedgerPosting
+	pins     map[string]outbound.PickupPIN
+	// balancesClaimed counts ClaimDriverBalances calls, which lock accounts.
+	balancesClaimed int
 }
 
 type fakeOutboxRepo struct {
 	messages []outbound.OutboxMessage
 }
 
+// Enqueue checks every event against its published contract, so any test that
+// produces an event doubles as a producer contract test.
 func (f *fakeOutboxRepo) Enqueue(ctx context.Context, msg outbound.OutboxMessage) error {
+	if _, err := eventsv1.Validate([]byte(msg.Payload)); err != nil {
+		return fmt.Errorf("event %s breaks its contract: %w", msg.Topic, err)
+	}
 	f.messages = append(f.messages, msg)
 	return nil
 }
 
-func (f *fakeOutboxRepo) Claim(ctx context.Context, limit int, maxAttempts int) ([]outbound.OutboxMessage, error) {
+func (f *fakeOutboxRepo) Claim(ctx context.Context, limit int, maxAttempts int, lease time.Duration) ([]outbound.OutboxMessage, error) {
 	return nil, nil
 }
 
-func (f *fakeOutboxRepo) MarkSent(ctx context.Context, id string) error {
+func (f *fakeOutboxRepo) MarkSent(ctx context.Context, msg outbound.OutboxMessage) error {
 	return nil
 }
 
-func (f *fakeOutboxRepo) MarkFailed(ctx context.Context, id string, reason string, nextAttemptAt time.Time) error {
+func (f *fakeOutboxRepo) MarkFailed(ctx context.Context, msg outbound.OutboxMessage, reason string, nextAttemptAt time.Time) error {
+	return nil
+}
+
+func (f *fakeOutboxRepo) Unclaim(ctx context.Context, msg outbound.OutboxMessage) error {
 	return nil
 }
 
@@ -42,6 +65,10 @@ func (f *fakeOutboxRepo) ResetFailed(ctx context.Context, limit int) (int64, err
 	return 0, nil
 }
 
+func (f *fakeOutboxRepo) ReclaimExpired(ctx context.Context, limit int, maxAttempts int) (outbound.OutboxReclaim, error) {
+	return outbound.OutboxReclaim{}, nil
+}
+
 type fakeOfferRepo struct {
 	store map[string]outbound.RideOffer
 }
@@ -81,12 +108,38 @@ func (f *fakeOfferRepo) UpdateStatusIfCurrent(ctx context.Context, id string, cu
 	return nil
 }
 
-func (f *fakeOfferRepo) ListExpired(ctx context.Context, cutoff int64, limit int) ([]outbound.RideOffer, error) {
-	return nil, nil
+func (f *fakeOfferRepo) ExpireDue(ctx context.Context, cutoff int64, limit int) ([]outbound.RideOffer, error) {
+	out := []outbound.RideOffer{}
+	for _, offer := range f.store {
+		if offer.Status == string(domain.OfferPending) && offer.ExpiresAt <= cutoff {
+			out = append(out, offer)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ExpiresAt < out[j].ExpiresAt })
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	for i := range out {
+		out[i].Status = string(domain.OfferExpired)
+		f.store[out[i].ID] = out[i]
+	}
+	return out, nil
+}
+
+func (f *fakeOfferRepo) ExpirePendingForRide(ctx context.Context, rideID string) ([]outbound.RideOffer, error) {
+	out := []outbound.RideOffer{}
+	for id, offer := range f.store {
+		if offer.RideID == rideID && offer.Status == string(domain.OfferPending) {
+			offer.Status = string(domain.OfferExpired)
+			f.store[id] = offer
+			out = append(out, offer)
+		}
+	}
+	return out, nil
 }
 
 func newFakeRideRepo() *fakeRideRepo {
-	return &fakeRideRepo{store: map[string]outbound.Ride{}}
+	return &fakeRideRepo{store: map[string]outbound.Ride{}, reminded: map[string]time.Time{}, excluded: map[string][]string{}, payments: map[string]outbound.Payment{}, pins: map[string]outbound.PickupPIN{}}
 }
 
 func (f *fakeRideRepo) Create(ctx context.Context, ride outbound.Ride) error {
@@ -102,35 +155,534 @@ func (f *fakeRideRepo) Get(ctx context.Context, id string) (outbound.Ride, error
 	return ride, nil
 }
 
-func (f *fakeRideRepo) UpdateStatusIfCurrent(ctx context.Context, id string, currentStatus string, nextStatus string, updatedAt time.Time) error {
-	r, ok := f.store[id]
-	if !ok {
-		return outbound.ErrNotFound
+func (f *fakeRideRepo) GetActiveByRider(ctx context.Context, riderID string) (outbound.Ride, error) {
+	return f.getActive(func(r outbound.Ride) bool { return r.RiderID == riderID })
+}
+
+func (f *fakeRideRepo) GetActiveByDriver(ctx context.Context, driverID string) (outbound.Ride, error) {
+	return f.getActive(func(r outbound.Ride) bool { return r.DriverID != nil && *r.DriverID == driverID })
+}
+
+func (f *fakeRideRepo) getActive(holds func(outbound.Ride) bool) (outbound.Ride, error) {
+	for _, r := range f.store {
+		if holds(r) && toDomainRide(r).IsActive() {
+			return r, nil
+		}
+	}
+	return outbound.Ride{}, outbound.ErrNotFound
+}
+
+func (f *fakeRideRepo) List(ctx context.Context, filter outbound.RideFilter) ([]outbound.Ride, error) {
+	out := make([]outbound.Ride, 0, len(f.store))
+	for _, r := range f.store {
+		if filter.RiderID != "" && r.RiderID != filter.RiderID {
+			continue
+		}
+		if filter.DriverID != "" && (r.DriverID == nil || *r.DriverID != filter.DriverID) {
+			continue
+		}
+		if filter.Status != "" && r.Status != filter.Status {
+			continue
+		}
+		if !filter.CreatedFrom.IsZero() && r.CreatedAt.Before(filter.CreatedFrom) {
+			continue
+		}
+		if !filter.CreatedTo.IsZero() && !r.CreatedAt.Before(filter.CreatedTo) {
+			continue
+		}
+		if filter.After != nil && !rideBefore(r, filter.After.CreatedAt, filter.After.ID) {
+			continue
+		}
+		out = append(out, r)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return rideBefore(out[j], out[i].CreatedAt, out[i].ID)
+	})
+	if filter.Limit > 0 && len(out) > filter.Limit {
+		out = out[:filter.Limit]
+	}
+	return out, nil
+}
+
+func rideBefore(r outbound.Ride, createdAt time.Time, id string) bool {
+	if r.CreatedAt.Equal(createdAt) {
+		return r.ID < id
+	}
+	return r.CreatedAt.Before(createdAt)
+}
+
+func (f *fakeRideRepo) UpdateStatusIfCurrent(ctx context.Context, id string, version int64, currentStatus string, nextStatus string, updatedAt time.Time) error {
+	r, err := f.current(id, version)
+	if err != nil {
+		return err
 	}
 	if r.Status != currentStatus {
 		return outbound.ErrConflict
 	}
 	r.Status = nextStatus
 	r.UpdatedAt = updatedAt
+	r.Version++
 	f.store[id] = r
 	return nil
 }
 
-func (f *fakeRideRepo) AssignDriverIfCurrent(ctx context.Context, id string, driverID string, currentStatus string, nextStatus string, updatedAt time.Time) error {
+func (f *fakeRideRepo) ClaimScheduled(ctx context.Context, cutoff time.Time, limit int) ([]outbound.Ride, error) {
+	return f.claimScheduled(cutoff, limit, func(r outbound.Ride) bool {
+		_, err := f.getActive(func(active outbound.Ride) bool { return active.RiderID == r.RiderID })
+		return err != nil
+	}), nil
+}
+
+func (f *fakeRideRepo) ClaimOverdueScheduled(ctx context.Context, cutoff time.Time, limit int) ([]outbound.Ride, error) {
+	return f.claimScheduled(cutoff, limit, func(outbound.Ride) bool { return true }), nil
+}
+
+func (f *fakeRideRepo) ClaimReminders(ctx context.Context, cutoff time.Time, limit int) ([]outbound.Ride, error) {
+	return f.claimScheduled(cutoff, limit, func(r outbound.Ride) bool {
+		_, sent := f.reminded[r.ID]
+		return !sent
+	}), nil
+}
+
+func (f *fakeRideRepo) ClaimStuck(ctx context.Context, status string, cutoff time.Time, limit int) ([]outbound.Ride, error) {
+	out := []outbound.Ride{}
+	for _, r := range f.store {
+		if r.Status == status && !r.UpdatedAt.After(cutoff) {
+			out = append(out, r)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].UpdatedAt.Before(out[j].UpdatedAt) })
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (f *fakeRideRepo) RedispatchIfCurrent(ctx context.Context, id string, version int64, currentStatus string, nextStatus string, updatedAt time.Time) error {
+	r, err := f.current(id, version)
+	if err != nil {
+		return err
+	}
+	if r.Status != currentStatus {
+		return outbound.ErrConflict
+	}
+	r.Status = nextStatus
+	r.Redispatches++
+	r.UpdatedAt = updatedAt
+	r.Version++
+	f.store[id] = r
+	return nil
+}
+
+func (f *fakeRideRepo) claimScheduled(cutoff time.Time, limit int, keep func(outbound.Ride) bool) []outbound.Ride {
+	out := []outbound.Ride{}
+	for _, r := range f.store {
+		if r.Status != string(domain.StatusScheduled) || r.PickupAt == nil || r.PickupAt.After(cutoff) || !keep(r) {
+			continue
+		}
+		out = append(out, r)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].PickupAt.Before(*out[j].PickupAt) })
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+func (f *fakeRideRepo) MarkReminderSent(ctx context.Context, id string, version int64, sentAt time.Time) error {
+	r, err := f.current(id, version)
+	if err != nil {
+		return err
+	}
+	r.Version++
+	f.store[id] = r
+	f.reminded[id] = sentAt
+	return nil
+}
+
+// current returns the stored ride if it is still at version, mirroring the
+// compare-and-swap the real repo does on every ride write.
+func (f *fakeRideRepo) current(id string, version int64) (outbound.Ride, error) {
 	r, ok := f.store[id]
 	if !ok {
-		return outbound.ErrNotFound
+		return outbound.Ride{}, outbound.ErrNotFound
+	}
+	if r.Version != version {
+		return outbound.Ride{}, &outbound.VersionConflictError{RideID: id, Current: r.Version}
+	}
+	return r, nil
+}
+
+func (f *fakeRideRepo) ReschedulePickup(ctx context.Context, id string, version int64, pickupAt time.Time, updatedAt time.Time) error {
+	r, err := f.current(id, version)
+	if err != nil {
+		return err
+	}
+	if r.Status != string(domain.StatusScheduled) {
+		return outbound.ErrConflict
+	}
+	r.PickupAt = &pickupAt
+	r.UpdatedAt = updatedAt
+	r.Version++
+	f.store[id] = r
+	delete(f.reminded, id)
+	return nil
+}
+
+func (f *fakeRideRepo) MarkStopArrived(ctx context.Context, rideID string, version int64, seq int, at time.Time) error {
+	return f.markStop(rideID, version, seq, func(stop *outbound.RideStop) bool {
+		if stop.ArrivedAt != nil {
+			return false
+		}
+		stop.ArrivedAt = &at
+		return true
+	})
+}
+
+func (f *fakeRideRepo) MarkStopDeparted(ctx context.Context, rideID string, version int64, seq int, at time.Time) error {
+	return f.markStop(rideID, version, seq, func(stop *outbound.RideStop) bool {
+		if stop.ArrivedAt == nil || stop.DepartedAt != nil {
+			return false
+		}
+		stop.DepartedAt = &at
+		return true
+	})
+}
+
+func (f *fakeRideRepo) markStop(rideID string, version int64, seq int, apply func(*outbound.RideStop) bool) error {
+	r, err := f.current(rideID, version)
+	if err != nil {
+		return err
+	}
+	stops := append([]outbound.RideStop(nil), r.Stops...)
+	for i := range stops {
+		if stops[i].Seq != seq {
+			continue
+		}
+		if !apply(&stops[i]) {
+			return outbound.ErrConflict
+		}
+		r.Stops = stops
+		r.Version++
+		f.store[rideID] = r
+		return nil
+	}
+	return outbound.ErrNotFound
+}
+
+func (f *fakeRideRepo) AppendEvent(ctx context.Context, event outbound.RideEvent) error {
+	f.events = append(f.events, event)
+	return nil
+}
+
+func (f *fakeRideRepo) ListEvents(ctx context.Context, rideID string) ([]outbound.RideEvent, error) {
+	out := make([]outbound.RideEvent, 0, len(f.events))
+	for _, event := range f.events {
+		if event.RideID == rideID {
+			out = append(out, event)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeRideRepo) AssignDriverIfCurrent(ctx context.Context, id string, version int64, driverID string, currentStatus string, nextStatus string, updatedAt time.Time) error {
+	r, err := f.current(id, version)
+	if err != nil {
+		return err
 	}
 	if r.Status != currentStatus {
 		return outbound.ErrConflict
 	}
 	r.DriverID = &driverID
 	r.Status = nextStatus
+	r.AssignedAt = &updatedAt
+	r.UpdatedAt = updatedAt
+	r.Version++
+	f.store[id] = r
+	return nil
+}
+
+func (f *fakeRideRepo) ArriveIfCurrent(ctx context.Context, id string, version int64, currentStatus string, arrivedAt time.Time) error {
+	r, err := f.current(id, version)
+	if err != nil {
+		return err
+	}
+	if r.Status != currentStatus {
+		return outbound.ErrConflict
+	}
+	r.Status = string(domain.StatusDriverArrived)
+	r.ArrivedAt = &arrivedAt
+	r.UpdatedAt = arrivedAt
+	r.Version++
+	f.store[id] = r
+	return nil
+}
+
+func (f *fakeRideRepo) CancelIfCurrent(ctx context.Context, id string, version int64, currentStatus string, cancellation outbound.RideCancellation, updatedAt time.Time) error {
+	r, err := f.current(id, version)
+	if err != nil {
+		return err
+	}
+	if r.Status != currentStatus {
+		return outbound.ErrConflict
+	}
+	r.Status = string(domain.StatusCancelled)
+	r.CancelReason = &cancellation.Reason
+	r.CancellationFee = cancellation.Fee
+	r.UpdatedAt = updatedAt
+	r.Version++
+	f.store[id] = r
+	return nil
+}
+
+func (f *fakeRideRepo) CompleteIfCurrent(ctx context.Context, id string, version int64, currentStatus string, completedAt time.Time) error {
+	r, err := f.current(id, version)
+	if err != nil {
+		return err
+	}
+	if r.Status != currentStatus {
+		return outbound.ErrConflict
+	}
+	r.Status = string(domain.StatusCompleted)
+	r.CompletedAt = &completedAt
+	r.UpdatedAt = completedAt
+	r.Version++
+	f.store[id] = r
+	return nil
+}
+
+func (f *fakeRideRepo) AddRating(ctx context.Context, rating outbound.RideRating) error {
+	for _, existing := range f.ratings {
+		if existing.RideID == rating.RideID && existing.RaterRole == rating.RaterRole {
+			return outbound.ErrConflict
+		}
+	}
+	f.ratings = append(f.ratings, rating)
+	return nil
+}
+
+func (f *fakeRideRepo) SavePickupPIN(ctx context.Context, pin outbound.PickupPIN) error {
+	f.pins[pin.RideID] = pin
+	return nil
+}
+
+func (f *fakeRideRepo) GetPickupPIN(ctx context.Context, rideID string) (outbound.PickupPIN, error) {
+	pin, ok := f.pins[rideID]
+	if !ok {
+		return outbound.PickupPIN{}, outbound.ErrNotFound
+	}
+	return pin, nil
+}
+
+func (f *fakeRideRepo) RecordPickupPINFailure(ctx context.Context, rideID string, maxAttempts int, at time.Time) (outbound.PickupPIN, error) {
+	pin, ok := f.pins[rideID]
+	if !ok {
+		return outbound.PickupPIN{}, outbound.ErrNotFound
+	}
+	if pin.LockedAt != nil || pin.OverriddenAt != nil {
+		return outbound.PickupPIN{}, outbound.ErrConflict
+	}
+	pin.FailedAttempts++
+	if pin.FailedAttempts >= maxAttempts {
+		pin.LockedAt = &at
+	}
+	pin.UpdatedAt = at
+	f.pins[rideID] = pin
+	return pin, nil
+}
+
+func (f *fakeRideRepo) OverridePickupPIN(ctx context.Context, rideID string, actorID string, at time.Time) error {
+	pin, ok := f.pins[rideID]
+	if !ok {
+		return outbound.ErrNotFound
+	}
+	pin.OverriddenAt = &at
+	pin.OverriddenBy = &actorID
+	pin.UpdatedAt = at
+	f.pins[rideID] = pin
+	return nil
+}
+
+func (f *fakeRideRepo) CreatePayment(ctx context.Context, payment outbound.Payment) error {
+	f.payments[payment.RideID] = payment
+	return nil
+}
+
+func (f *fakeRideRepo) GetPaymentByRide(ctx context.Context, rideID string) (outbound.Payment, error) {
+	payment, ok := f.payments[rideID]
+	if !ok {
+		return outbound.Payment{}, outbound.ErrNotFound
+	}
+	return payment, nil
+}
+
+func (f *fakeRideRepo) UpdatePaymentIfCurrent(ctx context.Context, payment outbound.Payment, currentStatus string) error {
+	stored, ok := f.payments[payment.RideID]
+	if !ok || stored.Status != currentStatus {
+		return outbound.ErrConflict
+	}
+	f.payments[payment.RideID] = payment
+	return nil
+}
+
+func (f *fakeRideRepo) ListPendingPayments(ctx context.Context, limit int) ([]outbound.Payment, error) {
+	var out []outbound.Payment
+	for _, payment := range f.payments {
+		if payment.Status == string(domain.PaymentCapturePending) || payment.Status == string(domain.PaymentVoidPending) {
+			out = append(out, payment)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (f *fakeRideRepo) PostLedger(ctx context.Context, posting outbound.LedgerPosting) error {
+	for _, existing := range f.ledger {
+		if existing.Reference == posting.Reference {
+			return outbound.ErrConflict
+		}
+	}
+	f.ledger = append(f.ledger, posting)
+	return nil
+}
+
+func (f *fakeRideRepo) LedgerPosted(ctx context.Context, reference string) (bool, error) {
+	for _, existing := range f.ledger {
+		if existing.Reference == reference {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *fakeRideRepo) DriverEarnings(ctx context.Context, query outbound.EarningsQuery) ([]outbound.EarningsBucket, error) {
+	bucket := outbound.EarningsBucket{PeriodStart: query.From}
+	rides := map[string]bool{}
+	for _, posting := range f.ledger {
+		if posting.CreatedAt.Before(query.From) || !posting.CreatedAt.Before(query.To) {
+			continue
+		}
+		for _, entry := range posting.Entries {
+			if entry.OwnerType != string(domain.OwnerDriver) || entry.OwnerID != query.DriverID {
+				continue
+			}
+			bucket.Currency = posting.Currency
+			switch domain.EntryKind(entry.Kind) {
+			case domain.EntryEarning:
+				bucket.Earnings += entry.Amount
+			case domain.EntryTip:
+				bucket.Tips += entry.Amount
+			case domain.EntryFee:
+				bucket.Fees += entry.Amount
+			case domain.EntryPayout:
+				bucket.Payouts += entry.Amount
+			}
+			if posting.RideID != "" {
+				rides[posting.RideID] = true
+			}
+		}
+	}
+	if bucket.Currency == "" {
+		return nil, nil
+	}
+	bucket.Rides = len(rides)
+	return []outbound.EarningsBucket{bucket}, nil
+}
+
+func (f *fakeRideRepo) ClaimDriverBalances(ctx context.Context, minBalance int64, limit int) ([]outbound.AccountBalance, error) {
+	f.balancesClaimed++
+	return f.DriverBalances(ctx, minBalance, limit)
+}
+
+func (f *fakeRideRepo) DriverBalances(ctx context.Context, minBalance int64, limit int) ([]outbound.AccountBalance, error) {
+	balances := map[string]*outbound.AccountBalance{}
+	var order []string
+	for _, posting := range f.ledger {
+		for _, entry := range posting.Entries {
+			if entry.OwnerType != string(domain.OwnerDriver) {
+				continue
+			}
+			balance, ok := balances[entry.AccountID]
+			if !ok {
+				balance = &outbound.AccountBalance{OwnerID: entry.OwnerID, Currency: posting.Currency}
+				balances[entry.AccountID] = balance
+				order = append(order, entry.AccountID)
+			}
+			if entry.Direction == string(domain.Credit) {
+				balance.Balance += entry.Amount
+			} else {
+				balance.Balance -= entry.Amount
+			}
+		}
+	}
+	sort.Strings(order)
+	var out []outbound.AccountBalance
+	for _, id := range order {
+		if balances[id].Balance >= minBalance && balances[id].Balance > 0 {
+			out = append(out, *balances[id])
+		}
+	}
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (f *fakeRideRepo) ReleaseDriverIfCurrent(ctx context.Context, id string, version int64, driverID string, currentStatus string, nextStatus string, updatedAt time.Time) error {
+	r, err := f.current(id, version)
+	if err != nil {
+		return err
+	}
+	if r.Status != currentStatus || r.DriverID == nil || *r.DriverID != driverID {
+		return outbound.ErrConflict
+	}
+	r.DriverID = nil
+	r.AssignedAt = nil
+	r.ArrivedAt = nil
+	r.Status = nextStatus
 	r.UpdatedAt = updatedAt
+	r.Version++
 	f.store[id] = r
 	return nil
 }
 
+func (f *fakeRideRepo) ExcludeDriver(ctx context.Context, exclusion outbound.DriverExclusion) error {
+	for _, id := range f.excluded[exclusion.RideID] {
+		if id == exclusion.DriverID {
+			return nil
+		}
+	}
+	f.excluded[exclusion.RideID] = append(f.excluded[exclusion.RideID], exclusion.DriverID)
+	return nil
+}
+
+func (f *fakeRideRepo) ListExcludedDrivers(ctx context.Context, rideID string) ([]string, error) {
+	return append([]string(nil), f.excluded[rideID]...), nil
+}
+
+func (f *fakeRideRepo) AddStrike(ctx context.Context, strike outbound.CancellationStrike) error {
+	for _, existing := range f.strikes {
+		if existing.RideID == strike.RideID && existing.UserID == strike.UserID {
+			return nil
+		}
+	}
+	f.strikes = append(f.strikes, strike)
+	return nil
+}
+
+func (f *fakeRideRepo) CountStrikes(ctx context.Context, userID string, since time.Time) (int, error) {
+	count := 0
+	for _, strike := range f.strikes {
+		if strike.UserID == userID && !strike.CreatedAt.Before(since) {
+			count++
+		}
+	}
+	return count, nil
+}
+
 func TestCreateAndCancelRide(t *testing.T) {
 	repo := newFakeRideRepo()
 	outbox := &fakeOutboxRepo{}
@@ -147,7 +699,7 @@ func TestCreateAndCancelRide(t *testing.T) {
 		t.Fatalf("create error: %v", err)
 	}
 
-	_, err = svc.CancelRide(context.Background(), ride.ID, "test", "")
+	_, err = svc.CancelRide(context.Background(), CancelRideCmd{RideID: ride.ID, Reason: "test"})
 	if err != nil {
 		t.Fatalf("cancel error: %v", err)
 	}
@@ -177,7 +729,7 @@ func TestAssignStartComplete(t *testing.T) {
 		t.Fatalf("start matching error: %v", err)
 	}
 
-	if err := repo.UpdateStatusIfCurrent(context.Background(), ride.ID, string(domain.StatusMatching), string(domain.StatusOffered), time.Now().UTC()); err != nil {
+	if err := repo.UpdateStatusIfCurrent(context.Background(), ride.ID, repo.store[ride.ID].Version, string(domain.StatusMatching), string(domain.StatusOffered), time.Now().UTC()); err != nil {
 		t.Fatalf("mark offered error: %v", err)
 	}
 
@@ -186,12 +738,12 @@ func TestAssignStartComplete(t *testing.T) {
 		t.Fatalf("assign error: %v", err)
 	}
 
-	_, err = svc.StartRide(context.Background(), ride.ID, "")
+	_, err = svc.StartRide(context.Background(), DriverRideCmd{RideID: ride.ID, DriverID: "d1"})
 	if err != nil {
 		t.Fatalf("start ride error: %v", err)
 	}
 
-	_, err = svc.CompleteRide(context.Background(), ride.ID, "")
+	_, err = svc.CompleteRide(context.Background(), DriverRideCmd{RideID: ride.ID, DriverID: "d1"})
 	if err != nil {
 		t.Fatalf("complete error: %v", err)
 	}
@@ -200,10 +752,96 @@ func TestAssignStartComplete(t *testing.T) {
 	}
 }
 
+func TestStartCompleteRequiresAssignedDriver(t *testing.T) {
+	repo := newFakeRideRepo()
+	outbox := &fakeOutboxRepo{}
+	svc := &RideService{Repo: repo, Outbox: outbox, OfferMetrics: &OfferMetrics{}}
+
+	driverID := "d1"
+	repo.store["ride-1"] = outbound.Ride{ID: "ride-1", RiderID: "r1", DriverID: &driverID, Status: string(domain.StatusDriverAssigned)}
+
+	if _, err := svc.StartRide(context.Background(), DriverRideCmd{RideID: "ride-1", DriverID: "d2"}); !errors.Is(err, domain.ErrDriverMismatch) {
+		t.Fatalf("expected driver mismatch, got %v", err)
+	}
+	if _, err := svc.CompleteRide(context.Background(), DriverRideCmd{RideID: "ride-1", DriverID: "d2"}); !errors.Is(err, domain.ErrDriverMismatch) {
+		t.Fatalf("expected driver mismatch, got %v", err)
+	}
+	if len(outbox.messages) != 0 {
+		t.Fatalf("expected no outbox messages, got %d", len(outbox.messages))
+	}
+}
+
+func TestStartCompleteIdempotent(t *testing.T) {
+	repo := newFakeRideRepo()
+	outbox := &fakeOutboxRepo{}
+	svc := &RideService{Repo: repo, Outbox: outbox, OfferMetrics: &OfferMetrics{}}
+
+	driverID := "d1"
+	repo.store["ride-1"] = outbound.Ride{ID: "ride-1", RiderID: "r1", DriverID: &driverID, Status: string(domain.StatusDriverAssigned)}
+
+	for i := 0; i < 2; i++ {
+		ride, err := svc.StartRide(context.Background(), DriverRideCmd{RideID: "ride-1", DriverID: "d1"})
+		if err != nil {
+			t.Fatalf("start ride error: %v", err)
+		}
+		if ride.Status != domain.StatusInProgress {
+			t.Fatalf("expected in progress, got %s", ride.Status)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		ride, err := svc.CompleteRide(context.Background(), DriverRideCmd{RideID: "ride-1", DriverID: "d1"})
+		if err != nil {
+			t.Fatalf("complete error: %v", err)
+		}
+		if ride.Status != domain.StatusCompleted {
+			t.Fatalf("expected completed, got %s", ride.Status)
+		}
+	}
+	if len(outbox.messages) != 2 {
+		t.Fatalf("expected 2 outbox messages, got %d", len(outbox.messages))
+	}
+}
+
+func TestRideWritesCheckVersion(t *testing.T) {
+	repo := newFakeRideRepo()
+	outbox := &fakeOutboxRepo{}
+	svc := &RideService{Repo: repo, Outbox: outbox, OfferMetrics: &OfferMetrics{}}
+
+	driverID := "d1"
+	repo.store["ride-1"] = outbound.Ride{ID: "ride-1", RiderID: "r1", DriverID: &driverID, Status: string(domain.StatusDriverAssigned), Version: 3}
+
+	_, err := svc.StartRide(context.Background(), DriverRideCmd{RideID: "ride-1", DriverID: "d1", ExpectedVersion: 2})
+	var conflict *outbound.VersionConflictError
+	if !errors.As(err, &conflict) || conflict.Current != 3 {
+		t.Fatalf("expected version conflict at 3, got %v", err)
+	}
+	if !errors.Is(err, outbound.ErrConflict) {
+		t.Fatalf("expected conflict to match ErrConflict")
+	}
+	if repo.store["ride-1"].Status != string(domain.StatusDriverAssigned) || len(outbox.messages) != 0 {
+		t.Fatalf("expected stale start to leave the ride untouched")
+	}
+
+	ride, err := svc.StartRide(context.Background(), DriverRideCmd{RideID: "ride-1", DriverID: "d1", ExpectedVersion: 3})
+	if err != nil {
+		t.Fatalf("start ride error: %v", err)
+	}
+	if ride.Version != 4 || repo.store["ride-1"].Version != 4 {
+		t.Fatalf("expected version 4, got %d (stored %d)", ride.Version, repo.store["ride-1"].Version)
+	}
+
+	// A write made from a read that has since gone stale loses the swap.
+	if err := repo.CompleteIfCurrent(context.Background(), "ride-1", 3, string(domain.StatusInProgress), time.Now().UTC()); !errors.As(err, &conflict) || conflict.Current != 4 {
+		t.Fatalf("expected repo version conflict at 4, got %v", err)
+	}
+}
+
 func TestCreateOffer(t *testing.T) {
+	repo := newFakeRideRepo()
 	offers := &fakeOfferRepo{}
 	outbox := &fakeOutboxRepo{}
-	svc := &RideService{Offers: offers, Outbox: outbox, OfferMetrics: &OfferMetrics{}}
+	svc := &RideService{Repo: repo, Offers: offers, Outbox: outbox, OfferMetrics: &OfferMetrics{}}
+	repo.store["ride-1"] = outbound.Ride{ID: "ride-1", RiderID: "r1", Status: string(domain.StatusMatching)}
 
 	offer, err := svc.CreateOffer(context.Background(), StartMatchingCmd{
 		RideID:   "ride-1",
@@ -222,4 +860,146 @@ func TestCreateOffer(t *testing.T) {
 	if len(outbox.messages) != 1 {
 		t.Fatalf("expected outbox message, got %d", len(outbox.messages))
 	}
+	if got := repo.store["ride-1"].Status; got != string(domain.StatusOffered) {
+		t.Fatalf("expected ride offered, got %s", got)
+	}
+
+	if _, err := svc.CreateOffer(context.Background(), StartMatchingCmd{RideID: "ride-1", DriverID: "driver-2"}); !errors.Is(err, domain.ErrInvalidTransition) {
+		t.Fatalf("expected invalid transition for offered ride, got %v", err)
+	}
+}
+
+type fixedClock struct {
+	now time.Time
+}
+
+func (c *fixedClock) Now() time.Time { return c.now }
+
+func newOfferedRide(t *testing.T, ttl time.Duration) (*RideService, *fakeRideRepo, *fixedClock, domain.RideOffer) {
+	t.Helper()
+	repo := newFakeRideRepo()
+	clock := &fixedClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	svc := &RideService{Repo: repo, Offers: &fakeOfferRepo{}, Outbox: &fakeOutboxRepo{}, OfferMetrics: &OfferMetrics{}, Clock: clock}
+	repo.store["ride-1"] = outbound.Ride{ID: "ride-1", RiderID: "r1", Status: string(domain.StatusMatching)}
+	offer, err := svc.CreateOffer(context.Background(), StartMatchingCmd{RideID: "ride-1", DriverID: "driver-1", OfferTTL: ttl})
+	if err != nil {
+		t.Fatalf("create offer error: %v", err)
+	}
+	return svc, repo, clock, offer
+}
+
+func TestAcceptOfferAssignsDriver(t *testing.T) {
+	svc, repo, _, offer := newOfferedRide(t, 10*time.Second)
+
+	accepted, err := svc.AcceptOffer(context.Background(), OfferActionCmd{OfferID: offer.ID})
+	if err != nil {
+		t.Fatalf("accept error: %v", err)
+	}
+	if accepted.Status != domain.OfferAccepted {
+		t.Fatalf("expected accepted offer, got %s", accepted.Status)
+	}
+	ride := repo.store["ride-1"]
+	if ride.Status != string(domain.StatusDriverAssigned) {
+		t.Fatalf("expected driver assigned, got %s", ride.Status)
+	}
+	if ride.DriverID == nil || *ride.DriverID != "driver-1" {
+		t.Fatalf("expected driver-1 assigned, got %v", ride.DriverID)
+	}
+}
+
+func TestAcceptExpiredOfferRejected(t *testing.T) {
+	svc, repo, clock, offer := newOfferedRide(t, 10*time.Second)
+	clock.now = clock.now.Add(11 * time.Second)
+
+	if _, err := svc.AcceptOffer(context.Background(), OfferActionCmd{OfferID: offer.ID}); !errors.Is(err, domain.ErrOfferExpired) {
+		t.Fatalf("expected offer expired, got %v", err)
+	}
+	if got := repo.store["ride-1"].Status; got != string(domain.StatusOffered) {
+		t.Fatalf("expected ride still offered, got %s", got)
+	}
+}
+
+func TestDeclineAndExpireReturnRideToMatching(t *testing.T) {
+	tests := []struct {
+		name   string
+		action func(svc *RideService, cmd OfferActionCmd) (domain.RideOffer, error)
+	}{
+		{"decline", func(svc *RideService, cmd OfferActionCmd) (domain.RideOffer, error) {
+			return svc.DeclineOffer(context.Background(), cmd)
+		}},
+		{"expire", func(svc *RideService, cmd OfferActionCmd) (domain.RideOffer, error) {
+			return svc.ExpireOffer(context.Background(), cmd)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo, _, offer := newOfferedRide(t, 10*time.Second)
+			if _, err := tt.action(svc, OfferActionCmd{OfferID: offer.ID}); err != nil {
+				t.Fatalf("%s error: %v", tt.name, err)
+			}
+			if got := repo.store["ride-1"].Status; got != string(domain.StatusMatching) {
+				t.Fatalf("expected ride matching, got %s", got)
+			}
+		})
+	}
+}
+
+func TestExpireOfferLeavesCancelledRide(t *testing.T) {
+	svc, repo, _, offer := newOfferedRide(t, 10*time.Second)
+	ride := repo.store["ride-1"]
+	ride.Status = string(domain.StatusCancelled)
+	repo.store["ride-1"] = ride
+
+	if _, err := svc.ExpireOffer(context.Background(), OfferActionCmd{OfferID: offer.ID}); err != nil {
+		t.Fatalf("expire error: %v", err)
+	}
+	if got := repo.store["ride-1"].Status; got != string(domain.StatusCancelled) {
+		t.Fatalf("expected ride to stay cancelled, got %s", got)
+	}
+}
+
+func TestRideEventsKeyedOnRide(t *testing.T) {
+	repo := newFakeRideRepo()
+	outbox := &fakeOutboxRepo{}
+	svc := &RideService{Repo: repo, Outbox: outbox, OfferMetrics: &OfferMetrics{}}
+
+	ride, err := svc.CreateRide(context.Background(), CreateRideCmd{RiderID: "r1", PickupLat: 1, PickupLng: 2, DropoffLat: 3, DropoffLng: 4})
+	if err != nil {
+		t.Fatalf("create error: %v", err)
+	}
+	if _, err := svc.StartMatching(context.Background(), ride.ID, ""); err != nil {
+		t.Fatalf("start matching error: %v", err)
+	}
+	for _, msg := range outbox.messages {
+		if msg.AggregateID != ride.ID {
+			t.Fatalf("expected %s keyed on ride %s, got %q", msg.Topic, ride.ID, msg.AggregateID)
+		}
+	}
+	if eventAggregate(&eventsv1.DriverPayoutCreated{PayoutId: "p1"}) != "" {
+		t.Fatalf("expected events without a ride to be unkeyed")
+	}
+}
+
+func TestRideRequestedMatchesConsumerContract(t *testing.T) {
+	outbox := &fakeOutboxRepo{}
+	svc := &RideService{Repo: newFakeRideRepo(), Outbox: outbox, OfferMetrics: &OfferMetrics{}}
+
+	ride, err := svc.CreateRide(context.Background(), CreateRideCmd{RiderID: "r1", PickupLat: 1.5, PickupLng: 2.5, DropoffLat: 3, DropoffLng: 4})
+	if err != nil {
+		t.Fatalf("create error: %v", err)
+	}
+	if len(outbox.messages) != 1 || outbox.messages[0].Topic != eventsv1.TopicRideRequested {
+		t.Fatalf("expected ride.requested, got %+v", outbox.messages)
+	}
+	var event eventsv1.RideRequested
+	envelope, err := eventsv1.Unmarshal([]byte(outbox.messages[0].Payload), &event)
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if envelope.Version != "v1" || envelope.Producer != "ride-service" {
+		t.Fatalf("unexpected envelope: %+v", envelope)
+	}
+	if event.GetRideId() != ride.ID || event.GetPickupLat() != 1.5 || event.GetPickupLng() != 2.5 || event.PickupAt != nil {
+		t.Fatalf("unexpected event: %+v", &event)
+	}
 }
@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+type RescheduleRideCmd struct {
+	RideID          string
+	RiderID         string
+	PickupAt        time.Time
+	IdempotencyKey  string
+	ExpectedVersion int64
+}
+
+// RescheduleRide moves the pickup of a rider's scheduled ride. Rides that have
+// already been dispatched to matching can no longer be rescheduled.
+func (s *RideService) RescheduleRide(ctx context.Context, cmd RescheduleRideCmd) (domain.Ride, error) {
+	if err := s.Scheduling.Validate(cmd.PickupAt, s.now()); err != nil {
+		return domain.Ride{}, err
+	}
+	return s.withIdempotency(ctx, idempotencyScope{Key: cmd.IdempotencyKey, Operation: "ride.reschedule", Caller: cmd.RiderID, Request: cmd}, func(repo outbound.RideRepo, outbox outbound.OutboxRepo) (domain.Ride, error) {
+		ride, err := s.loadRide(ctx, cmd.RideID, repo)
+		if err != nil {
+			return domain.Ride{}, err
+		}
+		if !ride.IsRequestedBy(cmd.RiderID) {
+			return domain.Ride{}, domain.ErrRiderMismatch
+		}
+		if ride.Status != domain.StatusScheduled {
+			return domain.Ride{}, domain.ErrInvalidTransition
+		}
+		if err := checkVersion(ride, cmd.ExpectedVersion); err != nil {
+			return domain.Ride{}, err
+		}
+
+		now := s.now()
+		updated := ride
+		updated.PickupAt = cmd.PickupAt.UTC()
+		updated.UpdatedAt = now
+		updated.Version++
+		if err := repo.ReschedulePickup(ctx, updated.ID, ride.Version, updated.PickupAt, now); err != nil {
+			return domain.Ride{}, err
+		}
+		if err := s.appendEvent(ctx, repo, updated.ID, ride.Status, updated.Status, statusChange{Actor: domain.ActorRider, ActorID: cmd.RiderID, Reason: "rescheduled"}, now); err != nil {
+			return domain.Ride{}, err
+		}
+		if err := s.enqueueEvent(ctx, outbox, eventsv1.TopicRideRescheduled, &eventsv1.RideRescheduled{
+			RideId:   updated.ID,
+			RiderId:  updated.RiderID,
+			PickupAt: updated.PickupAt.Unix(),
+			Status:   string(updated.Status),
+		}); err != nil {
+			return domain.Ride{}, err
+		}
+		return updated, nil
+	})
+}
+
+// ScheduledDispatch is what one dispatch run did. Failures lists rides whose
+// dispatch or timeout failed; they were skipped and come up again next run.
+type ScheduledDispatch struct {
+	Dispatched int
+	Failures   []ScheduledFailure
+}
+
+// ScheduledFailure is a scheduled ride whose update failed and was skipped.
+type ScheduledFailure struct {
+	RideID string
+	Err    error
+}
+
+// DispatchScheduledRides starts matching for scheduled rides whose pickup is
+// within the dispatch lead time. Rides are claimed with SKIP LOCKED so several
+// schedulers can run side by side without dispatching a ride twice. A rider
+// still out on another ride keeps their scheduled ride waiting, but only until
+// the policy's dispatch timeout past pickup, when it is timed out instead.
+// Each ride is updated under its own savepoint: one that fails is reported in
+// Failures instead of rolling back the rest of the batch.
+func (s *RideService) DispatchScheduledRides(ctx context.Context, limit int) (ScheduledDispatch, error) {
+	return runInTxSavepoints(s, func(savepoint savepointFunc, offers outbound.RideOfferRepo, repo outbound.RideRepo, _ outbound.IdempotencyRepo, outbox outbound.OutboxRepo) (ScheduledDispatch, error) {
+		var run ScheduledDispatch
+		// A ride that changed under us, e.g. cancelled by the rider, is no
+		// longer ours to dispatch and is not a failure.
+		fail := func(rideID string, err error) {
+			if !errors.Is(err, outbound.ErrConflict) {
+				run.Failures = append(run.Failures, ScheduledFailure{RideID: rideID, Err: err})
+			}
+		}
+		now := s.now()
+		if s.Scheduling.DispatchTimeout > 0 {
+			overdue, err := repo.ClaimOverdueScheduled(ctx, now.Add(-s.Scheduling.DispatchTimeout), limit)
+			if err != nil {
+				return ScheduledDispatch{}, err
+			}
+			for _, row := range overdue {
+				ride := toDomainRide(row)
+				if err := savepoint("scheduled_timeout", func() error {
+					return s.timeOutRide(ctx, offers, repo, outbox, ride, reasonScheduledTimeout)
+				}); err != nil {
+					fail(ride.ID, err)
+				}
+			}
+		}
+		rows, err := repo.ClaimScheduled(ctx, now.Add(s.Scheduling.DispatchLead), limit)
+		if err != nil {
+			return ScheduledDispatch{}, err
+		}
+		for _, row := range rows {
+			ride := toDomainRide(row)
+			waiting := false
+			err := savepoint("scheduled_dispatch", func() error {
+				// The claim skips busy riders, but two of one rider's
+				// scheduled rides can come up in the same batch; the second
+				// waits.
+				err := activeRide(ctx, repo.GetActiveByRider, ride.RiderID, domain.ErrRiderHasActiveRide)
+				if errors.Is(err, domain.ErrRiderHasActiveRide) {
+					waiting = true
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				_, err = s.startMatching(ctx, repo, outbox, ride, statusChange{Actor: domain.ActorSystem, Reason: "scheduled_dispatch"})
+				return err
+			})
+			if err != nil {
+				fail(ride.ID, err)
+				continue
+			}
+			if !waiting {
+				run.Dispatched++
+			}
+		}
+		return run, nil
+	})
+}
+
+// SendScheduledReminders emits ride.scheduled.reminder once per scheduled ride
+// when its pickup is within the reminder lead time.
+func (s *RideService) SendScheduledReminders(ctx context.Context, limit int) (int, error) {
+	sent := 0
+	err := s.withTx(ctx, func(repo outbound.RideRepo, outbox outbound.OutboxRepo) error {
+		now := s.now()
+		rows, err := repo.ClaimReminders(ctx, now.Add(s.Scheduling.ReminderLead), limit)
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			ride := toDomainRide(row)
+			if err := repo.MarkReminderSent(ctx, ride.ID, ride.Version, now); err != nil {
+				return err
+			}
+			if err := s.enqueueEvent(ctx, outbox, eventsv1.TopicRideReminder, &eventsv1.RideReminder{
+				RideId:     ride.ID,
+				RiderId:    ride.RiderID,
+				PickupAt:   ride.PickupAt.Unix(),
+				PickupLat:  ride.PickupLat,
+				PickupLng:  ride.PickupLng,
+				DropoffLat: ride.DropoffLat,
+				DropoffLng: ride.DropoffLng,
+			}); err != nil {
+				return err
+			}
+			sent++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return sent, nil
+}
@@ -0,0 +1,194 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+func newScheduleService() (*RideService, *fakeRideRepo, *fakeOutboxRepo, *fixedClock) {
+	repo := newFakeRideRepo()
+	outbox := &fakeOutboxRepo{}
+	clock := &fixedClock{now: time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)}
+	return &RideService{
+		Repo:         repo,
+		Outbox:       outbox,
+		OfferMetrics: &OfferMetrics{},
+		Scheduling:   domain.DefaultSchedulePolicy(),
+		Clock:        clock,
+	}, repo, outbox, clock
+}
+
+func topics(outbox *fakeOutboxRepo) []string {
+	out := make([]string, 0, len(outbox.messages))
+	for _, msg := range outbox.messages {
+		out = append(out, msg.Topic)
+	}
+	return out
+}
+
+func countTopic(outbox *fakeOutboxRepo, topic string) int {
+	n := 0
+	for _, msg := range outbox.messages {
+		if msg.Topic == topic {
+			n++
+		}
+	}
+	return n
+}
+
+func TestCreateScheduledRide(t *testing.T) {
+	svc, repo, outbox, clock := newScheduleService()
+	pickupAt := clock.now.Add(3 * time.Hour)
+
+	ride, err := svc.CreateRide(context.Background(), CreateRideCmd{RiderID: "r1", PickupLat: 1, PickupLng: 2, DropoffLat: 3, DropoffLng: 4, PickupAt: pickupAt})
+	if err != nil {
+		t.Fatalf("create error: %v", err)
+	}
+	if ride.Status != domain.StatusScheduled || !ride.PickupAt.Equal(pickupAt) {
+		t.Fatalf("expected scheduled ride at %v, got %s at %v", pickupAt, ride.Status, ride.PickupAt)
+	}
+	if row := repo.store[ride.ID]; row.PickupAt == nil || !row.PickupAt.Equal(pickupAt) {
+		t.Fatalf("expected pickup_at stored, got %v", row.PickupAt)
+	}
+	if got := topics(outbox); len(got) != 1 || got[0] != "ride.scheduled" {
+		t.Fatalf("expected only ride.scheduled, got %v", got)
+	}
+
+	if _, err := svc.CreateRide(context.Background(), CreateRideCmd{RiderID: "r1", PickupAt: clock.now.Add(5 * time.Minute)}); !errors.Is(err, domain.ErrInvalidPickupTime) {
+		t.Fatalf("expected invalid pickup time, got %v", err)
+	}
+}
+
+// eventFailingRepo fails to write the timeline of one ride, so a batch that
+// touches it has one ride fail half way.
+type eventFailingRepo struct {
+	*fakeRideRepo
+	rideID string
+}
+
+func (r eventFailingRepo) AppendEvent(ctx context.Context, event outbound.RideEvent) error {
+	if event.RideID == r.rideID {
+		return errors.New("connection reset")
+	}
+	return r.fakeRideRepo.AppendEvent(ctx, event)
+}
+
+func TestDispatchScheduledRidesReportsFailedRide(t *testing.T) {
+	svc, repo, _, clock := newScheduleService()
+	ctx := context.Background()
+	var rides []domain.Ride
+	for _, rider := range []string{"r1", "r2"} {
+		ride, err := svc.CreateRide(ctx, CreateRideCmd{RiderID: rider, PickupAt: clock.now.Add(2 * time.Hour)})
+		if err != nil {
+			t.Fatalf("create error: %v", err)
+		}
+		rides = append(rides, ride)
+	}
+	svc.Repo = eventFailingRepo{fakeRideRepo: repo, rideID: rides[0].ID}
+
+	clock.now = clock.now.Add(2 * time.Hour)
+	run, err := svc.DispatchScheduledRides(ctx, 10)
+	if err != nil || run.Dispatched != 1 {
+		t.Fatalf("expected the healthy ride dispatched, got %+v (%v)", run, err)
+	}
+	if len(run.Failures) != 1 || run.Failures[0].RideID != rides[0].ID {
+		t.Fatalf("expected the failing ride reported, got %+v", run.Failures)
+	}
+	if got := repo.store[rides[1].ID].Status; got != string(domain.StatusMatching) {
+		t.Fatalf("expected the healthy ride matching, got %s", got)
+	}
+}
+
+func TestScheduledRideReminderAndDispatch(t *testing.T) {
+	svc, repo, outbox, clock := newScheduleService()
+	ctx := context.Background()
+	ride, err := svc.CreateRide(ctx, CreateRideCmd{RiderID: "r1", PickupLat: 1, PickupLng: 2, DropoffLat: 3, DropoffLng: 4, PickupAt: clock.now.Add(2 * time.Hour)})
+	if err != nil {
+		t.Fatalf("create error: %v", err)
+	}
+
+	// Nothing is due two hours out.
+	if sent, _ := svc.SendScheduledReminders(ctx, 10); sent != 0 {
+		t.Fatalf("expected no reminder yet, got %d", sent)
+	}
+
+	clock.now = clock.now.Add(time.Hour)
+	if sent, _ := svc.SendScheduledReminders(ctx, 10); sent != 1 {
+		t.Fatalf("expected one reminder, got %d", sent)
+	}
+	if sent, _ := svc.SendScheduledReminders(ctx, 10); sent != 0 {
+		t.Fatalf("expected reminder to be sent once, got %d", sent)
+	}
+	if run, _ := svc.DispatchScheduledRides(ctx, 10); run.Dispatched != 0 {
+		t.Fatalf("expected no dispatch before the lead time, got %d", run.Dispatched)
+	}
+
+	clock.now = clock.now.Add(45 * time.Minute)
+	run, err := svc.DispatchScheduledRides(ctx, 10)
+	if err != nil || run.Dispatched != 1 {
+		t.Fatalf("expected one dispatch, got %d (%v)", run.Dispatched, err)
+	}
+	if got := repo.store[ride.ID].Status; got != string(domain.StatusMatching) {
+		t.Fatalf("expected matching, got %s", got)
+	}
+	if countTopic(outbox, "ride.scheduled.reminder") != 1 || countTopic(outbox, "ride.requested") != 1 {
+		t.Fatalf("expected a reminder and a ride.requested, got %v", topics(outbox))
+	}
+	if run, _ := svc.DispatchScheduledRides(ctx, 10); run.Dispatched != 0 {
+		t.Fatalf("expected ride to be dispatched once, got %d", run.Dispatched)
+	}
+	events, _ := svc.GetRideTimeline(ctx, ride.ID)
+	last := events[len(events)-1]
+	if last.ToStatus != domain.StatusMatching || last.Actor != domain.ActorSystem || last.Reason != "scheduled_dispatch" {
+		t.Fatalf("unexpected dispatch event: %+v", last)
+	}
+}
+
+func TestRescheduleRide(t *testing.T) {
+	svc, repo, outbox, clock := newScheduleService()
+	ctx := context.Background()
+	ride, err := svc.CreateRide(ctx, CreateRideCmd{RiderID: "r1", PickupAt: clock.now.Add(90 * time.Minute)})
+	if err != nil {
+		t.Fatalf("create error: %v", err)
+	}
+	clock.now = clock.now.Add(45 * time.Minute)
+	if sent, _ := svc.SendScheduledReminders(ctx, 10); sent != 1 {
+		t.Fatalf("expected one reminder, got %d", sent)
+	}
+
+	newPickup := clock.now.Add(4 * time.Hour)
+	if _, err := svc.RescheduleRide(ctx, RescheduleRideCmd{RideID: ride.ID, RiderID: "r2", PickupAt: newPickup}); !errors.Is(err, domain.ErrRiderMismatch) {
+		t.Fatalf("expected rider mismatch, got %v", err)
+	}
+	if _, err := svc.RescheduleRide(ctx, RescheduleRideCmd{RideID: ride.ID, RiderID: "r1", PickupAt: clock.now}); !errors.Is(err, domain.ErrInvalidPickupTime) {
+		t.Fatalf("expected invalid pickup time, got %v", err)
+	}
+	updated, err := svc.RescheduleRide(ctx, RescheduleRideCmd{RideID: ride.ID, RiderID: "r1", PickupAt: newPickup})
+	if err != nil {
+		t.Fatalf("reschedule error: %v", err)
+	}
+	if !updated.PickupAt.Equal(newPickup) || !repo.store[ride.ID].PickupAt.Equal(newPickup) {
+		t.Fatalf("expected pickup moved to %v, got %v", newPickup, updated.PickupAt)
+	}
+	if countTopic(outbox, "ride.scheduled.updated") != 1 {
+		t.Fatalf("expected ride.scheduled.updated, got %v", topics(outbox))
+	}
+
+	// The new pickup gets its own reminder.
+	clock.now = newPickup.Add(-time.Hour)
+	if sent, _ := svc.SendScheduledReminders(ctx, 10); sent != 1 {
+		t.Fatalf("expected reminder re-armed, got %d", sent)
+	}
+
+	if _, err := svc.CancelRide(ctx, CancelRideCmd{RideID: ride.ID, Actor: domain.ActorRider, ActorID: "r1"}); err != nil {
+		t.Fatalf("cancel error: %v", err)
+	}
+	if _, err := svc.RescheduleRide(ctx, RescheduleRideCmd{RideID: ride.ID, RiderID: "r1", PickupAt: clock.now.Add(2 * time.Hour)}); !errors.Is(err, domain.ErrInvalidTransition) {
+		t.Fatalf("expected cancelled ride to be final, got %v", err)
+	}
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+type StopActionCmd struct {
+	RideID          string
+	DriverID        string
+	Seq             int
+	IdempotencyKey  string
+	ExpectedVersion int64
+}
+
+// ArriveAtStop records the assigned driver reaching an intermediate stop.
+func (s *RideService) ArriveAtStop(ctx context.Context, cmd StopActionCmd) (domain.Ride, error) {
+	return s.stopAction(ctx, cmd, true)
+}
+
+// DepartStop records the assigned driver leaving an intermediate stop.
+func (s *RideService) DepartStop(ctx context.Context, cmd StopActionCmd) (domain.Ride, error) {
+	return s.stopAction(ctx, cmd, false)
+}
+
+func (s *RideService) stopAction(ctx context.Context, cmd StopActionCmd, arrive bool) (domain.Ride, error) {
+	operation := "ride.stop_depart"
+	if arrive {
+		operation = "ride.stop_arrive"
+	}
+	return s.withIdempotency(ctx, idempotencyScope{Key: cmd.IdempotencyKey, Operation: operation, Caller: cmd.DriverID, Request: cmd}, func(repo outbound.RideRepo, outbox outbound.OutboxRepo) (domain.Ride, error) {
+		ride, err := s.loadRide(ctx, cmd.RideID, repo)
+		if err != nil {
+			return domain.Ride{}, err
+		}
+		if !ride.IsAssignedTo(cmd.DriverID) {
+			return domain.Ride{}, domain.ErrDriverMismatch
+		}
+		if err := checkVersion(ride, cmd.ExpectedVersion); err != nil {
+			return domain.Ride{}, err
+		}
+
+		now := s.now()
+		var updated domain.Ride
+		if arrive {
+			updated, err = ride.ArriveAtStop(cmd.Seq, now)
+		} else {
+			updated, err = ride.DepartStop(cmd.Seq, now)
+		}
+		if err != nil {
+			return domain.Ride{}, err
+		}
+		idx, ok := updated.StopIndex(cmd.Seq)
+		if !ok {
+			return domain.Ride{}, domain.ErrStopNotFound
+		}
+		before, after := ride.Stops[idx], updated.Stops[idx]
+		if before == after {
+			return updated, nil
+		}
+
+		event := &eventsv1.RideStopUpdated{
+			RideId:   updated.ID,
+			RiderId:  updated.RiderID,
+			DriverId: cmd.DriverID,
+			Seq:      int32(cmd.Seq),
+			Lat:      after.Lat,
+			Lng:      after.Lng,
+			Status:   string(updated.Status),
+		}
+		at := now.Unix()
+		topic := eventsv1.TopicRideStopArrived
+		if arrive {
+			err = repo.MarkStopArrived(ctx, updated.ID, ride.Version, cmd.Seq, now)
+			event.ArrivedAt = &at
+		} else {
+			err = repo.MarkStopDeparted(ctx, updated.ID, ride.Version, cmd.Seq, now)
+			topic = eventsv1.TopicRideStopDeparted
+			event.DepartedAt = &at
+			// Route leg 0 is pickup->first stop, so the leg leaving the
+			// stop at idx is idx+1.
+			if legs := s.Pricing.legs(updated.Route()); idx+1 < len(legs) {
+				event.NextEtaSeconds = &legs[idx+1].DurationSeconds
+			}
+		}
+		if err != nil {
+			return domain.Ride{}, err
+		}
+		if err := s.enqueueEvent(ctx, outbox, topic, event); err != nil {
+			return domain.Ride{}, err
+		}
+		return updated, nil
+	})
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+func TestCreateRideStoresStops(t *testing.T) {
+	svc, repo, outbox, _ := newScheduleService()
+	stops := []domain.Point{{Lat: 1.5, Lng: 2.5}, {Lat: 2, Lng: 3}}
+
+	ride, err := svc.CreateRide(context.Background(), CreateRideCmd{RiderID: "r1", PickupLat: 1, PickupLng: 2, DropoffLat: 3, DropoffLng: 4, Stops: stops})
+	if err != nil {
+		t.Fatalf("create error: %v", err)
+	}
+	if got := repo.store[ride.ID].Stops; len(got) != 2 || got[1].Seq != 2 || got[1].Lat != 2 {
+		t.Fatalf("unexpected stored stops: %+v", got)
+	}
+	if got := outbox.messages[0].Payload; !containsAll(got, `"stops":[{"lat":1.5,"lng":2.5},{"lat":2,"lng":3}]`) {
+		t.Fatalf("expected stops in ride.requested, got %s", got)
+	}
+
+	tooMany := append(stops, domain.Point{}, domain.Point{})
+	if _, err := svc.CreateRide(context.Background(), CreateRideCmd{RiderID: "r1", Stops: tooMany}); !errors.Is(err, domain.ErrTooManyStops) {
+		t.Fatalf("expected too many stops, got %v", err)
+	}
+}
+
+func TestStopProgressEmitsEvents(t *testing.T) {
+	svc, repo, outbox, clock := newScheduleService()
+	ctx := context.Background()
+	driverID := "d1"
+	repo.store["ride-1"] = outbound.Ride{
+		ID: "ride-1", RiderID: "r1", DriverID: &driverID, Status: string(domain.StatusInProgress),
+		PickupLat: -6.2, PickupLng: 106.8, DropoffLat: -6.3, DropoffLng: 106.9,
+		Stops: []outbound.RideStop{{Seq: 1, Lat: -6.25, Lng: 106.85}},
+	}
+
+	if _, err := svc.ArriveAtStop(ctx, StopActionCmd{RideID: "ride-1", DriverID: "d2", Seq: 1}); !errors.Is(err, domain.ErrDriverMismatch) {
+		t.Fatalf("expected driver mismatch, got %v", err)
+	}
+	if _, err := svc.DepartStop(ctx, StopActionCmd{RideID: "ride-1", DriverID: "d1", Seq: 1}); !errors.Is(err, domain.ErrStopOutOfOrder) {
+		t.Fatalf("expected departure before arrival to fail, got %v", err)
+	}
+	if _, err := svc.ArriveAtStop(ctx, StopActionCmd{RideID: "ride-1", DriverID: "d1", Seq: 2}); !errors.Is(err, domain.ErrStopNotFound) {
+		t.Fatalf("expected stop not found, got %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		ride, err := svc.ArriveAtStop(ctx, StopActionCmd{RideID: "ride-1", DriverID: "d1", Seq: 1})
+		if err != nil {
+			t.Fatalf("arrive error: %v", err)
+		}
+		if !ride.Stops[0].ArrivedAt.Equal(clock.now) {
+			t.Fatalf("expected arrival at %v, got %v", clock.now, ride.Stops[0].ArrivedAt)
+		}
+	}
+	clock.now = clock.now.Add(3 * time.Minute)
+	if _, err := svc.DepartStop(ctx, StopActionCmd{RideID: "ride-1", DriverID: "d1", Seq: 1}); err != nil {
+		t.Fatalf("depart error: %v", err)
+	}
+	if got := repo.store["ride-1"].Stops[0].DepartedAt; got == nil || !got.Equal(clock.now) {
+		t.Fatalf("expected departure stored, got %v", got)
+	}
+
+	if countTopic(outbox, "ride.stop.arrived") != 1 || countTopic(outbox, "ride.stop.departed") != 1 {
+		t.Fatalf("expected one event per stop change, got %v", topics(outbox))
+	}
+	if got := outbox.messages[len(outbox.messages)-1].Payload; !containsAll(got, `"rider_id":"r1"`, `"next_eta_seconds":`) {
+		t.Fatalf("expected departure payload with eta, got %s", got)
+	}
+}
+
+func TestStopActionFindsStopBySeq(t *testing.T) {
+	svc, repo, outbox, _ := newScheduleService()
+	ctx := context.Background()
+	driverID := "d1"
+	// Stops as loaded from storage need not sit at Seq-1 in the slice.
+	repo.store["ride-1"] = outbound.Ride{
+		ID: "ride-1", RiderID: "r1", DriverID: &driverID, Status: string(domain.StatusInProgress),
+		Stops: []outbound.RideStop{{Seq: 2, Lat: 2, Lng: 2}},
+	}
+
+	ride, err := svc.ArriveAtStop(ctx, StopActionCmd{RideID: "ride-1", DriverID: "d1", Seq: 2})
+	if err != nil {
+		t.Fatalf("arrive error: %v", err)
+	}
+	if ride.Stops[0].ArrivedAt.IsZero() {
+		t.Fatalf("expected stop 2 arrived, got %+v", ride.Stops)
+	}
+	if got := outbox.messages[len(outbox.messages)-1].Payload; !containsAll(got, `"seq":2`, `"lat":2`) {
+		t.Fatalf("unexpected ride.stop.arrived payload: %s", got)
+	}
+}
+
+func containsAll(s string, parts ...string) bool {
+	for _, part := range parts {
+		if !strings.Contains(s, part) {
+			return false
+		}
+	}
+	return true
+}
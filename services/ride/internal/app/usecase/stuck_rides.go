@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+const (
+	reasonSLARedispatch    = "sla_redispatch"
+	reasonSLATimeout       = "sla_timeout"
+	reasonScheduledTimeout = "scheduled_timeout"
+)
+
+// stuckStatuses are the statuses a ride waits in for matching, in the order
+// they are swept.
+var stuckStatuses = []domain.RideStatus{domain.StatusRequested, domain.StatusMatching, domain.StatusOffered}
+
+// StuckRide is what the SLA sweep did to one ride: either it was announced to
+// matching again or, out of redispatches, it was timed out.
+type StuckRide struct {
+	RideID   string
+	Status   domain.RideStatus
+	TimedOut bool
+}
+
+// StuckSweep is what one SLA sweep did. Failures lists rides whose update
+// failed; they were skipped and are claimed again by a later sweep.
+type StuckSweep struct {
+	Reaped   []StuckRide
+	Failures []StuckFailure
+}
+
+// StuckFailure is a stuck ride whose redispatch or timeout failed.
+type StuckFailure struct {
+	RideID string
+	Status domain.RideStatus
+	Err    error
+}
+
+// ReapStuckRides finds rides that have waited on matching longer than the
+// StuckRides policy allows for their status and redispatches or times them
+// out. Each status is claimed with SKIP LOCKED in its own transaction, so
+// several workers can sweep side by side, and each ride is updated under its
+// own savepoint, so one that fails is reported in Failures instead of rolling
+// back the rest of the batch.
+func (s *RideService) ReapStuckRides(ctx context.Context, limit int) (StuckSweep, error) {
+	var sweep StuckSweep
+	for _, status := range stuckStatuses {
+		threshold := s.StuckRides.Threshold(status)
+		if threshold <= 0 {
+			continue
+		}
+		batch, err := runInTxSavepoints(s, func(savepoint savepointFunc, offers outbound.RideOfferRepo, repo outbound.RideRepo, _ outbound.IdempotencyRepo, outbox outbound.OutboxRepo) (StuckSweep, error) {
+			rows, err := repo.ClaimStuck(ctx, string(status), s.now().Add(-threshold), limit)
+			if err != nil {
+				return StuckSweep{}, err
+			}
+			var batch StuckSweep
+			for _, row := range rows {
+				ride := toDomainRide(row)
+				timedOut := !s.StuckRides.CanRedispatch(ride)
+				err := savepoint("stuck_ride", func() error {
+					if timedOut {
+						return s.timeOutRide(ctx, offers, repo, outbox, ride, reasonSLATimeout)
+					}
+					return s.redispatchRide(ctx, offers, repo, outbox, ride)
+				})
+				// A ride that moved on since it was claimed, e.g. matched
+				// or cancelled, is no longer stuck.
+				if errors.Is(err, outbound.ErrConflict) {
+					continue
+				}
+				if err != nil {
+					batch.Failures = append(batch.Failures, StuckFailure{RideID: ride.ID, Status: status, Err: err})
+					continue
+				}
+				batch.Reaped = append(batch.Reaped, StuckRide{RideID: ride.ID, Status: status, TimedOut: timedOut})
+			}
+			return batch, nil
+		})
+		if err != nil {
+			return sweep, err
+		}
+		sweep.Reaped = append(sweep.Reaped, batch.Reaped...)
+		sweep.Failures = append(sweep.Failures, batch.Failures...)
+	}
+	return sweep, nil
+}
+
+// redispatchRide emits ride.requested again. A ride stuck on an offer nobody
+// answered goes back to MATCHING first, since matching only offers rides in
+// MATCHING, and the offer is expired with it so the driver can no longer
+// accept it; ride.offer.expired goes out ahead of ride.requested.
+func (s *RideService) redispatchRide(ctx context.Context, offers outbound.RideOfferRepo, repo outbound.RideRepo, outbox outbound.OutboxRepo, ride domain.Ride) error {
+	next := ride.Status
+	if ride.Status == domain.StatusOffered {
+		next = domain.StatusMatching
+	}
+	now := s.now()
+	if err := repo.RedispatchIfCurrent(ctx, ride.ID, ride.Version, string(ride.Status), string(next), now); err != nil {
+		return err
+	}
+	if err := s.expirePendingOffers(ctx, offers, outbox, ride); err != nil {
+		return err
+	}
+	if err := s.appendEvent(ctx, repo, ride.ID, ride.Status, next, statusChange{Actor: domain.ActorSystem, Reason: reasonSLARedispatch}, now); err != nil {
+		return err
+	}
+	updated := ride
+	updated.Status = next
+	return s.enqueueEvent(ctx, outbox, eventsv1.TopicRideRequested, rideRequestedEvent(updated, 1))
+}
+
+// timeOutRide cancels a ride that was never matched, or a scheduled ride that
+// could not be dispatched in time, releasing the rider's payment hold in full.
+// An offer the ride was still waiting on is expired with it, ahead of
+// ride.cancelled, so the driver can no longer accept it.
+func (s *RideService) timeOutRide(ctx context.Context, offers outbound.RideOfferRepo, repo outbound.RideRepo, outbox outbound.OutboxRepo, ride domain.Ride, reason string) error {
+	updated, err := ride.Transition(domain.StatusCancelled)
+	if err != nil {
+		return err
+	}
+	now := s.now()
+	if err := repo.CancelIfCurrent(ctx, ride.ID, ride.Version, string(ride.Status), outbound.RideCancellation{Reason: string(domain.CancelSystemTimeout)}, now); err != nil {
+		return err
+	}
+	if err := s.expirePendingOffers(ctx, offers, outbox, ride); err != nil {
+		return err
+	}
+	if err := s.appendEvent(ctx, repo, ride.ID, ride.Status, updated.Status, statusChange{Actor: domain.ActorSystem, Reason: reason}, now); err != nil {
+		return err
+	}
+	if err := s.requestCapture(ctx, repo, ride.ID, 0); err != nil {
+		return err
+	}
+	return s.enqueueEvent(ctx, outbox, eventsv1.TopicRideCancelled, &eventsv1.RideCancelled{
+		RideId:     updated.ID,
+		Reason:     reason,
+		ReasonCode: string(domain.CancelSystemTimeout),
+		Actor:      string(domain.ActorSystem),
+		Status:     string(updated.Status),
+		RiderId:    updated.RiderID,
+		Currency:   updated.Currency,
+	})
+}
+
+// expirePendingOffers expires the offer an OFFERED ride is waiting on and
+// emits ride.offer.expired for it. Rides in other statuses have none.
+func (s *RideService) expirePendingOffers(ctx context.Context, offers outbound.RideOfferRepo, outbox outbound.OutboxRepo, ride domain.Ride) error {
+	if ride.Status != domain.StatusOffered {
+		return nil
+	}
+	expired, err := offers.ExpirePendingForRide(ctx, ride.ID)
+	if err != nil {
+		return err
+	}
+	for _, row := range expired {
+		if err := s.enqueueEvent(ctx, outbox, eventsv1.TopicRideOfferExpired, offerUpdatedEvent(toDomainOffer(row))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
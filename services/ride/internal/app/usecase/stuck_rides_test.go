@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+func newStuckRideService(t *testing.T) (*RideService, *fakeRideRepo, *fixedClock) {
+	t.Helper()
+	repo := newFakeRideRepo()
+	clock := &fixedClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	svc := &RideService{Repo: repo, Offers: &fakeOfferRepo{}, Outbox: &fakeOutboxRepo{}, Clock: clock, StuckRides: domain.DefaultStuckRidePolicy()}
+	return svc, repo, clock
+}
+
+func TestReapStuckRidesRedispatches(t *testing.T) {
+	svc, repo, clock := newStuckRideService(t)
+	outbox := svc.Outbox.(*fakeOutboxRepo)
+	ctx := context.Background()
+	policy := svc.StuckRides
+	repo.store["ride-matching"] = outbound.Ride{ID: "ride-matching", RiderID: "r1", Status: string(domain.StatusMatching), UpdatedAt: clock.now.Add(-policy.Matching)}
+	repo.store["ride-offered"] = outbound.Ride{ID: "ride-offered", RiderID: "r2", Status: string(domain.StatusOffered), UpdatedAt: clock.now.Add(-policy.Offered - time.Second)}
+	repo.store["ride-fresh"] = outbound.Ride{ID: "ride-fresh", RiderID: "r3", Status: string(domain.StatusMatching), UpdatedAt: clock.now.Add(-policy.Matching + time.Second)}
+
+	sweep, err := svc.ReapStuckRides(ctx, 10)
+	if err != nil {
+		t.Fatalf("reap error: %v", err)
+	}
+	reaped := sweep.Reaped
+	if len(reaped) != 2 || reaped[0].TimedOut || reaped[1].TimedOut {
+		t.Fatalf("expected two redispatched rides, got %+v", reaped)
+	}
+
+	matching := repo.store["ride-matching"]
+	if matching.Status != string(domain.StatusMatching) || matching.Redispatches != 1 || !matching.UpdatedAt.Equal(clock.now) {
+		t.Fatalf("expected matching ride redispatched in place, got %+v", matching)
+	}
+	offered := repo.store["ride-offered"]
+	if offered.Status != string(domain.StatusMatching) || offered.Redispatches != 1 {
+		t.Fatalf("expected offered ride back in matching, got %+v", offered)
+	}
+	if fresh := repo.store["ride-fresh"]; fresh.Redispatches != 0 || fresh.Version != 0 {
+		t.Fatalf("expected fresh ride untouched, got %+v", fresh)
+	}
+
+	var requested []string
+	for _, msg := range outbox.messages {
+		if msg.Topic == eventsv1.TopicRideRequested {
+			requested = append(requested, msg.Payload)
+		}
+	}
+	if len(requested) != 2 {
+		t.Fatalf("expected ride.requested for each stuck ride, got %d", len(requested))
+	}
+	events, _ := svc.GetRideTimeline(ctx, "ride-offered")
+	if got := events[len(events)-1]; got.Actor != domain.ActorSystem || got.Reason != reasonSLARedispatch || got.ToStatus != domain.StatusMatching {
+		t.Fatalf("expected redispatch on the timeline, got %+v", got)
+	}
+
+	// Redispatching refreshed updated_at, so the next sweep leaves them alone.
+	if again, err := svc.ReapStuckRides(ctx, 10); err != nil || len(again.Reaped) != 0 {
+		t.Fatalf("expected nothing stuck right after a redispatch, got %+v / %v", again, err)
+	}
+}
+
+func TestReapStuckRidesExpiresPendingOffer(t *testing.T) {
+	svc, repo, clock := newStuckRideService(t)
+	offers := svc.Offers.(*fakeOfferRepo)
+	outbox := svc.Outbox.(*fakeOutboxRepo)
+	ctx := context.Background()
+	repo.store["ride-1"] = outbound.Ride{ID: "ride-1", RiderID: "r1", Status: string(domain.StatusOffered), UpdatedAt: clock.now.Add(-svc.StuckRides.Offered)}
+	if err := offers.Create(ctx, outbound.RideOffer{ID: "offer-1", RideID: "ride-1", DriverID: "driver-1", Status: string(domain.OfferPending), ExpiresAt: clock.now.Add(time.Minute).Unix()}); err != nil {
+		t.Fatalf("create offer error: %v", err)
+	}
+
+	if sweep, err := svc.ReapStuckRides(ctx, 10); err != nil || len(sweep.Reaped) != 1 || sweep.Reaped[0].TimedOut {
+		t.Fatalf("expected the offered ride redispatched, got %+v / %v", sweep, err)
+	}
+	if got := offers.store["offer-1"].Status; got != string(domain.OfferExpired) {
+		t.Fatalf("expected the outstanding offer expired, got %s", got)
+	}
+	if _, err := svc.AcceptOffer(ctx, OfferActionCmd{OfferID: "offer-1"}); err == nil {
+		t.Fatalf("expected the expired offer no longer acceptable")
+	}
+
+	var topics []string
+	for _, msg := range outbox.messages {
+		topics = append(topics, msg.Topic)
+	}
+	if len(topics) != 2 || topics[0] != eventsv1.TopicRideOfferExpired || topics[1] != eventsv1.TopicRideRequested {
+		t.Fatalf("expected ride.offer.expired then ride.requested, got %v", topics)
+	}
+	if !strings.Contains(outbox.messages[0].Payload, `"offer_id":"offer-1"`) {
+		t.Fatalf("unexpected ride.offer.expired: %s", outbox.messages[0].Payload)
+	}
+}
+
+func TestReapStuckRidesTimesOut(t *testing.T) {
+	svc, repo, clock := newStuckRideService(t)
+	outbox := svc.Outbox.(*fakeOutboxRepo)
+	ctx := context.Background()
+	repo.store["ride-1"] = outbound.Ride{ID: "ride-1", RiderID: "r1", Status: string(domain.StatusRequested), UpdatedAt: clock.now}
+
+	for i := 0; i < svc.StuckRides.MaxRedispatches; i++ {
+		clock.now = clock.now.Add(svc.StuckRides.Requested)
+		sweep, err := svc.ReapStuckRides(ctx, 10)
+		if err != nil || len(sweep.Reaped) != 1 || sweep.Reaped[0].TimedOut {
+			t.Fatalf("sweep %d: expected a redispatch, got %+v / %v", i+1, sweep, err)
+		}
+	}
+
+	clock.now = clock.now.Add(svc.StuckRides.Requested)
+	sweep, err := svc.ReapStuckRides(ctx, 10)
+	if err != nil {
+		t.Fatalf("reap error: %v", err)
+	}
+	reaped := sweep.Reaped
+	if len(reaped) != 1 || !reaped[0].TimedOut || reaped[0].Status != domain.StatusRequested {
+		t.Fatalf("expected the ride timed out from REQUESTED, got %+v", reaped)
+	}
+	stored := repo.store["ride-1"]
+	if stored.Status != string(domain.StatusCancelled) || stored.CancelReason == nil || *stored.CancelReason != string(domain.CancelSystemTimeout) {
+		t.Fatalf("expected SYSTEM_TIMEOUT cancellation, got %+v", stored)
+	}
+	msg, ok := lastMessage(outbox, eventsv1.TopicRideCancelled)
+	if !ok || !strings.Contains(msg.Payload, `"reason_code":"SYSTEM_TIMEOUT"`) || !strings.Contains(msg.Payload, `"actor":"system"`) {
+		t.Fatalf("unexpected ride.cancelled: %s", msg.Payload)
+	}
+}
+
+func TestReapStuckRidesTimesOutOfferedRideAndExpiresOffer(t *testing.T) {
+	svc, repo, clock := newStuckRideService(t)
+	offers := svc.Offers.(*fakeOfferRepo)
+	outbox := svc.Outbox.(*fakeOutboxRepo)
+	ctx := context.Background()
+	repo.store["ride-1"] = outbound.Ride{ID: "ride-1", RiderID: "r1", Status: string(domain.StatusOffered), Redispatches: svc.StuckRides.MaxRedispatches, UpdatedAt: clock.now.Add(-svc.StuckRides.Offered)}
+	if err := offers.Create(ctx, outbound.RideOffer{ID: "offer-1", RideID: "ride-1", DriverID: "driver-1", Status: string(domain.OfferPending), ExpiresAt: clock.now.Add(time.Minute).Unix()}); err != nil {
+		t.Fatalf("create offer error: %v", err)
+	}
+
+	if sweep, err := svc.ReapStuckRides(ctx, 10); err != nil || len(sweep.Reaped) != 1 || !sweep.Reaped[0].TimedOut {
+		t.Fatalf("expected the offered ride timed out, got %+v / %v", sweep, err)
+	}
+	if got := offers.store["offer-1"].Status; got != string(domain.OfferExpired) {
+		t.Fatalf("expected the outstanding offer expired, got %s", got)
+	}
+	var topics []string
+	for _, msg := range outbox.messages {
+		topics = append(topics, msg.Topic)
+	}
+	if len(topics) != 2 || topics[0] != eventsv1.TopicRideOfferExpired || topics[1] != eventsv1.TopicRideCancelled {
+		t.Fatalf("expected ride.offer.expired then ride.cancelled, got %v", topics)
+	}
+}
+
+func TestReapStuckRidesReportsFailedRide(t *testing.T) {
+	svc, repo, clock := newStuckRideService(t)
+	ctx := context.Background()
+	repo.store["ride-1"] = outbound.Ride{ID: "ride-1", RiderID: "r1", Status: string(domain.StatusMatching), UpdatedAt: clock.now.Add(-svc.StuckRides.Matching)}
+	repo.store["ride-2"] = outbound.Ride{ID: "ride-2", RiderID: "r2", Status: string(domain.StatusMatching), UpdatedAt: clock.now.Add(-svc.StuckRides.Matching)}
+	svc.Repo = eventFailingRepo{fakeRideRepo: repo, rideID: "ride-1"}
+
+	sweep, err := svc.ReapStuckRides(ctx, 10)
+	if err != nil {
+		t.Fatalf("reap error: %v", err)
+	}
+	if len(sweep.Reaped) != 1 || sweep.Reaped[0].RideID != "ride-2" {
+		t.Fatalf("expected the healthy ride redispatched, got %+v", sweep.Reaped)
+	}
+	if len(sweep.Failures) != 1 || sweep.Failures[0].RideID != "ride-1" || sweep.Failures[0].Status != domain.StatusMatching {
+		t.Fatalf("expected the failing ride reported, got %+v", sweep.Failures)
+	}
+}
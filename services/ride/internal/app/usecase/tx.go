@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+// withTx is runInTx for the common case of writing rides and the outbox. The
+// row locks taken by claim queries inside fn are held until it commits.
+func (s *RideService) withTx(_ context.Context, fn func(repo outbound.RideRepo, outbox outbound.OutboxRepo) error) error {
+	_, err := runInTx(s, func(_ outbound.RideOfferRepo, rides outbound.RideRepo, _ outbound.IdempotencyRepo, outbox outbound.OutboxRepo) (struct{}, error) {
+		return struct{}{}, fn(rides, outbox)
+	})
+	return err
+}
+
+// runInTx hands fn the transaction's repositories, or the service's own when
+// no transaction manager is configured.
+func runInTx[T any](s *RideService, fn func(offers outbound.RideOfferRepo, rides outbound.RideRepo, idem outbound.IdempotencyRepo, outbox outbound.OutboxRepo) (T, error)) (T, error) {
+	return runInTxSavepoints(s, func(_ savepointFunc, offers outbound.RideOfferRepo, rides outbound.RideRepo, idem outbound.IdempotencyRepo, outbox outbound.OutboxRepo) (T, error) {
+		return fn(offers, rides, idem, outbox)
+	})
+}
+
+// savepointFunc runs fn under a savepoint of the current transaction, so a
+// failing fn only undoes its own writes.
+type savepointFunc func(name string, fn func() error) error
+
+// runInTxSavepoints is runInTx for batches that isolate each row's work with
+// savepoints. Without a transaction manager fn's writes cannot be undone, and
+// savepoint just runs fn.
+func runInTxSavepoints[T any](s *RideService, fn func(savepoint savepointFunc, offers outbound.RideOfferRepo, rides outbound.RideRepo, idem outbound.IdempotencyRepo, outbox outbound.OutboxRepo) (T, error)) (T, error) {
+	var zero T
+	if s.TxManager == nil {
+		savepoint := func(_ string, fn func() error) error { return fn() }
+		return fn(savepoint, s.Offers, s.Repo, s.Idempotency, s.Outbox)
+	}
+	tx, err := s.TxManager.Begin()
+	if err != nil {
+		return zero, err
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	result, err := fn(tx.Savepoint, tx.RideOfferRepo(), tx.RideRepo(), tx.IdempotencyRepo(), tx.OutboxRepo())
+	if err != nil {
+		return zero, err
+	}
+	if err := tx.Commit(); err != nil {
+		return zero, err
+	}
+	tx = nil
+	return result, nil
+}
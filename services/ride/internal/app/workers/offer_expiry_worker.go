@@ -4,21 +4,24 @@ import (
 	"context"
 	"time"
 
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/metrics"
 	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/usecase"
-	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
 	"go.uber.org/zap"
 )
 
+// OfferExpiryWorker expires offers drivers let run out. Every replica runs
+// one; each sweep claims its own batch, so they share the backlog instead of
+// racing on it.
 type OfferExpiryWorker struct {
-	Repo     outbound.RideOfferRepo
 	Usecase  *usecase.RideService
+	Metrics  *metrics.OfferExpiryMetrics
 	Logger   *zap.Logger
 	Interval time.Duration
 	Batch    int
 }
 
 func (w *OfferExpiryWorker) Run(ctx context.Context) {
-	if w.Repo == nil || w.Usecase == nil || w.Logger == nil {
+	if w.Usecase == nil || w.Logger == nil {
 		return
 	}
 	interval := w.Interval
@@ -43,17 +46,29 @@ func (w *OfferExpiryWorker) Run(ctx context.Context) {
 	}
 }
 
+// expire sweeps until a batch comes back short, so a backlog is worked off in
+// one tick rather than one batch per interval.
 func (w *OfferExpiryWorker) expire(ctx context.Context, batch int) {
-	cutoff := time.Now().UTC().Unix()
-	offers, err := w.Repo.ListExpired(ctx, cutoff, batch)
-	if err != nil {
-		w.Logger.Warn("offers.expire_scan_failed", zap.Error(err))
-		return
-	}
-	for _, offer := range offers {
-		_, err := w.Usecase.ExpireOffer(ctx, usecase.OfferActionCmd{OfferID: offer.ID})
+	for ctx.Err() == nil {
+		sweep, err := w.Usecase.ExpireDueOffers(ctx, batch)
 		if err != nil {
-			w.Logger.Warn("offers.expire_failed", zap.String("offer_id", offer.ID), zap.Error(err))
+			w.Logger.Warn("offers.expire_failed", zap.Error(err))
+			return
+		}
+		for _, failure := range sweep.Failures {
+			w.Logger.Warn("offers.expire_ride_failed",
+				zap.String("offer_id", failure.OfferID),
+				zap.String("ride_id", failure.RideID),
+				zap.Error(failure.Err),
+			)
+		}
+		w.Metrics.AddExpired(sweep.Expired)
+		w.Metrics.ObserveSweep(sweep.Lag)
+		if sweep.Expired > 0 {
+			w.Logger.Info("offers.expired", zap.Int("count", sweep.Expired), zap.Duration("scan_lag", sweep.Lag))
+		}
+		if sweep.Expired < batch {
+			return
 		}
 	}
 }
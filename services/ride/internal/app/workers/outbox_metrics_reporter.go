@@ -9,13 +9,15 @@ import (
 )
 
 type MetricsReporter struct {
-	Outbox   *metrics.OutboxMetrics
-	Logger   *zap.Logger
-	Interval time.Duration
+	Outbox      *metrics.OutboxMetrics
+	StuckRides  *metrics.StuckRideMetrics
+	OfferExpiry *metrics.OfferExpiryMetrics
+	Logger      *zap.Logger
+	Interval    time.Duration
 }
 
 func (r *MetricsReporter) Run(ctx context.Context) {
-	if r.Outbox == nil || r.Logger == nil {
+	if (r.Outbox == nil && r.StuckRides == nil && r.OfferExpiry == nil) || r.Logger == nil {
 		return
 	}
 	interval := r.Interval
@@ -30,12 +32,38 @@ func (r *MetricsReporter) Run(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			r.Logger.Info("outbox.metrics",
-				zap.Int64("claimed", r.Outbox.Claimed()),
-				zap.Int64("published", r.Outbox.Published()),
-				zap.Int64("failed", r.Outbox.Failed()),
-				zap.Int64("dlq", r.Outbox.DLQ()),
-			)
+			r.report()
 		}
 	}
 }
+
+func (r *MetricsReporter) report() {
+	if r.OfferExpiry != nil {
+		r.Logger.Info("offers.expiry_metrics",
+			zap.Int64("expired", r.OfferExpiry.Expired()),
+			zap.Int64("sweeps", r.OfferExpiry.Sweeps()),
+			zap.Duration("scan_lag", r.OfferExpiry.ScanLag()),
+		)
+	}
+	if r.StuckRides != nil {
+		r.Logger.Info("rides.stuck_metrics",
+			zap.Any("redispatched", r.StuckRides.Redispatched()),
+			zap.Any("timed_out", r.StuckRides.TimedOut()),
+		)
+	}
+	if r.Outbox == nil {
+		return
+	}
+	r.Logger.Info("outbox.metrics",
+		zap.Int64("claimed", r.Outbox.Claimed()),
+		zap.Int64("published", r.Outbox.Published()),
+		zap.Int64("failed", r.Outbox.Failed()),
+		zap.Int64("dlq", r.Outbox.DLQ()),
+		zap.Int64("reclaimed", r.Outbox.Reclaimed()),
+		zap.Int64("latency_count", r.Outbox.LatencyCount()),
+		zap.Duration("latency_mean", r.Outbox.LatencyMean()),
+		zap.Duration("latency_p50", r.Outbox.LatencyQuantile(0.5)),
+		zap.Duration("latency_p99", r.Outbox.LatencyQuantile(0.99)),
+		zap.Int64s("latency_buckets", r.Outbox.LatencyHistogram()),
+	)
+}
@@ -0,0 +1,66 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/metrics"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+	"go.uber.org/zap"
+)
+
+// OutboxReaper recovers outbox rows left in PROCESSING by a worker that died
+// between Claim and MarkSent/MarkFailed. Once their lease runs out they go
+// back to PENDING for any worker to publish.
+type OutboxReaper struct {
+	Repo        outbound.OutboxRepo
+	Logger      *zap.Logger
+	Metrics     *metrics.OutboxMetrics
+	BatchSize   int
+	MaxAttempts int
+	Interval    time.Duration
+}
+
+func (r *OutboxReaper) Run(ctx context.Context) {
+	if r.Repo == nil || r.Logger == nil {
+		return
+	}
+	interval := r.Interval
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.Reap(ctx)
+		}
+	}
+}
+
+// Reap reclaims one batch of expired leases.
+func (r *OutboxReaper) Reap(ctx context.Context) {
+	batch := r.BatchSize
+	if batch <= 0 {
+		batch = 100
+	}
+	reclaim, err := r.Repo.ReclaimExpired(ctx, batch, r.MaxAttempts)
+	if err != nil {
+		r.Logger.Warn("outbox.reclaim_failed", zap.Error(err))
+		return
+	}
+	if reclaim.Requeued == 0 && reclaim.Failed == 0 {
+		return
+	}
+	r.Metrics.IncReclaimed(reclaim.Requeued + reclaim.Failed)
+	r.Metrics.AddDLQ(reclaim.Failed)
+	r.Logger.Warn("outbox.reclaimed",
+		zap.Int64("requeued", reclaim.Requeued),
+		zap.Int64("dlq", reclaim.Failed),
+	)
+}
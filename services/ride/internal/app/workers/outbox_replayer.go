@@ -22,13 +22,13 @@ func (r *OutboxReplayer) ReplayFailed(ctx context.Context) {
 	if batch <= 0 {
 		batch = 50
 	}
-	messages, err := r.Repo.Claim(ctx, batch, 1)
+	messages, err := r.Repo.Claim(ctx, batch, 1, 0)
 	if err != nil {
 		r.Logger.Warn("outbox.replay_claim_failed", zap.Error(err))
 		return
 	}
 	for _, msg := range messages {
 		r.Logger.Info("outbox.replay_queued", zap.String("id", msg.ID), zap.String("topic", msg.Topic))
-		_ = r.Repo.MarkFailed(ctx, msg.ID, "replay", time.Now().UTC())
+		_ = r.Repo.MarkFailed(ctx, msg, "replay", time.Now().UTC())
 	}
 }
@@ -2,6 +2,8 @@ package workers
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"time"
 
 	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/metrics"
@@ -17,6 +19,15 @@ type OutboxWorker struct {
 	BatchSize   int
 	MaxAttempts int
 	Interval    time.Duration
+	// Lease is how long a claimed batch may stay PROCESSING before
+	// OutboxReaper hands it to another worker; it must outlast a batch publish.
+	Lease time.Duration
+	// Concurrency bounds how many aggregates publish at once.
+	Concurrency int
+	// Wake, when set, triggers a flush as soon as something is enqueued
+	// (see db.OutboxListener). The Interval ticker stays as a safety net for
+	// missed notifications and backed-off retries.
+	Wake <-chan struct{}
 }
 
 func (w *OutboxWorker) Run(ctx context.Context) {
@@ -44,47 +55,143 @@ func (w *OutboxWorker) Run(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			w.flush(ctx, batch, maxAttempts)
+		case <-w.Wake:
+		}
+		// A full batch means more may be waiting; keep going until drained.
+		for ctx.Err() == nil {
+			if w.flush(ctx, batch, maxAttempts) < batch {
+				break
+			}
 		}
 	}
 }
 
-func (w *OutboxWorker) flush(ctx context.Context, batch int, maxAttempts int) {
-	messages, err := w.Repo.Claim(ctx, batch, maxAttempts)
+// flush publishes one claimed batch and reports how many rows it claimed.
+func (w *OutboxWorker) flush(ctx context.Context, batch int, maxAttempts int) int {
+	messages, err := w.Repo.Claim(ctx, batch, maxAttempts, w.Lease)
 	if err != nil {
 		w.Logger.Warn("outbox.claim_failed", zap.Error(err))
-		return
+		return 0
 	}
 	w.Metrics.IncClaimed(len(messages))
-	for _, msg := range messages {
-		if err := w.Publisher.Publish(ctx, msg.Topic, []byte(msg.Payload)); err != nil {
-			nextAttempt := time.Now().UTC().Add(backoffDuration(msg.Attempt))
-			if msg.Attempt >= maxAttempts {
-				_ = w.Repo.MarkFailed(ctx, msg.ID, err.Error(), time.Time{})
-				w.Metrics.IncDLQ()
-				w.Logger.Warn("outbox.dlq",
-					zap.String("id", msg.ID),
-					zap.String("topic", msg.Topic),
-					zap.Int("attempt", msg.Attempt),
-					zap.Error(err),
-				)
-				continue
+
+	// Each ride's events go out in order on their own goroutine, so a ride
+	// stuck behind a failing event does not hold back the others.
+	concurrency := w.Concurrency
+	if concurrency <= 0 {
+		concurrency = 8
+	}
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for _, group := range groupByAggregate(messages) {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(group []outbound.OutboxMessage) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			w.publishInOrder(ctx, group, maxAttempts)
+		}(group)
+	}
+	wg.Wait()
+	return len(messages)
+}
+
+// publishInOrder publishes one aggregate's messages in sequence. Once one
+// fails, the rest are handed back unpublished to wait behind it. Once the
+// batch's lease turns out to be lost, the rest belong to whichever worker
+// reclaimed them and are left alone.
+func (w *OutboxWorker) publishInOrder(ctx context.Context, messages []outbound.OutboxMessage, maxAttempts int) {
+	for i, msg := range messages {
+		if err := w.Publisher.PublishMsg(ctx, msg.Topic, msg.ID, []byte(msg.Payload)); err != nil {
+			if w.markFailed(ctx, msg, maxAttempts, err) {
+				return
 			}
-			_ = w.Repo.MarkFailed(ctx, msg.ID, err.Error(), nextAttempt)
-			w.Metrics.IncFailed()
-			w.Logger.Warn("outbox.publish_failed",
-				zap.String("id", msg.ID),
-				zap.String("topic", msg.Topic),
-				zap.Int("attempt", msg.Attempt),
-				zap.Error(err),
-			)
-			continue
+			for _, held := range messages[i+1:] {
+				if err := w.Repo.Unclaim(ctx, held); errors.Is(err, outbound.ErrLeaseLost) {
+					w.leaseLost(held)
+					return
+				} else if err != nil {
+					w.Logger.Warn("outbox.unclaim_failed", zap.String("id", held.ID), zap.Error(err))
+				}
+			}
+			return
 		}
-		if err := w.Repo.MarkSent(ctx, msg.ID); err != nil {
+		if err := w.Repo.MarkSent(ctx, msg); errors.Is(err, outbound.ErrLeaseLost) {
+			// Published all the same; the broker drops the copy the new
+			// holder sends, since the message ID is its dedup key.
+			w.leaseLost(msg)
+			return
+		} else if err != nil {
 			w.Logger.Warn("outbox.mark_sent_failed", zap.String("id", msg.ID), zap.Error(err))
 		}
 		w.Metrics.IncPublished()
+		if !msg.CreatedAt.IsZero() {
+			w.Metrics.ObserveLatency(time.Since(msg.CreatedAt))
+		}
+	}
+}
+
+// markFailed records a failed publish and reports whether the lease on msg
+// was lost, in which case nothing was recorded.
+func (w *OutboxWorker) markFailed(ctx context.Context, msg outbound.OutboxMessage, maxAttempts int, err error) (lost bool) {
+	if msg.Attempt >= maxAttempts {
+		if markErr := w.Repo.MarkFailed(ctx, msg, err.Error(), time.Time{}); errors.Is(markErr, outbound.ErrLeaseLost) {
+			w.leaseLost(msg)
+			return true
+		}
+		w.Metrics.IncDLQ()
+		w.Logger.Warn("outbox.dlq",
+			zap.String("id", msg.ID),
+			zap.String("topic", msg.Topic),
+			zap.String("aggregate_id", msg.AggregateID),
+			zap.Int("attempt", msg.Attempt),
+			zap.Error(err),
+		)
+		return false
+	}
+	nextAttempt := time.Now().UTC().Add(backoffDuration(msg.Attempt))
+	if markErr := w.Repo.MarkFailed(ctx, msg, err.Error(), nextAttempt); errors.Is(markErr, outbound.ErrLeaseLost) {
+		w.leaseLost(msg)
+		return true
+	}
+	w.Metrics.IncFailed()
+	w.Logger.Warn("outbox.publish_failed",
+		zap.String("id", msg.ID),
+		zap.String("topic", msg.Topic),
+		zap.String("aggregate_id", msg.AggregateID),
+		zap.Int("attempt", msg.Attempt),
+		zap.Error(err),
+	)
+	return false
+}
+
+func (w *OutboxWorker) leaseLost(msg outbound.OutboxMessage) {
+	w.Logger.Warn("outbox.lease_lost",
+		zap.String("id", msg.ID),
+		zap.String("topic", msg.Topic),
+		zap.String("aggregate_id", msg.AggregateID),
+	)
+}
+
+// groupByAggregate splits a claimed batch into per-aggregate runs, keeping
+// claim order within each. Messages without an aggregate stand alone.
+func groupByAggregate(messages []outbound.OutboxMessage) [][]outbound.OutboxMessage {
+	groups := make([][]outbound.OutboxMessage, 0, len(messages))
+	index := map[string]int{}
+	for _, msg := range messages {
+		if msg.AggregateID == "" {
+			groups = append(groups, []outbound.OutboxMessage{msg})
+			continue
+		}
+		i, ok := index[msg.AggregateID]
+		if !ok {
+			i = len(groups)
+			index[msg.AggregateID] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], msg)
 	}
+	return groups
 }
 
 func backoffDuration(attempt int) time.Duration {
@@ -0,0 +1,50 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/usecase"
+	"go.uber.org/zap"
+)
+
+// PaymentWorker settles the captures and voids the ride flow requested, so a
+// slow or failing provider never holds up completing or cancelling a ride.
+type PaymentWorker struct {
+	Usecase  *usecase.RideService
+	Logger   *zap.Logger
+	Interval time.Duration
+	Batch    int
+}
+
+func (w *PaymentWorker) Run(ctx context.Context) {
+	if w.Usecase == nil || w.Logger == nil {
+		return
+	}
+	interval := w.Interval
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	batch := w.Batch
+	if batch <= 0 {
+		batch = 50
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			settled, err := w.Usecase.SettlePayments(ctx, batch)
+			if err != nil {
+				w.Logger.Warn("payments.settle_failed", zap.Error(err))
+			}
+			if settled > 0 {
+				w.Logger.Info("payments.settled", zap.Int("count", settled))
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/usecase"
+	"go.uber.org/zap"
+)
+
+// ScheduledRideWorker reminds riders of upcoming bookings and hands scheduled
+// rides to matching once they are within the dispatch lead time.
+type ScheduledRideWorker struct {
+	Usecase  *usecase.RideService
+	Logger   *zap.Logger
+	Interval time.Duration
+	Batch    int
+}
+
+func (w *ScheduledRideWorker) Run(ctx context.Context) {
+	if w.Usecase == nil || w.Logger == nil {
+		return
+	}
+	interval := w.Interval
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	batch := w.Batch
+	if batch <= 0 {
+		batch = 50
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.tick(ctx, batch)
+		}
+	}
+}
+
+func (w *ScheduledRideWorker) tick(ctx context.Context, batch int) {
+	sent, err := w.Usecase.SendScheduledReminders(ctx, batch)
+	if err != nil {
+		w.Logger.Warn("rides.scheduled_reminder_failed", zap.Error(err))
+	} else if sent > 0 {
+		w.Logger.Info("rides.scheduled_reminded", zap.Int("count", sent))
+	}
+	run, err := w.Usecase.DispatchScheduledRides(ctx, batch)
+	if err != nil {
+		w.Logger.Warn("rides.scheduled_dispatch_failed", zap.Error(err))
+		return
+	}
+	for _, failure := range run.Failures {
+		w.Logger.Warn("rides.scheduled_dispatch_ride_failed", zap.String("ride_id", failure.RideID), zap.Error(failure.Err))
+	}
+	if run.Dispatched > 0 {
+		w.Logger.Info("rides.scheduled_dispatched", zap.Int("count", run.Dispatched))
+	}
+}
@@ -0,0 +1,65 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/metrics"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/usecase"
+	"go.uber.org/zap"
+)
+
+// StuckRideWorker sweeps rides left in REQUESTED, MATCHING or OFFERED past the
+// SLA, re-announcing them to matching and finally timing them out.
+type StuckRideWorker struct {
+	Usecase  *usecase.RideService
+	Metrics  *metrics.StuckRideMetrics
+	Logger   *zap.Logger
+	Interval time.Duration
+	Batch    int
+}
+
+func (w *StuckRideWorker) Run(ctx context.Context) {
+	if w.Usecase == nil || w.Logger == nil {
+		return
+	}
+	interval := w.Interval
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	batch := w.Batch
+	if batch <= 0 {
+		batch = 50
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.reap(ctx, batch)
+		}
+	}
+}
+
+func (w *StuckRideWorker) reap(ctx context.Context, batch int) {
+	sweep, err := w.Usecase.ReapStuckRides(ctx, batch)
+	if err != nil {
+		w.Logger.Warn("rides.stuck_reap_failed", zap.Error(err))
+	}
+	for _, failure := range sweep.Failures {
+		w.Logger.Warn("rides.stuck_ride_failed", zap.String("ride_id", failure.RideID), zap.String("status", string(failure.Status)), zap.Error(failure.Err))
+	}
+	for _, ride := range sweep.Reaped {
+		if ride.TimedOut {
+			w.Metrics.IncTimedOut(string(ride.Status))
+			w.Logger.Warn("rides.stuck_timed_out", zap.String("ride_id", ride.RideID), zap.String("status", string(ride.Status)))
+			continue
+		}
+		w.Metrics.IncRedispatched(string(ride.Status))
+		w.Logger.Info("rides.stuck_redispatched", zap.String("ride_id", ride.RideID), zap.String("status", string(ride.Status)))
+	}
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrRiderHasActiveRide  = errors.New("rider already has an active ride")
+	ErrDriverHasActiveRide = errors.New("driver already has an active ride")
+)
+
+// ActiveStatuses are the statuses in which a ride ties up its rider and, once
+// assigned, its driver. Neither may hold a second ride in one of them; the
+// rides_rider_active_idx and rides_driver_active_idx unique indexes enforce
+// the same set. Scheduled rides only count once they are dispatched.
+var ActiveStatuses = []RideStatus{
+	StatusRequested,
+	StatusMatching,
+	StatusOffered,
+	StatusDriverAssigned,
+	StatusDriverArrived,
+	StatusInProgress,
+}
+
+func (r Ride) IsActive() bool {
+	for _, status := range ActiveStatuses {
+		if r.Status == status {
+			return true
+		}
+	}
+	return false
+}
+
+// ActiveRideError is returned when a rider or driver already has an active
+// ride. RideID names that ride so the client can resume it; it is empty when
+// the ride finished before it could be looked up. Err is ErrRiderHasActiveRide
+// or ErrDriverHasActiveRide.
+type ActiveRideError struct {
+	Err    error
+	RideID string
+}
+
+func (e *ActiveRideError) Error() string {
+	if e.RideID == "" {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%v: %s", e.Err, e.RideID)
+}
+
+func (e *ActiveRideError) Unwrap() error {
+	return e.Err
+}
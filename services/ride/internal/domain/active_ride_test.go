@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRideIsActive(t *testing.T) {
+	active := map[RideStatus]bool{
+		StatusScheduled:      false,
+		StatusRequested:      true,
+		StatusMatching:       true,
+		StatusOffered:        true,
+		StatusDriverAssigned: true,
+		StatusDriverArrived:  true,
+		StatusInProgress:     true,
+		StatusCompleted:      false,
+		StatusCancelled:      false,
+	}
+	for status, want := range active {
+		if got := (Ride{Status: status}).IsActive(); got != want {
+			t.Fatalf("%s: expected active=%v, got %v", status, want, got)
+		}
+	}
+}
+
+func TestActiveRideError(t *testing.T) {
+	err := error(&ActiveRideError{Err: ErrDriverHasActiveRide, RideID: "ride-1"})
+	if !errors.Is(err, ErrDriverHasActiveRide) || errors.Is(err, ErrRiderHasActiveRide) {
+		t.Fatalf("expected the driver sentinel, got %v", err)
+	}
+	if err.Error() != "driver already has an active ride: ride-1" {
+		t.Fatalf("unexpected message %q", err.Error())
+	}
+}
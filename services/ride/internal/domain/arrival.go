@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrNoShowTooEarly = errors.New("rider no-show too early")
+
+// ArrivalPolicy decides when a driver counts as arrived at the pickup and how
+// long they must then wait for the rider. A driver within RadiusMeters of the
+// pickup has arrived; once GracePeriod has passed since arrival they may cancel
+// the ride as a rider no-show, and the rider is charged NoShowFee.
+type ArrivalPolicy struct {
+	RadiusMeters float64
+	GracePeriod  time.Duration
+	NoShowFee    int64
+}
+
+func DefaultArrivalPolicy() ArrivalPolicy {
+	return ArrivalPolicy{
+		RadiusMeters: 100,
+		GracePeriod:  5 * time.Minute,
+		NoShowFee:    10000,
+	}
+}
+
+// WithinRadius reports whether a driver at lat/lng is close enough to the
+// ride's pickup to have arrived.
+func (p ArrivalPolicy) WithinRadius(ride Ride, lat float64, lng float64) bool {
+	return HaversineMeters(ride.PickupLat, ride.PickupLng, lat, lng) <= p.RadiusMeters
+}
+
+// NoShowAt is when the driver may start treating the rider as a no-show.
+func (p ArrivalPolicy) NoShowAt(ride Ride) time.Time {
+	return ride.ArrivedAt.Add(p.GracePeriod)
+}
+
+// CheckNoShow allows a no-show cancellation only for a ride whose driver has
+// arrived and waited out the grace period.
+func (p ArrivalPolicy) CheckNoShow(ride Ride, now time.Time) error {
+	if ride.Status != StatusDriverArrived || ride.ArrivedAt.IsZero() {
+		return ErrInvalidTransition
+	}
+	if now.Before(p.NoShowAt(ride)) {
+		return ErrNoShowTooEarly
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestArrivalPolicyWithinRadius(t *testing.T) {
+	p := DefaultArrivalPolicy()
+	ride := Ride{PickupLat: -6.2, PickupLng: 106.8}
+	if !p.WithinRadius(ride, -6.2005, 106.8) {
+		t.Fatalf("expected a driver ~55m away to have arrived")
+	}
+	if p.WithinRadius(ride, -6.202, 106.8) {
+		t.Fatalf("expected a driver ~220m away not to have arrived")
+	}
+}
+
+func TestArrivalPolicyCheckNoShow(t *testing.T) {
+	p := DefaultArrivalPolicy()
+	arrivedAt := time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)
+	arrived := Ride{Status: StatusDriverArrived, ArrivedAt: arrivedAt}
+	tests := []struct {
+		name string
+		ride Ride
+		now  time.Time
+		want error
+	}{
+		{"within_grace", arrived, arrivedAt.Add(p.GracePeriod - time.Second), ErrNoShowTooEarly},
+		{"after_grace", arrived, arrivedAt.Add(p.GracePeriod), nil},
+		{"not_arrived", Ride{Status: StatusDriverAssigned}, arrivedAt.Add(time.Hour), ErrInvalidTransition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := p.CheckNoShow(tt.ride, tt.now); err != tt.want {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
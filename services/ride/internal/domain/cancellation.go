@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidCancelReason     = errors.New("invalid cancel reason")
+	ErrCancellationFeeRequired = errors.New("cancellation fee required")
+)
+
+// CancelReason is the typed reason recorded on a cancelled ride.
+type CancelReason string
+
+const (
+	CancelRiderChangedMind       CancelReason = "RIDER_CHANGED_MIND"
+	CancelRiderDriverTooFar      CancelReason = "RIDER_DRIVER_TOO_FAR"
+	CancelRiderWaitTooLong       CancelReason = "RIDER_WAIT_TOO_LONG"
+	CancelDriverVehicleIssue     CancelReason = "DRIVER_VEHICLE_ISSUE"
+	CancelDriverRiderUnreachable CancelReason = "DRIVER_RIDER_UNREACHABLE"
+	CancelRiderNoShow            CancelReason = "RIDER_NO_SHOW"
+	CancelNoDriver               CancelReason = "NO_DRIVER"
+	CancelSystemTimeout          CancelReason = "SYSTEM_TIMEOUT"
+	CancelOther                  CancelReason = "OTHER"
+)
+
+// ParseCancelReason accepts the known reasons; an empty value means OTHER.
+func ParseCancelReason(value string) (CancelReason, error) {
+	switch CancelReason(value) {
+	case "":
+		return CancelOther, nil
+	case CancelRiderChangedMind, CancelRiderDriverTooFar, CancelRiderWaitTooLong,
+		CancelDriverVehicleIssue, CancelDriverRiderUnreachable, CancelRiderNoShow,
+		CancelNoDriver, CancelSystemTimeout, CancelOther:
+		return CancelReason(value), nil
+	default:
+		return "", ErrInvalidCancelReason
+	}
+}
+
+// CancellationPolicy decides what a cancellation costs. Riders cancel free
+// until a driver has been assigned for longer than FreeWindow; after that they
+// pay RiderFee and take a strike. Drivers never pay but every cancellation of
+// an assigned ride is a strike. Strikes are counted over StrikeWindow.
+type CancellationPolicy struct {
+	FreeWindow   time.Duration
+	RiderFee     int64
+	StrikeWindow time.Duration
+	ConfirmTTL   time.Duration
+}
+
+func DefaultCancellationPolicy() CancellationPolicy {
+	return CancellationPolicy{
+		FreeWindow:   2 * time.Minute,
+		RiderFee:     5000,
+		StrikeWindow: 30 * 24 * time.Hour,
+		ConfirmTTL:   2 * time.Minute,
+	}
+}
+
+// CancellationDecision is the outcome of the policy for one cancellation.
+type CancellationDecision struct {
+	Fee    int64
+	Strike bool
+}
+
+// Decide applies the policy to a ride about to be cancelled by actor.
+func (p CancellationPolicy) Decide(ride Ride, actor Actor, now time.Time) CancellationDecision {
+	if !ride.AwaitingPickup() {
+		return CancellationDecision{}
+	}
+	switch actor {
+	case ActorRider:
+		assignedAt := ride.AssignedAt
+		if assignedAt.IsZero() {
+			assignedAt = ride.UpdatedAt
+		}
+		if now.Sub(assignedAt) <= p.FreeWindow {
+			return CancellationDecision{}
+		}
+		return CancellationDecision{Fee: p.RiderFee, Strike: true}
+	case ActorDriver:
+		return CancellationDecision{Strike: true}
+	}
+	return CancellationDecision{}
+}
+
+// CancellationQuote is the fee a rider is shown before confirming a
+// cancellation; it is handed out as a signed token valid until ExpiresAt.
+type CancellationQuote struct {
+	RideID    string    `json:"ride_id"`
+	RiderID   string    `json:"rider_id"`
+	Fee       int64     `json:"fee"`
+	Currency  string    `json:"currency"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Confirms reports whether the quote accepts a fee for the ride at now.
+func (q CancellationQuote) Confirms(ride Ride, fee int64, now time.Time) bool {
+	return q.RideID == ride.ID && q.RiderID == ride.RiderID && q.Fee >= fee && now.Before(q.ExpiresAt)
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCancellationPolicyDecide(t *testing.T) {
+	p := DefaultCancellationPolicy()
+	now := time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)
+	driverID := "driver-1"
+	assigned := Ride{ID: "ride-1", Status: StatusDriverAssigned, DriverID: &driverID}
+	tests := []struct {
+		name       string
+		ride       Ride
+		actor      Actor
+		wantFee    int64
+		wantStrike bool
+	}{
+		{"rider_before_assignment", Ride{Status: StatusMatching}, ActorRider, 0, false},
+		{"rider_within_free_window", withAssignedAt(assigned, now.Add(-p.FreeWindow)), ActorRider, 0, false},
+		{"rider_after_free_window", withAssignedAt(assigned, now.Add(-p.FreeWindow-time.Second)), ActorRider, p.RiderFee, true},
+		{"rider_after_driver_arrived", withStatus(withAssignedAt(assigned, now.Add(-time.Hour)), StatusDriverArrived), ActorRider, p.RiderFee, true},
+		{"driver_assigned", withAssignedAt(assigned, now.Add(-time.Hour)), ActorDriver, 0, true},
+		{"system", withAssignedAt(assigned, now.Add(-time.Hour)), ActorSystem, 0, false},
+		{"matching", Ride{Status: StatusOffered}, ActorMatching, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := p.Decide(tt.ride, tt.actor, now)
+			if got.Fee != tt.wantFee || got.Strike != tt.wantStrike {
+				t.Fatalf("expected fee=%d strike=%v, got %+v", tt.wantFee, tt.wantStrike, got)
+			}
+		})
+	}
+}
+
+func TestParseCancelReason(t *testing.T) {
+	if got, err := ParseCancelReason(""); err != nil || got != CancelOther {
+		t.Fatalf("expected empty reason to be OTHER, got %q %v", got, err)
+	}
+	if _, err := ParseCancelReason("BORED"); err != ErrInvalidCancelReason {
+		t.Fatalf("expected invalid reason, got %v", err)
+	}
+}
+
+func withAssignedAt(r Ride, at time.Time) Ride {
+	r.AssignedAt = at
+	return r
+}
+
+func withStatus(r Ride, status RideStatus) Ride {
+	r.Status = status
+	return r
+}
@@ -0,0 +1,327 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrUnbalancedPosting     = errors.New("unbalanced ledger posting")
+	ErrInvalidEarningsPeriod = errors.New("invalid earnings period")
+)
+
+type EntryDirection string
+
+const (
+	Debit  EntryDirection = "DEBIT"
+	Credit EntryDirection = "CREDIT"
+)
+
+// EntryKind says why money moved, so reports can tell earnings from tips,
+// fees and payouts on the same account.
+type EntryKind string
+
+const (
+	EntryCharge     EntryKind = "CHARGE"
+	EntryCollection EntryKind = "COLLECTION"
+	EntryEarning    EntryKind = "EARNING"
+	EntryCommission EntryKind = "COMMISSION"
+	EntryTip        EntryKind = "TIP"
+	EntryFee        EntryKind = "FEE"
+	EntryRefund     EntryKind = "REFUND"
+	EntryPayout     EntryKind = "PAYOUT"
+)
+
+type AccountOwner string
+
+const (
+	OwnerRider    AccountOwner = "rider"
+	OwnerDriver   AccountOwner = "driver"
+	OwnerPlatform AccountOwner = "platform"
+)
+
+// Platform account names.
+const (
+	PlatformClearing   = "clearing"
+	PlatformCommission = "commission"
+	PlatformFees       = "fees"
+	PlatformRefunds    = "refunds"
+	PlatformPayouts    = "payouts"
+)
+
+// LedgerAccount is one balance per owner and currency. Rider accounts track
+// what riders were charged against what was collected from them, driver
+// accounts what the platform owes the driver, and platform accounts the
+// platform's own money.
+type LedgerAccount struct {
+	ID        string
+	OwnerType AccountOwner
+	OwnerID   string
+	Currency  string
+}
+
+func RiderAccount(riderID, currency string) LedgerAccount {
+	return newLedgerAccount(OwnerRider, riderID, currency)
+}
+
+func DriverAccount(driverID, currency string) LedgerAccount {
+	return newLedgerAccount(OwnerDriver, driverID, currency)
+}
+
+func PlatformAccount(name, currency string) LedgerAccount {
+	return newLedgerAccount(OwnerPlatform, name, currency)
+}
+
+func newLedgerAccount(owner AccountOwner, ownerID, currency string) LedgerAccount {
+	return LedgerAccount{
+		ID:        string(owner) + ":" + ownerID + ":" + currency,
+		OwnerType: owner,
+		OwnerID:   ownerID,
+		Currency:  currency,
+	}
+}
+
+type LedgerEntry struct {
+	Account   LedgerAccount
+	Direction EntryDirection
+	Kind      EntryKind
+	Amount    int64
+}
+
+// LedgerPosting is one balanced transaction. Reference is unique across the
+// ledger, so posting the same business event twice is rejected.
+type LedgerPosting struct {
+	ID        string
+	Reference string
+	RideID    string
+	Currency  string
+	Entries   []LedgerEntry
+	CreatedAt time.Time
+}
+
+// Validate checks that every entry moves a positive amount in the posting's
+// currency and that debits equal credits.
+func (p LedgerPosting) Validate() error {
+	if p.Reference == "" || len(p.Entries) < 2 {
+		return ErrUnbalancedPosting
+	}
+	var debits, credits int64
+	for _, entry := range p.Entries {
+		if entry.Amount <= 0 || entry.Account.Currency != p.Currency {
+			return ErrUnbalancedPosting
+		}
+		switch entry.Direction {
+		case Debit:
+			debits += entry.Amount
+		case Credit:
+			credits += entry.Amount
+		default:
+			return ErrUnbalancedPosting
+		}
+	}
+	if debits != credits {
+		return ErrUnbalancedPosting
+	}
+	return nil
+}
+
+func (p *LedgerPosting) add(account LedgerAccount, direction EntryDirection, kind EntryKind, amount int64) {
+	if amount <= 0 {
+		return
+	}
+	p.Entries = append(p.Entries, LedgerEntry{Account: account, Direction: direction, Kind: kind, Amount: amount})
+}
+
+// CommissionPolicy is the platform's cut of fares and fees, in basis points,
+// per product. Tips are never commissioned.
+type CommissionPolicy struct {
+	DefaultBps int
+	ByProduct  map[string]int
+}
+
+func DefaultCommissionPolicy() CommissionPolicy {
+	return CommissionPolicy{DefaultBps: 2000}
+}
+
+func (p CommissionPolicy) Bps(product string) int {
+	if bps, ok := p.ByProduct[product]; ok {
+		return bps
+	}
+	return p.DefaultBps
+}
+
+// Split divides amount into the platform commission and the driver's share.
+// Commission rounds down so the driver keeps any remainder.
+func (p CommissionPolicy) Split(product string, amount int64) (commission, earning int64) {
+	bps := int64(p.Bps(product))
+	if bps < 0 {
+		bps = 0
+	}
+	if bps > 10000 {
+		bps = 10000
+	}
+	commission = amount * bps / 10000
+	return commission, amount - commission
+}
+
+// RideCharge is what was collected from the rider for one ride, broken down
+// by what it paid for.
+type RideCharge struct {
+	RideID   string
+	RiderID  string
+	DriverID string
+	Product  string
+	Currency string
+	Fare     int64
+	Tip      int64
+	Fee      int64
+}
+
+func (c RideCharge) Total() int64 {
+	return c.Fare + c.Tip + c.Fee
+}
+
+// RidePosting books a captured ride payment: the rider is charged and the
+// charge collected, the driver earns the fare and fees less commission plus
+// any tip, and the platform keeps the commission. Without a driver everything
+// goes to platform fees.
+func (p CommissionPolicy) RidePosting(id string, charge RideCharge, at time.Time) (LedgerPosting, error) {
+	posting := LedgerPosting{
+		ID:        id,
+		Reference: "ride:" + charge.RideID + ":charge",
+		RideID:    charge.RideID,
+		Currency:  charge.Currency,
+		CreatedAt: at,
+	}
+	rider := RiderAccount(charge.RiderID, charge.Currency)
+	total := charge.Total()
+	posting.add(rider, Debit, EntryCharge, total)
+	if charge.DriverID == "" {
+		posting.add(PlatformAccount(PlatformFees, charge.Currency), Credit, EntryFee, total)
+	} else {
+		driver := DriverAccount(charge.DriverID, charge.Currency)
+		commissionAccount := PlatformAccount(PlatformCommission, charge.Currency)
+		fareCommission, fareEarning := p.Split(charge.Product, charge.Fare)
+		feeCommission, feeEarning := p.Split(charge.Product, charge.Fee)
+		posting.add(driver, Credit, EntryEarning, fareEarning)
+		posting.add(driver, Credit, EntryTip, charge.Tip)
+		posting.add(driver, Credit, EntryFee, feeEarning)
+		posting.add(commissionAccount, Credit, EntryCommission, fareCommission+feeCommission)
+	}
+	posting.add(PlatformAccount(PlatformClearing, charge.Currency), Debit, EntryCollection, total)
+	posting.add(rider, Credit, EntryCollection, total)
+	return posting, posting.Validate()
+}
+
+// RefundReference is the ledger reference of the refund refundID on a ride.
+func RefundReference(rideID, refundID string) string {
+	return "ride:" + rideID + ":refund:" + refundID
+}
+
+// RefundPosting books money returned to a rider. The platform absorbs the
+// refund; driver earnings already booked are not clawed back.
+func RefundPosting(id, rideID, currency, refundID string, amount int64, at time.Time) (LedgerPosting, error) {
+	posting := LedgerPosting{
+		ID:        id,
+		Reference: RefundReference(rideID, refundID),
+		RideID:    rideID,
+		Currency:  currency,
+		CreatedAt: at,
+	}
+	posting.add(PlatformAccount(PlatformRefunds, currency), Debit, EntryRefund, amount)
+	posting.add(PlatformAccount(PlatformClearing, currency), Credit, EntryRefund, amount)
+	return posting, posting.Validate()
+}
+
+// Payout settles part of a driver's balance in one payout batch.
+type Payout struct {
+	ID        string
+	BatchID   string
+	DriverID  string
+	Amount    int64
+	Currency  string
+	CreatedAt time.Time
+}
+
+// Posting books the payout against the driver's balance.
+func (p Payout) Posting() (LedgerPosting, error) {
+	posting := LedgerPosting{
+		ID:        p.ID,
+		Reference: "payout:" + p.BatchID + ":" + p.DriverID + ":" + p.Currency,
+		Currency:  p.Currency,
+		CreatedAt: p.CreatedAt,
+	}
+	posting.add(DriverAccount(p.DriverID, p.Currency), Debit, EntryPayout, p.Amount)
+	posting.add(PlatformAccount(PlatformPayouts, p.Currency), Credit, EntryPayout, p.Amount)
+	return posting, posting.Validate()
+}
+
+type EarningsPeriod string
+
+const (
+	EarningsDaily  EarningsPeriod = "DAILY"
+	EarningsWeekly EarningsPeriod = "WEEKLY"
+)
+
+// Longest window an earnings summary may span, per period.
+const (
+	maxDailyWindow  = 92 * 24 * time.Hour
+	maxWeeklyWindow = 53 * 7 * 24 * time.Hour
+)
+
+// EarningsWindow resolves the period and [from, to) range of an earnings
+// summary. An empty period means daily; a zero to means the end of the current
+// UTC day or ISO week and a zero from means the 7 days or 4 weeks before to.
+// from is aligned to the start of its day or week so the first bucket is whole.
+func EarningsWindow(period string, from, to, now time.Time) (EarningsPeriod, time.Time, time.Time, error) {
+	p := EarningsPeriod(period)
+	if p == "" {
+		p = EarningsDaily
+	}
+	var bucket, span, limit time.Duration
+	switch p {
+	case EarningsDaily:
+		bucket, span, limit = 24*time.Hour, 7*24*time.Hour, maxDailyWindow
+	case EarningsWeekly:
+		bucket, span, limit = 7*24*time.Hour, 4*7*24*time.Hour, maxWeeklyWindow
+	default:
+		return "", time.Time{}, time.Time{}, ErrInvalidEarningsPeriod
+	}
+	if to.IsZero() {
+		to = truncateToPeriod(p, now.UTC()).Add(bucket)
+	}
+	to = to.UTC()
+	if from.IsZero() {
+		from = to.Add(-span)
+	}
+	from = truncateToPeriod(p, from.UTC())
+	if !from.Before(to) || to.Sub(from) > limit {
+		return "", time.Time{}, time.Time{}, ErrInvalidEarningsPeriod
+	}
+	return p, from, to, nil
+}
+
+func truncateToPeriod(p EarningsPeriod, t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if p != EarningsWeekly {
+		return day
+	}
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// EarningsSummary is what a driver earned in one day or week.
+type EarningsSummary struct {
+	PeriodStart time.Time
+	Currency    string
+	Earnings    int64
+	Tips        int64
+	Fees        int64
+	Payouts     int64
+	Rides       int
+}
+
+// Net is what the driver earned in the period, before payouts.
+func (s EarningsSummary) Net() int64 {
+	return s.Earnings + s.Tips + s.Fees
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRidePostingBalances(t *testing.T) {
+	policy := CommissionPolicy{DefaultBps: 2000, ByProduct: map[string]int{"premium": 2500}}
+	at := time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)
+	charge := RideCharge{RideID: "ride-1", RiderID: "r1", DriverID: "d1", Product: "premium", Currency: "IDR", Fare: 30001, Tip: 5000, Fee: 2000}
+
+	posting, err := policy.RidePosting("tx-1", charge, at)
+	if err != nil {
+		t.Fatalf("posting error: %v", err)
+	}
+	credits := map[EntryKind]int64{}
+	for _, entry := range posting.Entries {
+		if entry.Direction == Credit && entry.Account.OwnerType != OwnerRider {
+			credits[entry.Kind] += entry.Amount
+		}
+	}
+	// 25% of 30001 rounds down to 7500; 25% of the 2000 fee is 500.
+	if credits[EntryEarning] != 22501 || credits[EntryTip] != 5000 || credits[EntryFee] != 1500 || credits[EntryCommission] != 8000 {
+		t.Fatalf("unexpected split: %+v", credits)
+	}
+
+	noDriver, err := policy.RidePosting("tx-2", RideCharge{RideID: "ride-2", RiderID: "r1", Currency: "IDR", Fee: 5000}, at)
+	if err != nil || len(noDriver.Entries) != 4 || noDriver.Entries[1].Account.ID != "platform:fees:IDR" {
+		t.Fatalf("expected fee to platform without a driver, got %+v / %v", noDriver.Entries, err)
+	}
+
+	unbalanced := posting
+	unbalanced.Entries = append([]LedgerEntry(nil), posting.Entries[:len(posting.Entries)-1]...)
+	if err := unbalanced.Validate(); !errors.Is(err, ErrUnbalancedPosting) {
+		t.Fatalf("expected unbalanced posting rejected, got %v", err)
+	}
+}
+
+func TestEarningsWindow(t *testing.T) {
+	// Thursday.
+	now := time.Date(2026, 1, 8, 15, 30, 0, 0, time.UTC)
+	period, from, to, err := EarningsWindow("", time.Time{}, time.Time{}, now)
+	if err != nil || period != EarningsDaily || !from.Equal(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)) || !to.Equal(time.Date(2026, 1, 9, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected daily default: %s %s %s %v", period, from, to, err)
+	}
+	_, from, _, err = EarningsWindow("WEEKLY", time.Time{}, time.Time{}, now)
+	if err != nil || from.Weekday() != time.Monday || !from.Equal(time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected weekly window aligned to monday, got %s %v", from, err)
+	}
+	if _, _, _, err := EarningsWindow("MONTHLY", time.Time{}, time.Time{}, now); !errors.Is(err, ErrInvalidEarningsPeriod) {
+		t.Fatalf("expected unknown period rejected, got %v", err)
+	}
+	if _, _, _, err := EarningsWindow("DAILY", now.AddDate(-1, 0, 0), now, now); !errors.Is(err, ErrInvalidEarningsPeriod) {
+		t.Fatalf("expected oversized daily window rejected, got %v", err)
+	}
+}
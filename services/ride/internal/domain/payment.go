@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrPaymentDeclined          = errors.New("payment declined")
+	ErrPaymentNotFound          = errors.New("payment not found")
+	ErrInvalidPaymentTransition = errors.New("invalid payment transition")
+	ErrInvalidRefund            = errors.New("invalid refund")
+	ErrRefundMismatch           = errors.New("refund id reused with a different amount")
+)
+
+type PaymentStatus string
+
+const (
+	PaymentAuthorized     PaymentStatus = "AUTHORIZED"
+	PaymentCapturePending PaymentStatus = "CAPTURE_PENDING"
+	PaymentCaptured       PaymentStatus = "CAPTURED"
+	PaymentVoidPending    PaymentStatus = "VOID_PENDING"
+	PaymentVoided         PaymentStatus = "VOIDED"
+	PaymentRefunded       PaymentStatus = "REFUNDED"
+	PaymentFailed         PaymentStatus = "FAILED"
+)
+
+// Payment tracks the rider's card hold for one ride. The hold is placed when
+// the ride is created; the ride's outcome then asks for a capture or a void,
+// which the payment worker settles with the provider.
+type Payment struct {
+	ID             string
+	RideID         string
+	RiderID        string
+	Amount         int64
+	CaptureAmount  int64
+	CapturedAmount int64
+	RefundedAmount int64
+	Currency       string
+	Status         PaymentStatus
+	ProviderRef    string
+	Attempts       int
+	LastError      string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Pending reports whether the payment is waiting on the provider.
+func (p Payment) Pending() bool {
+	return p.Status == PaymentCapturePending || p.Status == PaymentVoidPending
+}
+
+// RequestCapture asks for amount of the hold to be captured. Capturing nothing
+// releases the hold instead.
+func (p Payment) RequestCapture(amount int64) (Payment, error) {
+	if p.Status != PaymentAuthorized {
+		return p, ErrInvalidPaymentTransition
+	}
+	if amount <= 0 {
+		return p.RequestVoid()
+	}
+	if amount > p.Amount {
+		amount = p.Amount
+	}
+	p.Status = PaymentCapturePending
+	p.CaptureAmount = amount
+	p.Attempts = 0
+	p.LastError = ""
+	return p, nil
+}
+
+// RequestVoid asks for the hold to be released without charging the rider.
+func (p Payment) RequestVoid() (Payment, error) {
+	if p.Status != PaymentAuthorized {
+		return p, ErrInvalidPaymentTransition
+	}
+	p.Status = PaymentVoidPending
+	p.CaptureAmount = 0
+	p.Attempts = 0
+	p.LastError = ""
+	return p, nil
+}
+
+// Settle records that the provider completed the pending capture or void.
+func (p Payment) Settle() (Payment, error) {
+	switch p.Status {
+	case PaymentCapturePending:
+		p.Status = PaymentCaptured
+		p.CapturedAmount = p.CaptureAmount
+	case PaymentVoidPending:
+		p.Status = PaymentVoided
+	default:
+		return p, ErrInvalidPaymentTransition
+	}
+	p.LastError = ""
+	return p, nil
+}
+
+// RecordFailure notes a failed provider call; after maxAttempts the payment is
+// parked as FAILED for manual follow-up.
+func (p Payment) RecordFailure(reason string, maxAttempts int) Payment {
+	p.Attempts++
+	p.LastError = reason
+	if maxAttempts > 0 && p.Attempts >= maxAttempts {
+		p.Status = PaymentFailed
+	}
+	return p
+}
+
+// Refundable is what is left of the captured amount.
+func (p Payment) Refundable() int64 {
+	if p.Status != PaymentCaptured && p.Status != PaymentRefunded {
+		return 0
+	}
+	return p.CapturedAmount - p.RefundedAmount
+}
+
+// Refund returns amount of a captured payment to the rider. Several partial
+// refunds are allowed up to the captured amount.
+func (p Payment) Refund(amount int64) (Payment, error) {
+	if p.Status != PaymentCaptured && p.Status != PaymentRefunded {
+		return p, ErrInvalidPaymentTransition
+	}
+	if amount <= 0 || amount > p.Refundable() {
+		return p, ErrInvalidRefund
+	}
+	p.RefundedAmount += amount
+	p.Status = PaymentRefunded
+	return p, nil
+}
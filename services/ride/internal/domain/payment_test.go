@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPaymentLifecycle(t *testing.T) {
+	auth := Payment{ID: "p1", Amount: 25000, Status: PaymentAuthorized}
+
+	capture, err := auth.RequestCapture(30000)
+	if err != nil || capture.Status != PaymentCapturePending || capture.CaptureAmount != 25000 {
+		t.Fatalf("expected capture capped at the hold, got %+v / %v", capture, err)
+	}
+	captured, err := capture.Settle()
+	if err != nil || captured.Status != PaymentCaptured || captured.CapturedAmount != 25000 {
+		t.Fatalf("unexpected settle: %+v / %v", captured, err)
+	}
+	if _, err := captured.RequestVoid(); !errors.Is(err, ErrInvalidPaymentTransition) {
+		t.Fatalf("expected captured payment not voidable, got %v", err)
+	}
+
+	refunded, err := captured.Refund(10000)
+	if err != nil || refunded.Status != PaymentRefunded || refunded.Refundable() != 15000 {
+		t.Fatalf("unexpected partial refund: %+v / %v", refunded, err)
+	}
+	if _, err := refunded.Refund(15001); !errors.Is(err, ErrInvalidRefund) {
+		t.Fatalf("expected over-refund rejected, got %v", err)
+	}
+
+	void, err := auth.RequestCapture(0)
+	if err != nil || void.Status != PaymentVoidPending {
+		t.Fatalf("expected zero capture to void, got %+v / %v", void, err)
+	}
+	if voided, _ := void.Settle(); voided.Status != PaymentVoided || voided.CapturedAmount != 0 {
+		t.Fatalf("unexpected void settle: %+v", voided)
+	}
+
+	failing := capture.RecordFailure("timeout", 2)
+	if failing.Status != PaymentCapturePending || failing.Attempts != 1 {
+		t.Fatalf("expected first failure to keep retrying, got %+v", failing)
+	}
+	if failing = failing.RecordFailure("timeout", 2); failing.Status != PaymentFailed {
+		t.Fatalf("expected payment parked after max attempts, got %+v", failing)
+	}
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrPickupPINRequired = errors.New("pickup pin required")
+	ErrInvalidPickupPIN  = errors.New("invalid pickup pin")
+	ErrPickupPINLocked   = errors.New("pickup pin locked")
+	ErrNoPickupPIN       = errors.New("no pickup pin issued")
+)
+
+// PickupPINPolicy sets how many digits the PIN a rider reads out at pickup has
+// and how many wrong entries the driver gets before it locks. A locked PIN
+// keeps the ride from starting until support overrides it.
+type PickupPINPolicy struct {
+	Length      int
+	MaxAttempts int
+}
+
+func DefaultPickupPINPolicy() PickupPINPolicy {
+	return PickupPINPolicy{
+		Length:      4,
+		MaxAttempts: 3,
+	}
+}
+
+// PickupPIN is the state of the PIN issued when a driver was assigned. Only a
+// hash of the PIN itself is kept.
+type PickupPIN struct {
+	RideID         string
+	Hash           string
+	FailedAttempts int
+	LockedAt       time.Time
+	OverriddenAt   time.Time
+	OverriddenBy   string
+}
+
+// Required reports whether the driver still has to enter the PIN to start the
+// ride; support lifts the requirement with an override.
+func (p PickupPIN) Required() bool {
+	return p.OverriddenAt.IsZero()
+}
+
+func (p PickupPIN) Locked() bool {
+	return p.Required() && !p.LockedAt.IsZero()
+}
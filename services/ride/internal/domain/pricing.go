@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+const earthRadiusMeters = 6371000.0
+
+var (
+	ErrUnknownProduct = errors.New("unknown product")
+	ErrInvalidQuote   = errors.New("invalid quote")
+	ErrQuoteExpired   = errors.New("quote expired")
+)
+
+// FareRule holds the tariff for a product. Amounts are in minor currency units.
+type FareRule struct {
+	BaseFare    int64
+	PerKm       int64
+	PerMinute   int64
+	MinimumFare int64
+	BookingFee  int64
+}
+
+type Fare struct {
+	Product         string
+	Currency        string
+	DistanceMeters  int64
+	DurationSeconds int64
+	BaseFare        int64
+	DistanceFare    int64
+	TimeFare        int64
+	BookingFee      int64
+	SurgeMultiplier float64
+	SurgeFare       int64
+	Total           int64
+}
+
+// Estimate prices a trip. The minimum fare applies to the trip portion only;
+// the booking fee is always added on top.
+func (r FareRule) Estimate(distanceMeters float64, duration time.Duration) Fare {
+	distanceFare := int64(math.Round(float64(r.PerKm) * distanceMeters / 1000))
+	timeFare := int64(math.Round(float64(r.PerMinute) * duration.Minutes()))
+	trip := r.BaseFare + distanceFare + timeFare
+	if trip < r.MinimumFare {
+		trip = r.MinimumFare
+	}
+	return Fare{
+		DistanceMeters:  int64(math.Round(distanceMeters)),
+		DurationSeconds: int64(math.Round(duration.Seconds())),
+		BaseFare:        r.BaseFare,
+		DistanceFare:    distanceFare,
+		TimeFare:        timeFare,
+		BookingFee:      r.BookingFee,
+		SurgeMultiplier: 1,
+		Total:           trip + r.BookingFee,
+	}
+}
+
+// WithSurge scales the trip portion of the fare. The booking fee is not
+// surged, and multipliers below 1 are ignored.
+func (f Fare) WithSurge(multiplier float64) Fare {
+	if multiplier <= 1 {
+		return f
+	}
+	trip := f.Total - f.BookingFee - f.SurgeFare
+	surged := int64(math.Round(float64(trip) * multiplier))
+	f.SurgeMultiplier = multiplier
+	f.SurgeFare = surged - trip
+	f.Total = surged + f.BookingFee
+	return f
+}
+
+// Quote is a fare the rider has been shown and may book until ExpiresAt.
+type Quote struct {
+	ID         string    `json:"id"`
+	RiderID    string    `json:"rider_id"`
+	PickupLat  float64   `json:"pickup_lat"`
+	PickupLng  float64   `json:"pickup_lng"`
+	DropoffLat float64   `json:"dropoff_lat"`
+	DropoffLng float64   `json:"dropoff_lng"`
+	Stops      []Point   `json:"stops,omitempty"`
+	Fare       Fare      `json:"fare"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// Covers reports whether the quote was issued for this rider and trip,
+// including the same stops in the same order.
+func (q Quote) Covers(riderID string, pickupLat, pickupLng, dropoffLat, dropoffLng float64, stops []Point) bool {
+	const epsilon = 1e-6
+	if q.RiderID != riderID || len(q.Stops) != len(stops) {
+		return false
+	}
+	for i := range stops {
+		if math.Abs(q.Stops[i].Lat-stops[i].Lat) >= epsilon || math.Abs(q.Stops[i].Lng-stops[i].Lng) >= epsilon {
+			return false
+		}
+	}
+	return math.Abs(q.PickupLat-pickupLat) < epsilon &&
+		math.Abs(q.PickupLng-pickupLng) < epsilon &&
+		math.Abs(q.DropoffLat-dropoffLat) < epsilon &&
+		math.Abs(q.DropoffLng-dropoffLng) < epsilon
+}
+
+// HaversineMeters returns the great-circle distance between two points.
+func HaversineMeters(lat1, lng1, lat2, lng2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLng := toRad(lng2 - lng1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(a))
+}
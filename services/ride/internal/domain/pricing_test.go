@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFareRuleEstimate(t *testing.T) {
+	rule := FareRule{BaseFare: 250, PerKm: 120, PerMinute: 30, MinimumFare: 700, BookingFee: 150}
+	tests := []struct {
+		name     string
+		distance float64
+		duration time.Duration
+		want     int64
+	}{
+		{"regular_trip", 10000, 20 * time.Minute, 250 + 1200 + 600 + 150},
+		{"minimum_fare", 500, 2 * time.Minute, 700 + 150},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fare := rule.Estimate(tt.distance, tt.duration)
+			if fare.Total != tt.want {
+				t.Fatalf("expected %d, got %d", tt.want, fare.Total)
+			}
+		})
+	}
+}
+
+func TestHaversineMeters(t *testing.T) {
+	// One degree of latitude is roughly 111.2km.
+	got := HaversineMeters(0, 0, 1, 0)
+	if got < 111000 || got > 111400 {
+		t.Fatalf("unexpected distance %f", got)
+	}
+}
+
+func TestFareWithSurge(t *testing.T) {
+	rule := FareRule{BaseFare: 250, PerKm: 120, PerMinute: 30, MinimumFare: 700, BookingFee: 150}
+	base := rule.Estimate(10000, 20*time.Minute)
+
+	surged := base.WithSurge(1.5)
+	if surged.SurgeFare != 1025 || surged.Total != 3075+150 {
+		t.Fatalf("unexpected surged fare %+v", surged)
+	}
+	if surged.BookingFee != base.BookingFee {
+		t.Fatalf("booking fee must not surge")
+	}
+	if got := base.WithSurge(0.5); got != base {
+		t.Fatalf("multipliers below 1 must be ignored")
+	}
+}
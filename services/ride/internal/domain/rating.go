@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidRating      = errors.New("invalid rating")
+	ErrRatingWindowClosed = errors.New("rating window closed")
+	ErrAlreadyRated       = errors.New("ride already rated")
+	ErrRatingNotPermitted = errors.New("rating not permitted")
+)
+
+const (
+	MinStars = 1
+	MaxStars = 5
+)
+
+// ratingTags are the feedback tags each side may attach, keyed by who is
+// rating. Anything else is rejected so tags stay usable for reporting.
+var ratingTags = map[Actor]map[string]bool{
+	ActorRider: {
+		"clean_car": true, "safe_driving": true, "friendly": true, "on_time": true, "good_navigation": true,
+		"dirty_car": true, "unsafe_driving": true, "rude": true, "late": true, "wrong_route": true,
+	},
+	ActorDriver: {
+		"friendly": true, "on_time": true, "respectful": true, "clear_pickup": true,
+		"rude": true, "late": true, "messy": true, "wrong_pickup": true,
+	},
+}
+
+// RatingPolicy bounds how long after completion a ride may be rated and how
+// much feedback a rating may carry.
+type RatingPolicy struct {
+	Window        time.Duration
+	MaxTags       int
+	MaxCommentLen int
+}
+
+func DefaultRatingPolicy() RatingPolicy {
+	return RatingPolicy{
+		Window:        72 * time.Hour,
+		MaxTags:       5,
+		MaxCommentLen: 500,
+	}
+}
+
+// Rating is one side's feedback on a completed ride. Each side rates a ride
+// at most once.
+type Rating struct {
+	RideID    string
+	RaterID   string
+	RaterRole Actor
+	RateeID   string
+	Stars     int
+	Tags      []string
+	Comment   string
+	CreatedAt time.Time
+}
+
+// RateeRole is the role of the party being rated.
+func (r Rating) RateeRole() Actor {
+	if r.RaterRole == ActorDriver {
+		return ActorRider
+	}
+	return ActorDriver
+}
+
+// NewRating checks that raterID took part in the ride as role and that the
+// rating arrives inside the window after completion.
+func (p RatingPolicy) NewRating(ride Ride, role Actor, raterID string, stars int, tags []string, comment string, now time.Time) (Rating, error) {
+	var rateeID string
+	switch role {
+	case ActorRider:
+		if !ride.IsRequestedBy(raterID) {
+			return Rating{}, ErrRiderMismatch
+		}
+		if ride.DriverID != nil {
+			rateeID = *ride.DriverID
+		}
+	case ActorDriver:
+		if !ride.IsAssignedTo(raterID) {
+			return Rating{}, ErrDriverMismatch
+		}
+		rateeID = ride.RiderID
+	default:
+		return Rating{}, ErrRatingNotPermitted
+	}
+	if ride.Status != StatusCompleted || rateeID == "" {
+		return Rating{}, ErrRatingNotPermitted
+	}
+	if p.Window > 0 && !ride.CompletedAt.IsZero() && now.After(ride.CompletedAt.Add(p.Window)) {
+		return Rating{}, ErrRatingWindowClosed
+	}
+	if stars < MinStars || stars > MaxStars {
+		return Rating{}, ErrInvalidRating
+	}
+	if err := p.validateFeedback(role, tags, comment); err != nil {
+		return Rating{}, err
+	}
+	return Rating{
+		RideID:    ride.ID,
+		RaterID:   raterID,
+		RaterRole: role,
+		RateeID:   rateeID,
+		Stars:     stars,
+		Tags:      dedupeTags(tags),
+		Comment:   comment,
+		CreatedAt: now,
+	}, nil
+}
+
+func (p RatingPolicy) validateFeedback(role Actor, tags []string, comment string) error {
+	if p.MaxTags > 0 && len(tags) > p.MaxTags {
+		return ErrInvalidRating
+	}
+	if p.MaxCommentLen > 0 && len([]rune(comment)) > p.MaxCommentLen {
+		return ErrInvalidRating
+	}
+	allowed := ratingTags[role]
+	for _, tag := range tags {
+		if !allowed[tag] {
+			return ErrInvalidRating
+		}
+	}
+	return nil
+}
+
+func dedupeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	seen := make(map[string]bool, len(tags))
+	out := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		out = append(out, tag)
+	}
+	return out
+}
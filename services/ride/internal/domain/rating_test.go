@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRatingPolicyNewRating(t *testing.T) {
+	p := DefaultRatingPolicy()
+	completedAt := time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)
+	driverID := "driver-1"
+	ride := Ride{ID: "ride-1", RiderID: "rider-1", DriverID: &driverID, Status: StatusCompleted, CompletedAt: completedAt}
+	inProgress := ride
+	inProgress.Status = StatusInProgress
+
+	tests := []struct {
+		name    string
+		ride    Ride
+		role    Actor
+		raterID string
+		stars   int
+		tags    []string
+		at      time.Time
+		wantErr error
+	}{
+		{"rider_rates_driver", ride, ActorRider, "rider-1", 5, []string{"clean_car", "clean_car"}, completedAt.Add(time.Hour), nil},
+		{"driver_rates_rider", ride, ActorDriver, "driver-1", 4, []string{"on_time"}, completedAt.Add(time.Hour), nil},
+		{"not_completed", inProgress, ActorRider, "rider-1", 5, nil, completedAt, ErrRatingNotPermitted},
+		{"other_rider", ride, ActorRider, "rider-2", 5, nil, completedAt, ErrRiderMismatch},
+		{"other_driver", ride, ActorDriver, "driver-2", 5, nil, completedAt, ErrDriverMismatch},
+		{"system", ride, ActorSystem, "", 5, nil, completedAt, ErrRatingNotPermitted},
+		{"zero_stars", ride, ActorRider, "rider-1", 0, nil, completedAt, ErrInvalidRating},
+		{"six_stars", ride, ActorRider, "rider-1", 6, nil, completedAt, ErrInvalidRating},
+		{"unknown_tag", ride, ActorRider, "rider-1", 3, []string{"bad_vibes"}, completedAt, ErrInvalidRating},
+		{"driver_tag_from_rider", ride, ActorRider, "rider-1", 3, []string{"messy"}, completedAt, ErrInvalidRating},
+		{"window_closed", ride, ActorRider, "rider-1", 5, nil, completedAt.Add(p.Window + time.Second), ErrRatingWindowClosed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := p.NewRating(tt.ride, tt.role, tt.raterID, tt.stars, tt.tags, "", tt.at)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if got.RateeRole() == tt.role || got.RateeID == tt.raterID || got.RateeID == "" {
+				t.Fatalf("expected the other side as ratee, got %+v", got)
+			}
+			if len(got.Tags) != 1 {
+				t.Fatalf("expected deduplicated tags, got %v", got.Tags)
+			}
+		})
+	}
+}
@@ -1,62 +1,139 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 type RideStatus string
 
 const (
+	StatusScheduled      RideStatus = "SCHEDULED"
 	StatusRequested      RideStatus = "REQUESTED"
 	StatusMatching       RideStatus = "MATCHING"
 	StatusOffered        RideStatus = "OFFERED"
 	StatusDriverAssigned RideStatus = "DRIVER_ASSIGNED"
+	StatusDriverArrived  RideStatus = "DRIVER_ARRIVED"
 	StatusInProgress     RideStatus = "IN_PROGRESS"
 	StatusCompleted      RideStatus = "COMPLETED"
 	StatusCancelled      RideStatus = "CANCELLED"
 )
 
+// Ride is a single trip. Version counts the writes made to it: each change
+// below returns the ride at its next version, and the store only accepts the
+// write while it still holds the version the change was made from.
 type Ride struct {
-	ID         string
-	RiderID    string
-	DriverID   *string
-	Status     RideStatus
-	PickupLat  float64
-	PickupLng  float64
-	DropoffLat float64
-	DropoffLng float64
+	ID              string
+	RiderID         string
+	DriverID        *string
+	Status          RideStatus
+	PickupLat       float64
+	PickupLng       float64
+	DropoffLat      float64
+	DropoffLng      float64
+	Product         string
+	FareAmount      int64
+	Currency        string
+	QuoteID         string
+	PickupAt        time.Time
+	Stops           []Stop
+	AssignedAt      time.Time
+	ArrivedAt       time.Time
+	CompletedAt     time.Time
+	CancellationFee int64
+	CancelReason    CancelReason
+	Redispatches    int
+	Version         int64
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 var (
 	ErrInvalidTransition = errors.New("invalid state transition")
+	ErrDriverMismatch    = errors.New("driver not assigned to ride")
+	ErrRiderMismatch     = errors.New("ride not requested by rider")
+	ErrDriverExcluded    = errors.New("driver excluded from ride")
 )
 
+// IsScheduled reports whether the ride is booked for a later pickup.
+func (r Ride) IsScheduled() bool {
+	return !r.PickupAt.IsZero()
+}
+
+func (r Ride) IsRequestedBy(riderID string) bool {
+	return riderID != "" && r.RiderID == riderID
+}
+
+// AwaitingPickup reports whether a driver holds the ride but has not started
+// it yet, whether or not they have reached the pickup.
+func (r Ride) AwaitingPickup() bool {
+	return r.Status == StatusDriverAssigned || r.Status == StatusDriverArrived
+}
+
+func (r Ride) IsAssignedTo(driverID string) bool {
+	return driverID != "" && r.DriverID != nil && *r.DriverID == driverID
+}
+
+// ReleaseDriver hands an assigned ride back to matching after its driver
+// cancels. It is kept apart from Transition so that nothing else can move an
+// assigned ride back into matching.
+func (r Ride) ReleaseDriver() (Ride, error) {
+	if !r.AwaitingPickup() || r.DriverID == nil {
+		return r, ErrInvalidTransition
+	}
+	r.Status = StatusMatching
+	r.DriverID = nil
+	r.AssignedAt = time.Time{}
+	r.ArrivedAt = time.Time{}
+	r.Version++
+	return r, nil
+}
+
 func (r Ride) Transition(next RideStatus) (Ride, error) {
 	if r.Status == next {
 		return r, nil
 	}
 	switch r.Status {
+	case StatusScheduled:
+		if next == StatusMatching || next == StatusCancelled {
+			r.Status = next
+			r.Version++
+			return r, nil
+		}
 	case StatusRequested:
 		if next == StatusMatching || next == StatusCancelled {
 			r.Status = next
+			r.Version++
 			return r, nil
 		}
 	case StatusMatching:
 		if next == StatusOffered || next == StatusCancelled {
 			r.Status = next
+			r.Version++
 			return r, nil
 		}
 	case StatusOffered:
-		if next == StatusDriverAssigned || next == StatusCancelled {
+		if next == StatusDriverAssigned || next == StatusMatching || next == StatusCancelled {
 			r.Status = next
+			r.Version++
 			return r, nil
 		}
 	case StatusDriverAssigned:
+		if next == StatusDriverArrived || next == StatusInProgress || next == StatusCancelled {
+			r.Status = next
+			r.Version++
+			return r, nil
+		}
+	case StatusDriverArrived:
 		if next == StatusInProgress || next == StatusCancelled {
 			r.Status = next
+			r.Version++
 			return r, nil
 		}
 	case StatusInProgress:
 		if next == StatusCompleted {
 			r.Status = next
+			r.Version++
 			return r, nil
 		}
 	}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrInvalidActor = errors.New("invalid actor")
+
+// Actor identifies who caused a ride transition.
+type Actor string
+
+const (
+	ActorRider    Actor = "rider"
+	ActorDriver   Actor = "driver"
+	ActorSystem   Actor = "system"
+	ActorMatching Actor = "matching"
+	// ActorAdmin is support acting on a ride, e.g. overriding its pickup PIN.
+	ActorAdmin Actor = "admin"
+)
+
+// ParseActor accepts the known actors; an empty value means the system.
+func ParseActor(value string) (Actor, error) {
+	switch Actor(value) {
+	case "":
+		return ActorSystem, nil
+	case ActorRider, ActorDriver, ActorSystem, ActorMatching:
+		return Actor(value), nil
+	default:
+		return "", ErrInvalidActor
+	}
+}
+
+// RideEvent is one entry in a ride's status timeline.
+type RideEvent struct {
+	ID         string
+	RideID     string
+	FromStatus RideStatus
+	ToStatus   RideStatus
+	Actor      Actor
+	ActorID    string
+	Reason     string
+	CreatedAt  time.Time
+}
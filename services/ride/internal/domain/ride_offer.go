@@ -25,7 +25,10 @@ type RideOffer struct {
 	CreatedAt time.Time
 }
 
-var ErrInvalidOfferTransition = errors.New("invalid offer transition")
+var (
+	ErrInvalidOfferTransition = errors.New("invalid offer transition")
+	ErrOfferExpired           = errors.New("offer expired")
+)
 
 func NewRideOffer(rideID, driverID string, ttl time.Duration) RideOffer {
 	now := time.Now().UTC()
@@ -59,3 +62,7 @@ func (o RideOffer) Transition(next RideOfferStatus) (RideOffer, error) {
 	}
 	return o, ErrInvalidOfferTransition
 }
+
+func (o RideOffer) IsExpired(now time.Time) bool {
+	return !now.Before(o.ExpiresAt)
+}
@@ -9,11 +9,20 @@ func TestRideTransitions(t *testing.T) {
 		next    RideStatus
 		wantErr bool
 	}{
+		{"scheduled_to_matching", StatusScheduled, StatusMatching, false},
+		{"scheduled_to_cancelled", StatusScheduled, StatusCancelled, false},
+		{"scheduled_to_offered", StatusScheduled, StatusOffered, true},
 		{"requested_to_matching", StatusRequested, StatusMatching, false},
 		{"requested_to_cancelled", StatusRequested, StatusCancelled, false},
 		{"matching_to_offered", StatusMatching, StatusOffered, false},
 		{"offered_to_assigned", StatusOffered, StatusDriverAssigned, false},
+		{"offered_to_matching", StatusOffered, StatusMatching, false},
+		{"assigned_to_matching", StatusDriverAssigned, StatusMatching, true},
 		{"assigned_to_in_progress", StatusDriverAssigned, StatusInProgress, false},
+		{"assigned_to_arrived", StatusDriverAssigned, StatusDriverArrived, false},
+		{"arrived_to_in_progress", StatusDriverArrived, StatusInProgress, false},
+		{"arrived_to_cancelled", StatusDriverArrived, StatusCancelled, false},
+		{"arrived_to_assigned", StatusDriverArrived, StatusDriverAssigned, true},
 		{"in_progress_to_completed", StatusInProgress, StatusCompleted, false},
 		{"completed_to_cancelled", StatusCompleted, StatusCancelled, true},
 		{"requested_to_completed", StatusRequested, StatusCompleted, true},
@@ -21,14 +30,31 @@ func TestRideTransitions(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ride := Ride{Status: tt.from}
-			_, err := ride.Transition(tt.next)
+			ride := Ride{Status: tt.from, Version: 1}
+			next, err := ride.Transition(tt.next)
 			if tt.wantErr && err == nil {
 				t.Fatalf("expected error")
 			}
 			if !tt.wantErr && err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
+			if !tt.wantErr && next.Version != 2 {
+				t.Fatalf("expected version 2, got %d", next.Version)
+			}
 		})
 	}
 }
+
+func TestReleaseDriver(t *testing.T) {
+	driverID := "d1"
+	released, err := Ride{Status: StatusDriverAssigned, DriverID: &driverID}.ReleaseDriver()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if released.Status != StatusMatching || released.DriverID != nil {
+		t.Fatalf("expected unassigned matching ride, got %s / %v", released.Status, released.DriverID)
+	}
+	if _, err := (Ride{Status: StatusInProgress, DriverID: &driverID}).ReleaseDriver(); err != ErrInvalidTransition {
+		t.Fatalf("expected started ride to keep its driver, got %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrInvalidPickupTime = errors.New("invalid pickup time")
+
+// SchedulePolicy bounds how far ahead a ride may be booked and when the
+// scheduler reminds the rider and starts matching before pickup. A ride still
+// undispatched DispatchTimeout after its pickup is timed out; zero keeps it
+// waiting.
+type SchedulePolicy struct {
+	MinAdvance      time.Duration
+	MaxAdvance      time.Duration
+	DispatchLead    time.Duration
+	ReminderLead    time.Duration
+	DispatchTimeout time.Duration
+}
+
+func DefaultSchedulePolicy() SchedulePolicy {
+	return SchedulePolicy{
+		MinAdvance:      30 * time.Minute,
+		MaxAdvance:      30 * 24 * time.Hour,
+		DispatchLead:    15 * time.Minute,
+		ReminderLead:    time.Hour,
+		DispatchTimeout: 30 * time.Minute,
+	}
+}
+
+// Validate checks a requested pickup time against now.
+func (p SchedulePolicy) Validate(pickupAt time.Time, now time.Time) error {
+	if pickupAt.Before(now.Add(p.MinAdvance)) {
+		return ErrInvalidPickupTime
+	}
+	if p.MaxAdvance > 0 && pickupAt.After(now.Add(p.MaxAdvance)) {
+		return ErrInvalidPickupTime
+	}
+	return nil
+}
+
+// DispatchAt is when matching should start for a pickup.
+func (p SchedulePolicy) DispatchAt(pickupAt time.Time) time.Time {
+	return pickupAt.Add(-p.DispatchLead)
+}
+
+// RemindAt is when the rider should be reminded of a pickup.
+func (p SchedulePolicy) RemindAt(pickupAt time.Time) time.Time {
+	return pickupAt.Add(-p.ReminderLead)
+}
+
+// TimeoutAt is when a pickup that could not be dispatched is given up on.
+func (p SchedulePolicy) TimeoutAt(pickupAt time.Time) time.Time {
+	return pickupAt.Add(p.DispatchTimeout)
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSchedulePolicyValidate(t *testing.T) {
+	p := DefaultSchedulePolicy()
+	now := time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		pickupAt time.Time
+		wantErr  bool
+	}{
+		{"too_soon", now.Add(10 * time.Minute), true},
+		{"at_min_advance", now.Add(p.MinAdvance), false},
+		{"next_week", now.Add(7 * 24 * time.Hour), false},
+		{"too_far", now.Add(p.MaxAdvance + time.Minute), true},
+		{"in_the_past", now.Add(-time.Hour), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.Validate(tt.pickupAt, now)
+			if tt.wantErr && err == nil {
+				t.Fatalf("expected error")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// MaxStops caps the intermediate stops a ride may have between pickup and
+// dropoff.
+const MaxStops = 3
+
+var (
+	ErrTooManyStops   = errors.New("too many stops")
+	ErrStopNotFound   = errors.New("stop not found")
+	ErrStopOutOfOrder = errors.New("stop out of order")
+)
+
+type Point struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// Stop is an intermediate waypoint. Seq starts at 1 and gives the visiting
+// order; the zero times mean the driver has not arrived or departed yet.
+type Stop struct {
+	Seq        int
+	Lat        float64
+	Lng        float64
+	ArrivedAt  time.Time
+	DepartedAt time.Time
+}
+
+// Leg is one hop of a route between consecutive points.
+type Leg struct {
+	DistanceMeters  int64
+	DurationSeconds int64
+}
+
+// NewStops numbers the requested waypoints in order.
+func NewStops(points []Point) ([]Stop, error) {
+	if len(points) > MaxStops {
+		return nil, ErrTooManyStops
+	}
+	stops := make([]Stop, 0, len(points))
+	for i, p := range points {
+		stops = append(stops, Stop{Seq: i + 1, Lat: p.Lat, Lng: p.Lng})
+	}
+	return stops, nil
+}
+
+// TripRoute is the ordered list of points a trip visits: pickup, every stop,
+// then dropoff.
+func TripRoute(pickup Point, stops []Point, dropoff Point) []Point {
+	route := make([]Point, 0, len(stops)+2)
+	route = append(route, pickup)
+	route = append(route, stops...)
+	return append(route, dropoff)
+}
+
+func (r Ride) Route() []Point {
+	return TripRoute(Point{Lat: r.PickupLat, Lng: r.PickupLng}, r.StopPoints(), Point{Lat: r.DropoffLat, Lng: r.DropoffLng})
+}
+
+// StopPoints returns the locations of the intermediate stops in order.
+func (r Ride) StopPoints() []Point {
+	points := make([]Point, 0, len(r.Stops))
+	for _, s := range r.Stops {
+		points = append(points, Point{Lat: s.Lat, Lng: s.Lng})
+	}
+	return points
+}
+
+// RouteLegs estimates every leg of a route at a constant average speed.
+func RouteLegs(route []Point, avgSpeedKmh float64) []Leg {
+	if len(route) < 2 {
+		return nil
+	}
+	metersPerSecond := avgSpeedKmh * 1000 / 3600
+	legs := make([]Leg, 0, len(route)-1)
+	for i := 1; i < len(route); i++ {
+		distance := HaversineMeters(route[i-1].Lat, route[i-1].Lng, route[i].Lat, route[i].Lng)
+		leg := Leg{DistanceMeters: int64(math.Round(distance))}
+		if metersPerSecond > 0 {
+			leg.DurationSeconds = int64(math.Round(distance / metersPerSecond))
+		}
+		legs = append(legs, leg)
+	}
+	return legs
+}
+
+// ArriveAtStop records the driver reaching a stop. Every earlier stop must
+// have been departed; arriving twice keeps the first arrival time.
+func (r Ride) ArriveAtStop(seq int, at time.Time) (Ride, error) {
+	idx, err := r.stopIndex(seq)
+	if err != nil {
+		return r, err
+	}
+	if !r.Stops[idx].ArrivedAt.IsZero() {
+		return r, nil
+	}
+	if idx > 0 && r.Stops[idx-1].DepartedAt.IsZero() {
+		return r, ErrStopOutOfOrder
+	}
+	r.Stops = append([]Stop(nil), r.Stops...)
+	r.Stops[idx].ArrivedAt = at
+	r.Version++
+	return r, nil
+}
+
+// DepartStop records the driver leaving a stop it has arrived at; departing
+// twice keeps the first departure time.
+func (r Ride) DepartStop(seq int, at time.Time) (Ride, error) {
+	idx, err := r.stopIndex(seq)
+	if err != nil {
+		return r, err
+	}
+	if !r.Stops[idx].DepartedAt.IsZero() {
+		return r, nil
+	}
+	if r.Stops[idx].ArrivedAt.IsZero() {
+		return r, ErrStopOutOfOrder
+	}
+	r.Stops = append([]Stop(nil), r.Stops...)
+	r.Stops[idx].DepartedAt = at
+	r.Version++
+	return r, nil
+}
+
+// StopIndex finds the position in Stops of the stop numbered seq.
+func (r Ride) StopIndex(seq int) (int, bool) {
+	for i, s := range r.Stops {
+		if s.Seq == seq {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func (r Ride) stopIndex(seq int) (int, error) {
+	if r.Status != StatusInProgress {
+		return 0, ErrInvalidTransition
+	}
+	idx, ok := r.StopIndex(seq)
+	if !ok {
+		return 0, ErrStopNotFound
+	}
+	return idx, nil
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRideStopProgress(t *testing.T) {
+	stops, err := NewStops([]Point{{Lat: 1, Lng: 1}, {Lat: 2, Lng: 2}})
+	if err != nil {
+		t.Fatalf("stops error: %v", err)
+	}
+	ride := Ride{Status: StatusInProgress, Stops: stops}
+	at := time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	if _, err := ride.ArriveAtStop(2, at); !errors.Is(err, ErrStopOutOfOrder) {
+		t.Fatalf("expected out of order, got %v", err)
+	}
+	if _, err := ride.DepartStop(1, at); !errors.Is(err, ErrStopOutOfOrder) {
+		t.Fatalf("expected depart before arrival to fail, got %v", err)
+	}
+	if _, err := ride.ArriveAtStop(3, at); !errors.Is(err, ErrStopNotFound) {
+		t.Fatalf("expected stop not found, got %v", err)
+	}
+
+	arrived, err := ride.ArriveAtStop(1, at)
+	if err != nil {
+		t.Fatalf("arrive error: %v", err)
+	}
+	if !ride.Stops[0].ArrivedAt.IsZero() {
+		t.Fatalf("expected original ride untouched")
+	}
+	again, _ := arrived.ArriveAtStop(1, at.Add(time.Minute))
+	if !again.Stops[0].ArrivedAt.Equal(at) {
+		t.Fatalf("expected first arrival kept, got %v", again.Stops[0].ArrivedAt)
+	}
+	departed, err := arrived.DepartStop(1, at.Add(2*time.Minute))
+	if err != nil {
+		t.Fatalf("depart error: %v", err)
+	}
+	if _, err := departed.ArriveAtStop(2, at.Add(5*time.Minute)); err != nil {
+		t.Fatalf("expected next stop reachable, got %v", err)
+	}
+
+	ride.Status = StatusDriverAssigned
+	if _, err := ride.ArriveAtStop(1, at); !errors.Is(err, ErrInvalidTransition) {
+		t.Fatalf("expected stops to require an in-progress ride, got %v", err)
+	}
+}
+
+func TestNewStopsLimit(t *testing.T) {
+	if _, err := NewStops(make([]Point, MaxStops+1)); !errors.Is(err, ErrTooManyStops) {
+		t.Fatalf("expected too many stops, got %v", err)
+	}
+}
+
+func TestRouteLegs(t *testing.T) {
+	ride := Ride{PickupLat: 0, PickupLng: 0, DropoffLat: 0, DropoffLng: 0.02, Stops: []Stop{{Seq: 1, Lat: 0, Lng: 0.01}}}
+	legs := RouteLegs(ride.Route(), 36)
+	if len(legs) != 2 {
+		t.Fatalf("expected 2 legs, got %d", len(legs))
+	}
+	for _, leg := range legs {
+		// 0.01 degrees of longitude at the equator is ~1112m, 111s at 10m/s.
+		if leg.DistanceMeters < 1100 || leg.DistanceMeters > 1120 || leg.DurationSeconds < 110 || leg.DurationSeconds > 112 {
+			t.Fatalf("unexpected leg %+v", leg)
+		}
+	}
+}
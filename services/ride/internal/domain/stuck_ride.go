@@ -0,0 +1,43 @@
+package domain
+
+import "time"
+
+// StuckRidePolicy sets how long a ride may wait in each status before a
+// driver is assigned. A ride left longer than its status allows, e.g. because
+// matching dropped its ride.requested, is announced to matching again up to
+// MaxRedispatches times and then cancelled with SYSTEM_TIMEOUT. A zero
+// threshold leaves that status alone.
+type StuckRidePolicy struct {
+	Requested       time.Duration
+	Matching        time.Duration
+	Offered         time.Duration
+	MaxRedispatches int
+}
+
+func DefaultStuckRidePolicy() StuckRidePolicy {
+	return StuckRidePolicy{
+		Requested:       time.Minute,
+		Matching:        3 * time.Minute,
+		Offered:         2 * time.Minute,
+		MaxRedispatches: 2,
+	}
+}
+
+// Threshold is how long a ride may sit in status without changing.
+func (p StuckRidePolicy) Threshold(status RideStatus) time.Duration {
+	switch status {
+	case StatusRequested:
+		return p.Requested
+	case StatusMatching:
+		return p.Matching
+	case StatusOffered:
+		return p.Offered
+	}
+	return 0
+}
+
+// CanRedispatch reports whether a stuck ride gets another try at matching
+// rather than being timed out.
+func (p StuckRidePolicy) CanRedispatch(ride Ride) bool {
+	return ride.Redispatches < p.MaxRedispatches
+}
@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStuckRidePolicy(t *testing.T) {
+	p := DefaultStuckRidePolicy()
+	if p.Threshold(StatusMatching) != 3*time.Minute || p.Threshold(StatusDriverAssigned) != 0 {
+		t.Fatalf("unexpected thresholds: matching=%s assigned=%s", p.Threshold(StatusMatching), p.Threshold(StatusDriverAssigned))
+	}
+	if !p.CanRedispatch(Ride{Redispatches: 1}) {
+		t.Fatalf("expected a second redispatch to be allowed")
+	}
+	if p.CanRedispatch(Ride{Redispatches: 2}) {
+		t.Fatalf("expected redispatches to stop at the limit")
+	}
+}
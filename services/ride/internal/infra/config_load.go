@@ -9,6 +9,8 @@ func LoadConfig() Config {
 	cfg.ShutdownTimeoutSeconds = viper.GetInt("shutdown.timeout")
 	cfg.PostgresDSN = viper.GetString("postgres.dsn")
 	cfg.IdempotencyTTLSeconds = viper.GetInt("idempotency.ttl_seconds")
+	cfg.IdempotencyLeaseSec = viper.GetInt("idempotency.lease_seconds")
+	cfg.IdempotencyWaitMillis = viper.GetInt("idempotency.wait_millis")
 	cfg.NATSURL = viper.GetString("nats.url")
 	cfg.NATSSelfHeal = viper.GetBool("nats.self_heal")
 	cfg.OutboxEnabled = viper.GetBool("outbox.enabled")
@@ -16,6 +18,9 @@ func LoadConfig() Config {
 	cfg.OutboxBatchSize = viper.GetInt("outbox.batch_size")
 	cfg.OutboxMaxAttempts = viper.GetInt("outbox.max_attempts")
 	cfg.OutboxRetentionHours = viper.GetInt("outbox.retention_hours")
+	cfg.OutboxLeaseSeconds = viper.GetInt("outbox.lease_seconds")
+	cfg.OutboxReapIntervalMs = viper.GetInt("outbox.reap_interval_millis")
+	cfg.OutboxListenEnabled = viper.GetBool("outbox.listen_enabled")
 	cfg.OfferExpiryEnabled = viper.GetBool("offer_expiry.enabled")
 	cfg.OfferExpiryIntervalMs = viper.GetInt("offer_expiry.interval_millis")
 	cfg.OfferExpiryBatchSize = viper.GetInt("offer_expiry.batch_size")
@@ -31,5 +36,65 @@ func LoadConfig() Config {
 	cfg.UserRequestTimeoutSec = viper.GetInt("grpc.user_request_timeout_seconds")
 	cfg.UserRetryMax = viper.GetInt("grpc.user_retry_max")
 	cfg.UserRetryBackoffMs = viper.GetInt("grpc.user_retry_backoff_ms")
+	cfg.MatchingAddr = viper.GetString("grpc.matching_addr")
+	cfg.Pricing.Currency = viper.GetString("pricing.currency")
+	cfg.Pricing.DefaultProduct = viper.GetString("pricing.default_product")
+	cfg.Pricing.AvgSpeedKmh = viper.GetFloat64("pricing.avg_speed_kmh")
+	cfg.Pricing.QuoteTTLSeconds = viper.GetInt("pricing.quote_ttl_seconds")
+	cfg.Pricing.QuoteSecret = viper.GetString("pricing.quote_secret")
+	cfg.Pricing.SurgeEnabled = viper.GetBool("pricing.surge_enabled")
+	cfg.Scheduler.Enabled = viper.GetBool("scheduler.enabled")
+	cfg.Scheduler.IntervalMs = viper.GetInt("scheduler.interval_millis")
+	cfg.Scheduler.BatchSize = viper.GetInt("scheduler.batch_size")
+	cfg.Scheduler.DispatchLeadSeconds = viper.GetInt("scheduler.dispatch_lead_seconds")
+	cfg.Scheduler.ReminderLeadSeconds = viper.GetInt("scheduler.reminder_lead_seconds")
+	cfg.Scheduler.MinAdvanceSeconds = viper.GetInt("scheduler.min_advance_seconds")
+	cfg.Scheduler.MaxAdvanceSeconds = viper.GetInt("scheduler.max_advance_seconds")
+	cfg.Scheduler.DispatchTimeoutSeconds = viper.GetInt("scheduler.dispatch_timeout_seconds")
+	cfg.Cancellation.FreeWindowSeconds = viper.GetInt("cancellation.free_window_seconds")
+	cfg.Cancellation.RiderFee = viper.GetInt64("cancellation.rider_fee")
+	cfg.Cancellation.StrikeWindowHours = viper.GetInt("cancellation.strike_window_hours")
+	cfg.Cancellation.ConfirmTTLSeconds = viper.GetInt("cancellation.confirm_ttl_seconds")
+	cfg.PickupPIN.Enabled = viper.GetBool("pickup_pin.enabled")
+	cfg.PickupPIN.Secret = viper.GetString("pickup_pin.secret")
+	cfg.PickupPIN.NotifyPublicKey = viper.GetString("pickup_pin.notify_public_key")
+	cfg.PickupPIN.Length = viper.GetInt("pickup_pin.length")
+	cfg.PickupPIN.MaxAttempts = viper.GetInt("pickup_pin.max_attempts")
+	cfg.Arrival.GeofenceEnabled = viper.GetBool("arrival.geofence_enabled")
+	cfg.Arrival.DriverLocationSubject = viper.GetString("arrival.driver_location_subject")
+	cfg.Arrival.RadiusMeters = viper.GetFloat64("arrival.radius_meters")
+	cfg.Arrival.GraceSeconds = viper.GetInt("arrival.grace_seconds")
+	cfg.Arrival.NoShowFee = viper.GetInt64("arrival.no_show_fee")
+	cfg.StuckRides.Enabled = viper.GetBool("stuck_rides.enabled")
+	cfg.StuckRides.IntervalMs = viper.GetInt("stuck_rides.interval_millis")
+	cfg.StuckRides.BatchSize = viper.GetInt("stuck_rides.batch_size")
+	cfg.StuckRides.RequestedSeconds = viper.GetInt("stuck_rides.requested_seconds")
+	cfg.StuckRides.MatchingSeconds = viper.GetInt("stuck_rides.matching_seconds")
+	cfg.StuckRides.OfferedSeconds = viper.GetInt("stuck_rides.offered_seconds")
+	cfg.StuckRides.MaxRedispatches = viper.GetInt("stuck_rides.max_redispatches")
+	cfg.Ratings.WindowHours = viper.GetInt("ratings.window_hours")
+	cfg.Ratings.MaxTags = viper.GetInt("ratings.max_tags")
+	cfg.Ratings.MaxCommentLength = viper.GetInt("ratings.max_comment_length")
+	cfg.Payments.Enabled = viper.GetBool("payments.enabled")
+	cfg.Payments.Provider = viper.GetString("payments.provider")
+	cfg.Payments.FakeDeclineAbove = viper.GetInt64("payments.fake_decline_above")
+	cfg.Payments.IntervalMs = viper.GetInt("payments.interval_millis")
+	cfg.Payments.BatchSize = viper.GetInt("payments.batch_size")
+	cfg.Payments.MaxAttempts = viper.GetInt("payments.max_attempts")
+	cfg.Ledger.CommissionPercent = viper.GetFloat64("ledger.commission_percent")
+	cfg.Ledger.PayoutMinAmount = viper.GetInt64("ledger.payout_min_amount")
+	cfg.Ledger.PayoutBatchSize = viper.GetInt("ledger.payout_batch_size")
+	if viper.IsSet("ledger.product_commission_percent") {
+		commission := map[string]float64{}
+		if err := viper.UnmarshalKey("ledger.product_commission_percent", &commission); err == nil {
+			cfg.Ledger.ProductCommissionPercent = commission
+		}
+	}
+	if viper.IsSet("pricing.products") {
+		products := map[string]ProductPricing{}
+		if err := viper.UnmarshalKey("pricing.products", &products); err == nil {
+			cfg.Pricing.Products = products
+		}
+	}
 	return cfg
 }
@@ -5,9 +5,37 @@ import (
 	"time"
 )
 
+const (
+	IdempotencyInProgress = "IN_PROGRESS"
+	IdempotencyCompleted  = "COMPLETED"
+)
+
+// IdempotencyRecord is one key within a scope (operation and caller). While
+// IN_PROGRESS it is a reservation held until LockedUntil; once COMPLETED it
+// carries the response to replay.
+type IdempotencyRecord struct {
+	Scope       string
+	Key         string
+	RequestHash string
+	Status      string
+	Response    string
+	LockedUntil time.Time
+	CreatedAt   time.Time
+}
+
 type IdempotencyRepo interface {
-	Get(ctx context.Context, key string) (string, bool, error)
-	Save(ctx context.Context, key string, response string) error
+	// Reserve inserts record as IN_PROGRESS, or takes over an existing
+	// reservation for the same request whose lease expired before now. When
+	// the key is held it returns the existing record and false.
+	Reserve(ctx context.Context, record IdempotencyRecord, now time.Time) (IdempotencyRecord, bool, error)
+	Get(ctx context.Context, scope, key string) (IdempotencyRecord, bool, error)
+	// Complete stores the response on the reservation Reserve returned. Its
+	// LockedUntil is the claim token: once the lease lapsed and another request
+	// took the key over, Complete returns ErrConflict.
+	Complete(ctx context.Context, reservation IdempotencyRecord, response string) error
+	// Release drops a reservation that never completed, leaving the key alone
+	// if another request has since taken it over.
+	Release(ctx context.Context, reservation IdempotencyRecord) error
 }
 
 type IdempotencyCleanup interface {
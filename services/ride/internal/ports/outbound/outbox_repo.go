@@ -2,25 +2,134 @@ package outbound
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
+// ErrLeaseLost reports that a claimed outbox row is no longer held by the
+// caller: its lease ran out and ReclaimExpired handed it on.
+var ErrLeaseLost = errors.New("outbox lease lost")
+
+// OutboxMessage is one event to publish. Messages sharing an AggregateID
+// (the ride) are published in the order they were enqueued; messages
+// without one are unordered.
 type OutboxMessage struct {
-	ID      string
-	Topic   string
-	Payload string
-	Attempt int
+	ID          string
+	Topic       string
+	Payload     string
+	AggregateID string
+	Attempt     int
+	CreatedAt   time.Time
+	// ClaimedAt is the claim token Claim handed out; a claimed row is only
+	// marked or unclaimed while it still carries it.
+	ClaimedAt time.Time
+}
+
+// OutboxReclaim counts PROCESSING rows whose lease ran out: Requeued went
+// back to PENDING, Failed had no attempts left and were dead-lettered.
+type OutboxReclaim struct {
+	Requeued int64
+	Failed   int64
 }
 
 type OutboxRepo interface {
 	Enqueue(ctx context.Context, msg OutboxMessage) error
-	Claim(ctx context.Context, limit int, maxAttempts int) ([]OutboxMessage, error)
-	MarkSent(ctx context.Context, id string) error
-	MarkFailed(ctx context.Context, id string, reason string, nextAttemptAt time.Time) error
+	// Claim moves up to limit due rows to PROCESSING under a lease. A claim
+	// that is neither marked sent nor failed before the lease ends is returned
+	// to PENDING by ReclaimExpired. A row is only claimed once every earlier
+	// row of its aggregate has been sent or is in the same batch, which comes
+	// back in enqueue order.
+	Claim(ctx context.Context, limit int, maxAttempts int, lease time.Duration) ([]OutboxMessage, error)
+	// MarkSent, MarkFailed and Unclaim settle a claimed message. They return
+	// ErrLeaseLost, and change nothing, once the row has been reclaimed.
+	MarkSent(ctx context.Context, msg OutboxMessage) error
+	MarkFailed(ctx context.Context, msg OutboxMessage, reason string, nextAttemptAt time.Time) error
+	// Unclaim hands a claimed row back to PENDING without spending the
+	// attempt, for rows held back behind a failed row of the same aggregate.
+	Unclaim(ctx context.Context, msg OutboxMessage) error
 	DeleteSentBefore(ctx context.Context, cutoff time.Time) (int64, error)
 	ResetFailed(ctx context.Context, limit int) (int64, error)
+	ReclaimExpired(ctx context.Context, limit int, maxAttempts int) (OutboxReclaim, error)
 }
 
 type OutboxPublisher interface {
-	Publish(ctx context.Context, subject string, payload []byte) error
+	// PublishMsg publishes payload with msgID as the broker dedup key.
+	PublishMsg(ctx context.Context, subject string, msgID string, payload []byte) error
+}
+
+// OutboxRecord is a full outbox row as operators see it.
+type OutboxRecord struct {
+	ID           string
+	Seq          int64
+	Topic        string
+	Payload      string
+	AggregateID  string
+	Status       string
+	AttemptCount int
+	LastError    string
+	AvailableAt  time.Time
+	LeaseUntil   time.Time
+	CreatedAt    time.Time
+}
+
+// OutboxFilter narrows an admin listing. Rows come back in enqueue order,
+// starting after AfterSeq.
+type OutboxFilter struct {
+	Status        string
+	Topic         string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	AfterSeq      int64
+	Limit         int
+}
+
+// OutboxSelection picks rows for a bulk admin action: either explicit IDs,
+// or every row in Statuses matching Topic and the created_at range.
+type OutboxSelection struct {
+	IDs           []string
+	Statuses      []string
+	Topic         string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+}
+
+// OutboxAudit is the note recorded for every row an admin action touched.
+type OutboxAudit struct {
+	Action string
+	Actor  string
+	Note   string
+	At     time.Time
+}
+
+type OutboxStatusStats struct {
+	Status string
+	Count  int64
+	Oldest time.Time
+}
+
+type OutboxTopicBacklog struct {
+	Topic      string
+	Pending    int64
+	Processing int64
+	Failed     int64
+}
+
+type OutboxStats struct {
+	Statuses []OutboxStatusStats
+	Topics   []OutboxTopicBacklog
+}
+
+// OutboxAdminRepo backs the operator tooling. Every change is written to the
+// outbox audit log in the same transaction.
+type OutboxAdminRepo interface {
+	List(ctx context.Context, filter OutboxFilter) ([]OutboxRecord, error)
+	Get(ctx context.Context, id string) (OutboxRecord, error)
+	// Replay makes the selected rows PENDING with a fresh attempt budget.
+	Replay(ctx context.Context, selection OutboxSelection, audit OutboxAudit) (int64, error)
+	// Skip marks the selected rows SKIPPED so they are never published and
+	// no longer hold back their aggregate.
+	Skip(ctx context.Context, selection OutboxSelection, audit OutboxAudit) (int64, error)
+	// Purge deletes the selected rows, keeping their payload in the audit log.
+	Purge(ctx context.Context, selection OutboxSelection, audit OutboxAudit) (int64, error)
+	Stats(ctx context.Context, topicLimit int) (OutboxStats, error)
 }
@@ -0,0 +1,43 @@
+package outbound
+
+import "context"
+
+// PaymentProvider is the card processor behind ride payments. Every call
+// carries an idempotency key: repeating a call with the same key returns the
+// first outcome and never moves money twice.
+type PaymentProvider interface {
+	Authorize(ctx context.Context, req AuthorizeRequest) (PaymentResult, error)
+	Capture(ctx context.Context, req CaptureRequest) (PaymentResult, error)
+	Void(ctx context.Context, req VoidRequest) (PaymentResult, error)
+	Refund(ctx context.Context, req RefundRequest) (PaymentResult, error)
+}
+
+type AuthorizeRequest struct {
+	IdempotencyKey string
+	CustomerID     string
+	Reference      string
+	Amount         int64
+	Currency       string
+}
+
+type CaptureRequest struct {
+	IdempotencyKey string
+	ProviderRef    string
+	Amount         int64
+}
+
+type VoidRequest struct {
+	IdempotencyKey string
+	ProviderRef    string
+}
+
+type RefundRequest struct {
+	IdempotencyKey string
+	ProviderRef    string
+	Amount         int64
+}
+
+type PaymentResult struct {
+	ProviderRef string
+	Amount      int64
+}
@@ -15,5 +15,12 @@ type RideOfferRepo interface {
 	Create(ctx context.Context, offer RideOffer) error
 	Get(ctx context.Context, id string) (RideOffer, error)
 	UpdateStatusIfCurrent(ctx context.Context, id string, currentStatus string, nextStatus string) error
-	ListExpired(ctx context.Context, cutoff int64, limit int) ([]RideOffer, error)
+	// ExpireDue claims up to limit PENDING offers that expired by cutoff, oldest
+	// first, and marks them EXPIRED in one statement. Offers locked by another
+	// transaction are skipped, so concurrent sweeps never claim the same offer.
+	// It returns the expired offers.
+	ExpireDue(ctx context.Context, cutoff int64, limit int) ([]RideOffer, error)
+	// ExpirePendingForRide marks the ride's PENDING offers EXPIRED whatever
+	// their expiry, and returns them.
+	ExpirePendingForRide(ctx context.Context, rideID string) ([]RideOffer, error)
 }
@@ -3,6 +3,7 @@ package outbound
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 )
 
@@ -11,22 +12,278 @@ var (
 	ErrConflict = errors.New("conflict")
 )
 
+// VersionConflictError is returned when a ride write was made from a stale
+// read: the stored ride is no longer at the version the caller loaded. It
+// matches ErrConflict, and Current is the version the caller should re-read.
+type VersionConflictError struct {
+	RideID  string
+	Current int64
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("ride %s version conflict: current version %d", e.RideID, e.Current)
+}
+
+func (e *VersionConflictError) Unwrap() error {
+	return ErrConflict
+}
+
 type Ride struct {
+	ID              string
+	RiderID         string
+	DriverID        *string
+	Status          string
+	PickupLat       float64
+	PickupLng       float64
+	DropoffLat      float64
+	DropoffLng      float64
+	Product         string
+	FareAmount      int64
+	Currency        string
+	QuoteID         *string
+	PickupAt        *time.Time
+	Stops           []RideStop
+	AssignedAt      *time.Time
+	ArrivedAt       *time.Time
+	CompletedAt     *time.Time
+	CancellationFee int64
+	CancelReason    *string
+	Redispatches    int
+	Version         int64
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type RideStop struct {
+	Seq        int
+	Lat        float64
+	Lng        float64
+	ArrivedAt  *time.Time
+	DepartedAt *time.Time
+}
+
+type RideEvent struct {
 	ID         string
-	RiderID    string
-	DriverID   *string
-	Status     string
-	PickupLat  float64
-	PickupLng  float64
-	DropoffLat float64
-	DropoffLng float64
+	RideID     string
+	FromStatus string
+	ToStatus   string
+	Actor      string
+	ActorID    *string
+	Reason     string
 	CreatedAt  time.Time
-	UpdatedAt  time.Time
+}
+
+type DriverExclusion struct {
+	RideID    string
+	DriverID  string
+	Reason    string
+	CreatedAt time.Time
+}
+
+// RideCancellation is what gets recorded on a ride when it is cancelled.
+type RideCancellation struct {
+	Reason string
+	Fee    int64
+}
+
+type CancellationStrike struct {
+	ID        string
+	UserID    string
+	Role      string
+	RideID    string
+	Reason    string
+	CreatedAt time.Time
+}
+
+// PickupPIN is the stored hash of a ride's pickup PIN and its lockout state.
+type PickupPIN struct {
+	RideID         string
+	Hash           string
+	FailedAttempts int
+	LockedAt       *time.Time
+	OverriddenAt   *time.Time
+	OverriddenBy   *string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type RideRating struct {
+	RideID    string
+	RaterRole string
+	RaterID   string
+	RateeID   string
+	Stars     int
+	Tags      []string
+	Comment   string
+	CreatedAt time.Time
+}
+
+type Payment struct {
+	ID             string
+	RideID         string
+	RiderID        string
+	Amount         int64
+	CaptureAmount  int64
+	CapturedAmount int64
+	RefundedAmount int64
+	Currency       string
+	Status         string
+	Provider       string
+	ProviderRef    string
+	Attempts       int
+	LastError      string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type LedgerEntry struct {
+	AccountID string
+	OwnerType string
+	OwnerID   string
+	Direction string
+	Kind      string
+	Amount    int64
+}
+
+type LedgerPosting struct {
+	ID        string
+	Reference string
+	RideID    string
+	Currency  string
+	Entries   []LedgerEntry
+	CreatedAt time.Time
+}
+
+type EarningsQuery struct {
+	DriverID string
+	Period   string
+	From     time.Time
+	To       time.Time
+}
+
+type EarningsBucket struct {
+	PeriodStart time.Time
+	Currency    string
+	Earnings    int64
+	Tips        int64
+	Fees        int64
+	Payouts     int64
+	Rides       int
+}
+
+type AccountBalance struct {
+	OwnerID  string
+	Currency string
+	Balance  int64
+}
+
+type RideCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+type RideFilter struct {
+	RiderID     string
+	DriverID    string
+	Status      string
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	After       *RideCursor
+	Limit       int
 }
 
 type RideRepo interface {
+	// Create returns a *domain.ActiveRideError without a RideID when the rider
+	// already has an active ride.
 	Create(ctx context.Context, ride Ride) error
 	Get(ctx context.Context, id string) (Ride, error)
-	UpdateStatusIfCurrent(ctx context.Context, id string, currentStatus string, nextStatus string, updatedAt time.Time) error
-	AssignDriverIfCurrent(ctx context.Context, id string, driverID string, currentStatus string, nextStatus string, updatedAt time.Time) error
+	// GetActiveByRider and GetActiveByDriver return the one ride the user holds
+	// in an active status, or ErrNotFound.
+	GetActiveByRider(ctx context.Context, riderID string) (Ride, error)
+	GetActiveByDriver(ctx context.Context, driverID string) (Ride, error)
+	List(ctx context.Context, filter RideFilter) ([]Ride, error)
+	// The ride writes below compare-and-swap on version: they apply only while
+	// the stored ride is still at version, bump it by one, and otherwise return
+	// ErrNotFound or a *VersionConflictError. A write that would give the rider
+	// or driver a second active ride returns a *domain.ActiveRideError.
+	UpdateStatusIfCurrent(ctx context.Context, id string, version int64, currentStatus string, nextStatus string, updatedAt time.Time) error
+	AssignDriverIfCurrent(ctx context.Context, id string, version int64, driverID string, currentStatus string, nextStatus string, updatedAt time.Time) error
+	// ArriveIfCurrent moves a ride in currentStatus to DRIVER_ARRIVED and stamps
+	// arrivedAt, which starts the rider's wait.
+	ArriveIfCurrent(ctx context.Context, id string, version int64, currentStatus string, arrivedAt time.Time) error
+	// CancelIfCurrent moves a ride in currentStatus to CANCELLED and records the
+	// reason and fee.
+	CancelIfCurrent(ctx context.Context, id string, version int64, currentStatus string, cancellation RideCancellation, updatedAt time.Time) error
+	// CompleteIfCurrent moves a ride in currentStatus to COMPLETED and stamps
+	// completedAt, which opens the rating window.
+	CompleteIfCurrent(ctx context.Context, id string, version int64, currentStatus string, completedAt time.Time) error
+	// ReleaseDriverIfCurrent clears the driver of a ride still held by driverID
+	// in currentStatus and moves it to nextStatus.
+	ReleaseDriverIfCurrent(ctx context.Context, id string, version int64, driverID string, currentStatus string, nextStatus string, updatedAt time.Time) error
+	// ExcludeDriver keeps a driver from being offered the ride again; excluding
+	// the same driver twice is a no-op.
+	ExcludeDriver(ctx context.Context, exclusion DriverExclusion) error
+	ListExcludedDrivers(ctx context.Context, rideID string) ([]string, error)
+	// AddStrike records a cancellation strike; a second strike for the same
+	// user and ride is a no-op.
+	AddStrike(ctx context.Context, strike CancellationStrike) error
+	CountStrikes(ctx context.Context, userID string, since time.Time) (int, error)
+	// ClaimScheduled locks SCHEDULED rides with a pickup at or before cutoff,
+	// skipping rows another transaction already holds and rides whose rider
+	// is still on an active ride.
+	ClaimScheduled(ctx context.Context, cutoff time.Time, limit int) ([]Ride, error)
+	// ClaimOverdueScheduled locks SCHEDULED rides with a pickup at or before
+	// cutoff whatever their rider is doing, so they can be timed out.
+	ClaimOverdueScheduled(ctx context.Context, cutoff time.Time, limit int) ([]Ride, error)
+	// ClaimReminders locks SCHEDULED rides due a reminder by cutoff that have
+	// not been reminded yet.
+	ClaimReminders(ctx context.Context, cutoff time.Time, limit int) ([]Ride, error)
+	// ClaimStuck locks rides that have sat in status since before cutoff,
+	// oldest first, skipping rows another transaction already holds.
+	ClaimStuck(ctx context.Context, status string, cutoff time.Time, limit int) ([]Ride, error)
+	// RedispatchIfCurrent moves a ride in currentStatus to nextStatus and counts
+	// one more redispatch; updatedAt restarts the ride's wait.
+	RedispatchIfCurrent(ctx context.Context, id string, version int64, currentStatus string, nextStatus string, updatedAt time.Time) error
+	MarkReminderSent(ctx context.Context, id string, version int64, sentAt time.Time) error
+	ReschedulePickup(ctx context.Context, id string, version int64, pickupAt time.Time, updatedAt time.Time) error
+	MarkStopArrived(ctx context.Context, rideID string, version int64, seq int, at time.Time) error
+	MarkStopDeparted(ctx context.Context, rideID string, version int64, seq int, at time.Time) error
+	// SavePickupPIN stores the ride's pickup PIN, replacing an earlier one
+	// together with its failed attempts, lock and override.
+	SavePickupPIN(ctx context.Context, pin PickupPIN) error
+	GetPickupPIN(ctx context.Context, rideID string) (PickupPIN, error)
+	// RecordPickupPINFailure counts a wrong PIN entry and locks the PIN once
+	// maxAttempts is reached. It returns ErrConflict when the PIN is already
+	// locked or overridden.
+	RecordPickupPINFailure(ctx context.Context, rideID string, maxAttempts int, at time.Time) (PickupPIN, error)
+	// OverridePickupPIN lifts the PIN requirement from the ride, lock included.
+	OverridePickupPIN(ctx context.Context, rideID string, actorID string, at time.Time) error
+	// AddRating stores one side's rating; it returns ErrConflict when that side
+	// has already rated the ride.
+	AddRating(ctx context.Context, rating RideRating) error
+	CreatePayment(ctx context.Context, payment Payment) error
+	GetPaymentByRide(ctx context.Context, rideID string) (Payment, error)
+	// UpdatePaymentIfCurrent writes payment only while its stored status is
+	// still currentStatus, returning ErrConflict otherwise.
+	UpdatePaymentIfCurrent(ctx context.Context, payment Payment, currentStatus string) error
+	// ListPendingPayments returns payments waiting on the provider, oldest
+	// first, without locking them.
+	ListPendingPayments(ctx context.Context, limit int) ([]Payment, error)
+	// PostLedger writes a balanced posting, opening accounts on first use. It
+	// returns ErrConflict when the reference has already been posted.
+	PostLedger(ctx context.Context, posting LedgerPosting) error
+	// LedgerPosted reports whether reference has already been posted.
+	LedgerPosted(ctx context.Context, reference string) (bool, error)
+	// DriverEarnings sums a driver's ledger entries into DAILY or WEEKLY UTC
+	// buckets in [From, To), oldest first.
+	DriverEarnings(ctx context.Context, query EarningsQuery) ([]EarningsBucket, error)
+	// DriverBalances returns up to limit driver accounts holding at least
+	// minBalance without locking anything.
+	DriverBalances(ctx context.Context, minBalance int64, limit int) ([]AccountBalance, error)
+	// ClaimDriverBalances is DriverBalances for a payout: it locks the
+	// accounts it returns, and only those, so concurrent payout batches cannot
+	// pay out the same balance twice.
+	ClaimDriverBalances(ctx context.Context, minBalance int64, limit int) ([]AccountBalance, error)
+	AppendEvent(ctx context.Context, event RideEvent) error
+	ListEvents(ctx context.Context, rideID string) ([]RideEvent, error)
 }
@@ -0,0 +1,12 @@
+package outbound
+
+import (
+	"context"
+
+	matchingv1 "github.com/daffahilmyf/ride-hailing/proto/matching/v1"
+	"google.golang.org/grpc"
+)
+
+type SurgeService interface {
+	GetSurge(ctx context.Context, in *matchingv1.GetSurgeRequest, opts ...grpc.CallOption) (*matchingv1.GetSurgeResponse, error)
+}
@@ -3,6 +3,9 @@ package outbound
 type Tx interface {
 	Commit() error
 	Rollback() error
+	// Savepoint runs fn under a savepoint: when fn fails its writes are
+	// rolled back and the transaction stays usable. fn's error is returned.
+	Savepoint(name string, fn func() error) error
 	RideRepo() RideRepo
 	IdempotencyRepo() IdempotencyRepo
 	OutboxRepo() OutboxRepo
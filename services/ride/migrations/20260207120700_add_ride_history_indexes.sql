@@ -0,0 +1,9 @@
+-- +goose Up
+CREATE INDEX IF NOT EXISTS rides_created_id_idx ON rides (created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS rides_rider_created_idx ON rides (rider_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS rides_driver_created_idx ON rides (driver_id, created_at DESC, id DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS rides_driver_created_idx;
+DROP INDEX IF EXISTS rides_rider_created_idx;
+DROP INDEX IF EXISTS rides_created_id_idx;
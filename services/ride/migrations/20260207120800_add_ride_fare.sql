@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE rides
+  ADD COLUMN IF NOT EXISTS product TEXT NOT NULL DEFAULT 'standard',
+  ADD COLUMN IF NOT EXISTS fare_amount BIGINT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS fare_currency TEXT NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS quote_id UUID NULL;
+
+-- +goose Down
+ALTER TABLE rides
+  DROP COLUMN IF EXISTS quote_id,
+  DROP COLUMN IF EXISTS fare_currency,
+  DROP COLUMN IF EXISTS fare_amount,
+  DROP COLUMN IF EXISTS product;
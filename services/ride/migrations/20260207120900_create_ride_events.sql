@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS ride_events (
+  id UUID PRIMARY KEY,
+  ride_id UUID NOT NULL REFERENCES rides (id) ON DELETE CASCADE,
+  from_status TEXT NOT NULL DEFAULT '',
+  to_status TEXT NOT NULL,
+  actor TEXT NOT NULL,
+  actor_id UUID NULL,
+  reason TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS ride_events_ride_created_idx ON ride_events (ride_id, created_at, id);
+
+-- +goose Down
+DROP TABLE IF EXISTS ride_events;
@@ -0,0 +1,12 @@
+-- +goose Up
+ALTER TABLE rides
+  ADD COLUMN IF NOT EXISTS pickup_at TIMESTAMPTZ NULL,
+  ADD COLUMN IF NOT EXISTS reminder_sent_at TIMESTAMPTZ NULL;
+
+CREATE INDEX IF NOT EXISTS rides_scheduled_pickup_idx ON rides (pickup_at) WHERE status = 'SCHEDULED';
+
+-- +goose Down
+DROP INDEX IF EXISTS rides_scheduled_pickup_idx;
+ALTER TABLE rides
+  DROP COLUMN IF EXISTS reminder_sent_at,
+  DROP COLUMN IF EXISTS pickup_at;
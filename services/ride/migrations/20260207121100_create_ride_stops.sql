@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS ride_stops (
+  ride_id UUID NOT NULL REFERENCES rides(id) ON DELETE CASCADE,
+  seq INT NOT NULL,
+  lat DOUBLE PRECISION NOT NULL,
+  lng DOUBLE PRECISION NOT NULL,
+  arrived_at TIMESTAMPTZ NULL,
+  departed_at TIMESTAMPTZ NULL,
+  PRIMARY KEY (ride_id, seq)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS ride_stops;
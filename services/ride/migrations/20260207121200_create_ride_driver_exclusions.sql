@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS ride_driver_exclusions (
+  ride_id UUID NOT NULL REFERENCES rides(id) ON DELETE CASCADE,
+  driver_id UUID NOT NULL,
+  reason TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (ride_id, driver_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS ride_driver_exclusions;
@@ -0,0 +1,24 @@
+-- +goose Up
+ALTER TABLE rides
+  ADD COLUMN IF NOT EXISTS assigned_at TIMESTAMPTZ NULL,
+  ADD COLUMN IF NOT EXISTS cancellation_fee BIGINT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS cancel_reason TEXT NULL;
+
+CREATE TABLE IF NOT EXISTS cancellation_strikes (
+  id UUID PRIMARY KEY,
+  user_id UUID NOT NULL,
+  role TEXT NOT NULL,
+  ride_id UUID NOT NULL REFERENCES rides(id) ON DELETE CASCADE,
+  reason TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  UNIQUE (ride_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS cancellation_strikes_user_idx ON cancellation_strikes (user_id, created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS cancellation_strikes;
+ALTER TABLE rides
+  DROP COLUMN IF EXISTS cancel_reason,
+  DROP COLUMN IF EXISTS cancellation_fee,
+  DROP COLUMN IF EXISTS assigned_at;
@@ -0,0 +1,22 @@
+-- +goose Up
+ALTER TABLE rides
+  ADD COLUMN IF NOT EXISTS completed_at TIMESTAMPTZ NULL;
+
+CREATE TABLE IF NOT EXISTS ride_ratings (
+  ride_id UUID NOT NULL REFERENCES rides(id) ON DELETE CASCADE,
+  rater_role TEXT NOT NULL,
+  rater_id UUID NOT NULL,
+  ratee_id UUID NOT NULL,
+  stars SMALLINT NOT NULL CHECK (stars BETWEEN 1 AND 5),
+  tags JSONB NOT NULL DEFAULT '[]',
+  comment TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (ride_id, rater_role)
+);
+
+CREATE INDEX IF NOT EXISTS ride_ratings_ratee_idx ON ride_ratings (ratee_id, created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS ride_ratings;
+ALTER TABLE rides
+  DROP COLUMN IF EXISTS completed_at;
@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS payments (
+  id UUID PRIMARY KEY,
+  ride_id UUID NOT NULL UNIQUE REFERENCES rides(id) ON DELETE CASCADE,
+  rider_id UUID NOT NULL,
+  amount BIGINT NOT NULL,
+  capture_amount BIGINT NOT NULL DEFAULT 0,
+  captured_amount BIGINT NOT NULL DEFAULT 0,
+  refunded_amount BIGINT NOT NULL DEFAULT 0,
+  currency TEXT NOT NULL,
+  status TEXT NOT NULL,
+  provider TEXT NOT NULL,
+  provider_ref TEXT NOT NULL DEFAULT '',
+  attempts INT NOT NULL DEFAULT 0,
+  last_error TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CHECK (captured_amount <= amount),
+  CHECK (refunded_amount <= captured_amount)
+);
+
+CREATE INDEX IF NOT EXISTS payments_pending_idx ON payments (updated_at)
+  WHERE status IN ('CAPTURE_PENDING', 'VOID_PENDING');
+
+-- +goose Down
+DROP TABLE IF EXISTS payments;
@@ -0,0 +1,76 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS ledger_accounts (
+  id TEXT PRIMARY KEY,
+  owner_type TEXT NOT NULL CHECK (owner_type IN ('rider', 'driver', 'platform')),
+  owner_id TEXT NOT NULL,
+  currency TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS ledger_accounts_owner_idx ON ledger_accounts (owner_type, owner_id);
+
+CREATE TABLE IF NOT EXISTS ledger_transactions (
+  id UUID PRIMARY KEY,
+  reference TEXT NOT NULL UNIQUE,
+  ride_id UUID NULL REFERENCES rides(id),
+  currency TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS ledger_entries (
+  id BIGSERIAL PRIMARY KEY,
+  transaction_id UUID NOT NULL REFERENCES ledger_transactions(id),
+  account_id TEXT NOT NULL REFERENCES ledger_accounts(id),
+  direction TEXT NOT NULL CHECK (direction IN ('DEBIT', 'CREDIT')),
+  kind TEXT NOT NULL,
+  amount BIGINT NOT NULL CHECK (amount > 0),
+  ride_id UUID NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS ledger_entries_account_idx ON ledger_entries (account_id, created_at);
+CREATE INDEX IF NOT EXISTS ledger_entries_transaction_idx ON ledger_entries (transaction_id);
+
+-- Every transaction must balance once its entries are in. The check is deferred
+-- to commit so entries can be inserted one at a time.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION ledger_check_balanced() RETURNS trigger AS $$
+DECLARE
+  net BIGINT;
+BEGIN
+  SELECT COALESCE(SUM(CASE direction WHEN 'DEBIT' THEN amount ELSE -amount END), 0)
+    INTO net
+    FROM ledger_entries
+   WHERE transaction_id = NEW.transaction_id;
+  IF net <> 0 THEN
+    RAISE EXCEPTION 'ledger transaction % is unbalanced by %', NEW.transaction_id, net;
+  END IF;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE CONSTRAINT TRIGGER ledger_entries_balanced
+  AFTER INSERT ON ledger_entries
+  DEFERRABLE INITIALLY DEFERRED
+  FOR EACH ROW EXECUTE FUNCTION ledger_check_balanced();
+
+-- The ledger is append-only: corrections are posted as new transactions.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION ledger_reject_change() RETURNS trigger AS $$
+BEGIN
+  RAISE EXCEPTION 'ledger entries are append-only';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER ledger_entries_append_only
+  BEFORE UPDATE OR DELETE ON ledger_entries
+  FOR EACH ROW EXECUTE FUNCTION ledger_reject_change();
+
+-- +goose Down
+DROP TABLE IF EXISTS ledger_entries;
+DROP TABLE IF EXISTS ledger_transactions;
+DROP TABLE IF EXISTS ledger_accounts;
+DROP FUNCTION IF EXISTS ledger_reject_change();
+DROP FUNCTION IF EXISTS ledger_check_balanced();
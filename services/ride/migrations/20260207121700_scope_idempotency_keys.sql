@@ -0,0 +1,26 @@
+-- +goose Up
+ALTER TABLE idempotency_keys
+  ADD COLUMN IF NOT EXISTS scope TEXT NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS request_hash TEXT NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'COMPLETED' CHECK (status IN ('IN_PROGRESS', 'COMPLETED')),
+  ADD COLUMN IF NOT EXISTS locked_until TIMESTAMPTZ NULL;
+
+ALTER TABLE idempotency_keys ALTER COLUMN response_body DROP NOT NULL;
+
+DROP INDEX IF EXISTS idempotency_keys_key_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS idempotency_keys_scope_key_idx ON idempotency_keys (scope, key);
+
+-- +goose Down
+DROP INDEX IF EXISTS idempotency_keys_scope_key_idx;
+DELETE FROM idempotency_keys WHERE status <> 'COMPLETED';
+DELETE FROM idempotency_keys a USING idempotency_keys b
+  WHERE a.key = b.key AND a.created_at > b.created_at;
+CREATE UNIQUE INDEX IF NOT EXISTS idempotency_keys_key_idx ON idempotency_keys (key);
+
+ALTER TABLE idempotency_keys ALTER COLUMN response_body SET NOT NULL;
+
+ALTER TABLE idempotency_keys
+  DROP COLUMN IF EXISTS locked_until,
+  DROP COLUMN IF EXISTS status,
+  DROP COLUMN IF EXISTS request_hash,
+  DROP COLUMN IF EXISTS scope;
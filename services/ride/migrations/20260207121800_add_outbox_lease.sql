@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE outbox
+  ADD COLUMN IF NOT EXISTS claimed_at TIMESTAMPTZ NULL,
+  ADD COLUMN IF NOT EXISTS lease_until TIMESTAMPTZ NULL;
+
+-- Rows already stranded in PROCESSING get an expired lease so the reaper
+-- picks them up on its first pass.
+UPDATE outbox SET lease_until = NOW() WHERE status = 'PROCESSING' AND lease_until IS NULL;
+
+CREATE INDEX IF NOT EXISTS outbox_lease_idx ON outbox (lease_until) WHERE status = 'PROCESSING';
+
+-- +goose Down
+DROP INDEX IF EXISTS outbox_lease_idx;
+
+ALTER TABLE outbox
+  DROP COLUMN IF EXISTS lease_until,
+  DROP COLUMN IF EXISTS claimed_at;
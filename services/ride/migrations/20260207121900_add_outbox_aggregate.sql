@@ -0,0 +1,31 @@
+-- +goose Up
+ALTER TABLE outbox
+  ADD COLUMN IF NOT EXISTS aggregate_id TEXT NULL,
+  ADD COLUMN IF NOT EXISTS seq BIGSERIAL;
+
+-- Unsent rows keep their ride ordering across the upgrade. ADD COLUMN numbers
+-- existing rows in heap order, so they are renumbered in enqueue order after
+-- every seq handed out so far, and the sequence moves past them.
+UPDATE outbox
+SET aggregate_id = NULLIF(payload::jsonb -> 'data' ->> 'ride_id', '')
+WHERE status <> 'SENT' AND aggregate_id IS NULL;
+
+UPDATE outbox o
+SET seq = ordered.seq
+FROM (
+  SELECT id, (SELECT COALESCE(MAX(seq), 0) FROM outbox) + row_number() OVER (ORDER BY created_at, id) AS seq
+  FROM outbox
+  WHERE status <> 'SENT'
+) ordered
+WHERE o.id = ordered.id;
+
+SELECT setval(pg_get_serial_sequence('outbox', 'seq'), COALESCE((SELECT MAX(seq) FROM outbox), 0) + 1, false);
+
+CREATE INDEX IF NOT EXISTS outbox_aggregate_seq_idx ON outbox (aggregate_id, seq) WHERE status <> 'SENT';
+
+-- +goose Down
+DROP INDEX IF EXISTS outbox_aggregate_seq_idx;
+
+ALTER TABLE outbox
+  DROP COLUMN IF EXISTS seq,
+  DROP COLUMN IF EXISTS aggregate_id;
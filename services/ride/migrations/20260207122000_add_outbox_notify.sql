@@ -0,0 +1,21 @@
+-- +goose Up
+-- Wakes outbox workers listening on outbox_enqueued as soon as an enqueue
+-- commits. Notifications are delivered at commit and coalesced per
+-- transaction, so a ride write that enqueues several events sends one.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION outbox_notify() RETURNS trigger AS $$
+BEGIN
+  PERFORM pg_notify('outbox_enqueued', '');
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+DROP TRIGGER IF EXISTS outbox_notify_insert ON outbox;
+CREATE TRIGGER outbox_notify_insert
+  AFTER INSERT ON outbox
+  FOR EACH STATEMENT EXECUTE FUNCTION outbox_notify();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS outbox_notify_insert ON outbox;
+DROP FUNCTION IF EXISTS outbox_notify();
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS outbox_audit (
+  id BIGSERIAL PRIMARY KEY,
+  outbox_id UUID NOT NULL,
+  action TEXT NOT NULL CHECK (action IN ('REPLAY', 'SKIP', 'PURGE')),
+  actor TEXT NOT NULL,
+  note TEXT NOT NULL DEFAULT '',
+  topic TEXT NOT NULL,
+  from_status TEXT NOT NULL,
+  payload TEXT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS outbox_audit_outbox_idx ON outbox_audit (outbox_id, created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS outbox_audit;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE rides ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE rides DROP COLUMN IF EXISTS version;
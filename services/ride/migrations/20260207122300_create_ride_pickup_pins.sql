@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS ride_pickup_pins (
+  ride_id UUID PRIMARY KEY REFERENCES rides(id) ON DELETE CASCADE,
+  pin_hash TEXT NOT NULL,
+  failed_attempts INT NOT NULL DEFAULT 0,
+  locked_at TIMESTAMPTZ NULL,
+  overridden_at TIMESTAMPTZ NULL,
+  overridden_by TEXT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS ride_pickup_pins;
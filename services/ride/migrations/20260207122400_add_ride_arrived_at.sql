@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE rides ADD COLUMN IF NOT EXISTS arrived_at TIMESTAMPTZ NULL;
+
+-- +goose Down
+ALTER TABLE rides DROP COLUMN IF EXISTS arrived_at;
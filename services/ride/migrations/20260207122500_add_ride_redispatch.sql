@@ -0,0 +1,8 @@
+-- +goose Up
+ALTER TABLE rides ADD COLUMN IF NOT EXISTS redispatch_count INT NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS rides_stuck_idx ON rides (status, updated_at)
+  WHERE status IN ('REQUESTED', 'MATCHING', 'OFFERED');
+
+-- +goose Down
+DROP INDEX IF EXISTS rides_stuck_idx;
+ALTER TABLE rides DROP COLUMN IF EXISTS redispatch_count;
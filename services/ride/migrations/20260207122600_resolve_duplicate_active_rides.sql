@@ -0,0 +1,89 @@
+-- +goose Up
+-- rides_rider_active_idx and rides_driver_active_idx cannot be built while a
+-- rider or driver holds more than one active ride. Each keeps the ride that
+-- is furthest along (the latest updated on a tie); the rest are cancelled as
+-- SYSTEM_TIMEOUT with their hold released and the cancellation on their
+-- timeline, and any offer they were waiting on is expired. Consumers get the
+-- same ride.offer.expired and ride.cancelled events a timed out ride emits,
+-- enqueued in that order. The status list matches domain.ActiveStatuses.
+CREATE TEMPORARY TABLE duplicate_active_rides ON COMMIT DROP AS
+WITH active AS (
+  SELECT id, rider_id, driver_id, status, fare_currency, updated_at,
+    CASE status
+      WHEN 'IN_PROGRESS' THEN 6
+      WHEN 'DRIVER_ARRIVED' THEN 5
+      WHEN 'DRIVER_ASSIGNED' THEN 4
+      WHEN 'OFFERED' THEN 3
+      WHEN 'MATCHING' THEN 2
+      ELSE 1
+    END AS progress
+  FROM rides
+  WHERE status IN ('REQUESTED', 'MATCHING', 'OFFERED', 'DRIVER_ASSIGNED', 'DRIVER_ARRIVED', 'IN_PROGRESS')
+), ranked AS (
+  SELECT id, rider_id, status, fare_currency, driver_id,
+    row_number() OVER (PARTITION BY rider_id ORDER BY progress DESC, updated_at DESC, id) AS rider_rank,
+    row_number() OVER (PARTITION BY driver_id ORDER BY progress DESC, updated_at DESC, id) AS driver_rank
+  FROM active
+)
+SELECT id, rider_id, status, fare_currency
+FROM ranked
+WHERE rider_rank > 1 OR (driver_id IS NOT NULL AND driver_rank > 1);
+
+INSERT INTO ride_events (id, ride_id, from_status, to_status, actor, reason, created_at)
+SELECT gen_random_uuid(), id, status, 'CANCELLED', 'system', 'duplicate_active_ride', now()
+FROM duplicate_active_rides;
+
+UPDATE payments
+SET status = 'VOID_PENDING', capture_amount = 0, attempts = 0, last_error = '', updated_at = now()
+WHERE status = 'AUTHORIZED'
+  AND ride_id IN (SELECT id FROM duplicate_active_rides);
+
+UPDATE rides
+SET status = 'CANCELLED', cancel_reason = 'SYSTEM_TIMEOUT', version = version + 1, updated_at = now()
+WHERE id IN (SELECT id FROM duplicate_active_rides);
+
+WITH expired AS (
+  UPDATE ride_offers
+  SET status = 'EXPIRED'
+  WHERE status = 'PENDING'
+    AND ride_id IN (SELECT id FROM duplicate_active_rides)
+  RETURNING id, ride_id, driver_id, status
+)
+INSERT INTO outbox (id, topic, payload, aggregate_id, created_at)
+SELECT gen_random_uuid(), 'ride.offer.expired', jsonb_build_object(
+    'id', gen_random_uuid(),
+    'type', 'ride.offer.expired',
+    'version', 'v1',
+    'occurred_at', to_char(now() AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+    'producer', 'ride-service',
+    'data', jsonb_build_object(
+      'offer_id', id,
+      'ride_id', ride_id,
+      'driver_id', driver_id,
+      'status', status
+    )
+  )::text, ride_id::text, now()
+FROM expired;
+
+INSERT INTO outbox (id, topic, payload, aggregate_id, created_at)
+SELECT gen_random_uuid(), 'ride.cancelled', jsonb_build_object(
+    'id', gen_random_uuid(),
+    'type', 'ride.cancelled',
+    'version', 'v1',
+    'occurred_at', to_char(now() AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+    'producer', 'ride-service',
+    'data', jsonb_build_object(
+      'ride_id', id,
+      'reason', 'duplicate_active_ride',
+      'reason_code', 'SYSTEM_TIMEOUT',
+      'actor', 'system',
+      'status', 'CANCELLED',
+      'rider_id', rider_id,
+      'fee_amount', 0,
+      'currency', fare_currency
+    )
+  )::text, id::text, now()
+FROM duplicate_active_rides;
+
+-- +goose Down
+-- Cancelled duplicates are not restored.
@@ -0,0 +1,18 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- A rider and a driver each hold at most one active ride. The status list
+-- matches domain.ActiveStatuses; scheduled rides only count once dispatched.
+-- Built concurrently so rides stay writable while the indexes build; the
+-- previous migration has already resolved duplicates. A failed concurrent
+-- build leaves an invalid index behind, so a retry drops it first.
+DROP INDEX CONCURRENTLY IF EXISTS rides_rider_active_idx;
+CREATE UNIQUE INDEX CONCURRENTLY rides_rider_active_idx ON rides (rider_id)
+  WHERE status IN ('REQUESTED', 'MATCHING', 'OFFERED', 'DRIVER_ASSIGNED', 'DRIVER_ARRIVED', 'IN_PROGRESS');
+DROP INDEX CONCURRENTLY IF EXISTS rides_driver_active_idx;
+CREATE UNIQUE INDEX CONCURRENTLY rides_driver_active_idx ON rides (driver_id)
+  WHERE driver_id IS NOT NULL
+    AND status IN ('REQUESTED', 'MATCHING', 'OFFERED', 'DRIVER_ASSIGNED', 'DRIVER_ARRIVED', 'IN_PROGRESS');
+
+-- +goose Down
+DROP INDEX CONCURRENTLY IF EXISTS rides_driver_active_idx;
+DROP INDEX CONCURRENTLY IF EXISTS rides_rider_active_idx;
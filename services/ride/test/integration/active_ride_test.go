@@ -0,0 +1,68 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/adapters/db"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+func TestOneActiveRidePerUser(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN not set")
+	}
+
+	conn, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	rides := db.NewRideRepo(conn)
+	now := time.Now().UTC()
+	riderID := uuid.NewString()
+	newRide := func(riderID string, status domain.RideStatus) outbound.Ride {
+		return outbound.Ride{ID: uuid.NewString(), RiderID: riderID, Status: string(status), Version: 1, CreatedAt: now, UpdatedAt: now}
+	}
+
+	first := newRide(riderID, domain.StatusRequested)
+	require.NoError(t, rides.Create(ctx, first))
+	require.NoError(t, rides.Create(ctx, newRide(riderID, domain.StatusScheduled)))
+
+	err = rides.Create(ctx, newRide(riderID, domain.StatusRequested))
+	var active *domain.ActiveRideError
+	require.True(t, errors.As(err, &active), "unexpected error: %v", err)
+	require.ErrorIs(t, err, domain.ErrRiderHasActiveRide)
+
+	held, err := rides.GetActiveByRider(ctx, riderID)
+	require.NoError(t, err)
+	require.Equal(t, first.ID, held.ID)
+
+	// A driver already on a ride cannot be assigned a second one.
+	driverID := uuid.NewString()
+	require.NoError(t, rides.AssignDriverIfCurrent(ctx, first.ID, 1, driverID, string(domain.StatusRequested), string(domain.StatusDriverAssigned), now))
+	other := newRide(uuid.NewString(), domain.StatusOffered)
+	require.NoError(t, rides.Create(ctx, other))
+	err = rides.AssignDriverIfCurrent(ctx, other.ID, 1, driverID, string(domain.StatusOffered), string(domain.StatusDriverAssigned), now)
+	require.ErrorIs(t, err, domain.ErrDriverHasActiveRide)
+
+	held, err = rides.GetActiveByDriver(ctx, driverID)
+	require.NoError(t, err)
+	require.Equal(t, first.ID, held.ID)
+
+	require.NoError(t, rides.CancelIfCurrent(ctx, first.ID, 2, string(domain.StatusDriverAssigned), outbound.RideCancellation{Reason: "OTHER"}, now))
+	_, err = rides.GetActiveByRider(ctx, riderID)
+	require.ErrorIs(t, err, outbound.ErrNotFound)
+	require.NoError(t, rides.AssignDriverIfCurrent(ctx, other.ID, 1, driverID, string(domain.StatusOffered), string(domain.StatusDriverAssigned), now))
+}
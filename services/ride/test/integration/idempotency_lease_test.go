@@ -0,0 +1,62 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/adapters/db"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+func TestIdempotencyLapsedHolderCannotSettleTakenOverKey(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN not set")
+	}
+	conn, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	ctx := context.Background()
+	repo := db.NewIdempotencyRepo(conn)
+
+	now := time.Now().UTC()
+	record := outbound.IdempotencyRecord{
+		Scope:       "ride.test:" + uuid.NewString(),
+		Key:         "k1",
+		RequestHash: "hash",
+		Status:      outbound.IdempotencyInProgress,
+		LockedUntil: now.Add(time.Second),
+		CreatedAt:   now,
+	}
+	stale, reserved, err := repo.Reserve(ctx, record, now)
+	require.NoError(t, err)
+	require.True(t, reserved)
+
+	// The first holder stalls past its lease and a retry takes the key over.
+	later := now.Add(2 * time.Second)
+	record.LockedUntil = later.Add(time.Second)
+	current, reserved, err := repo.Reserve(ctx, record, later)
+	require.NoError(t, err)
+	require.True(t, reserved)
+
+	require.NoError(t, repo.Release(ctx, stale))
+	held, ok, err := repo.Get(ctx, record.Scope, record.Key)
+	require.NoError(t, err)
+	require.True(t, ok, "a stale release must not drop the retry's reservation")
+	require.Equal(t, outbound.IdempotencyInProgress, held.Status)
+	require.ErrorIs(t, repo.Complete(ctx, stale, `"stale"`), outbound.ErrConflict)
+
+	require.NoError(t, repo.Complete(ctx, current, `"current"`))
+	held, _, err = repo.Get(ctx, record.Scope, record.Key)
+	require.NoError(t, err)
+	require.Equal(t, outbound.IdempotencyCompleted, held.Status)
+	require.Equal(t, `"current"`, held.Response)
+}
@@ -8,11 +8,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
 	"github.com/daffahilmyf/ride-hailing/services/ride/internal/adapters/db"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/metrics"
 	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/usecase"
 	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/workers"
 	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
@@ -29,14 +32,24 @@ func TestOfferExpiryWorker(t *testing.T) {
 	conn, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
 	require.NoError(t, err)
 
+	rides := db.NewRideRepo(conn)
+	now := time.Now().UTC()
+	rideID := uuid.NewString()
+	require.NoError(t, rides.Create(context.Background(), outbound.Ride{
+		ID:        rideID,
+		RiderID:   uuid.NewString(),
+		Status:    string(domain.StatusOffered),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}))
+
 	repo := db.NewRideOfferRepo(conn)
-	offer := domain.NewRideOffer("ride-1", "driver-1", -1*time.Second)
+	offer := domain.NewRideOffer(rideID, uuid.NewString(), -1*time.Second)
 	require.NoError(t, repo.Create(context.Background(), toOutboundOffer(offer)))
 
 	logger, _ := zap.NewDevelopment()
-	uc := &usecase.RideService{Offers: repo, OfferMetrics: &usecase.OfferMetrics{}}
+	uc := &usecase.RideService{Repo: rides, Offers: repo, TxManager: db.NewTxManager(conn), OfferMetrics: &usecase.OfferMetrics{}}
 	worker := &workers.OfferExpiryWorker{
-		Repo:     repo,
 		Usecase:  uc,
 		Logger:   logger,
 		Interval: 50 * time.Millisecond,
@@ -51,6 +64,103 @@ func TestOfferExpiryWorker(t *testing.T) {
 	updated, err := repo.Get(context.Background(), offer.ID)
 	require.NoError(t, err)
 	require.Equal(t, string(domain.OfferExpired), updated.Status)
+
+	ride, err := rides.Get(context.Background(), rideID)
+	require.NoError(t, err)
+	require.Equal(t, string(domain.StatusMatching), ride.Status)
+
+	events, err := rides.ListEvents(context.Background(), rideID)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, string(domain.ActorSystem), events[0].Actor)
+	require.Equal(t, "offer_expired", events[0].Reason)
+}
+
+// TestOfferExpiryConcurrentWorkers runs two workers, as two replicas would,
+// over one backlog: every offer is expired, and handed back to matching, by
+// exactly one of them.
+func TestOfferExpiryConcurrentWorkers(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN not set")
+	}
+
+	conn, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	rides := db.NewRideRepo(conn)
+	offers := db.NewRideOfferRepo(conn)
+	now := time.Now().UTC()
+	const n = 40
+	rideIDs := make([]string, 0, n)
+	offerIDs := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		rideID := uuid.NewString()
+		require.NoError(t, rides.Create(ctx, outbound.Ride{
+			ID:        rideID,
+			RiderID:   uuid.NewString(),
+			Status:    string(domain.StatusOffered),
+			Version:   1,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}))
+		offer := domain.NewRideOffer(rideID, uuid.NewString(), -time.Duration(n-i)*time.Second)
+		require.NoError(t, offers.Create(ctx, toOutboundOffer(offer)))
+		rideIDs = append(rideIDs, rideID)
+		offerIDs = append(offerIDs, offer.ID)
+	}
+
+	// Offers other tests left pending would otherwise be swept, and counted,
+	// alongside this backlog.
+	require.NoError(t, conn.Table("ride_offers").
+		Where("status = ? AND id NOT IN ?", string(domain.OfferPending), offerIDs).
+		Update("status", string(domain.OfferExpired)).Error)
+
+	logger, _ := zap.NewDevelopment()
+	workerCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	expiryMetrics := []*metrics.OfferExpiryMetrics{{}, {}}
+	for _, m := range expiryMetrics {
+		uc := &usecase.RideService{Repo: rides, Offers: offers, TxManager: db.NewTxManager(conn), OfferMetrics: &usecase.OfferMetrics{}}
+		worker := &workers.OfferExpiryWorker{
+			Usecase:  uc,
+			Metrics:  m,
+			Logger:   logger,
+			Interval: 20 * time.Millisecond,
+			Batch:    3,
+		}
+		go worker.Run(workerCtx)
+	}
+
+	expired := func() int64 { return expiryMetrics[0].Expired() + expiryMetrics[1].Expired() }
+	require.Eventually(t, func() bool {
+		var matching int64
+		err := conn.Table("rides").Where("id IN ? AND status = ?", rideIDs, string(domain.StatusMatching)).Count(&matching).Error
+		return err == nil && matching == n && expired() >= n
+	}, 5*time.Second, 20*time.Millisecond)
+	cancel()
+	require.Equal(t, int64(n), expired())
+
+	for i, rideID := range rideIDs {
+		offer, err := offers.Get(ctx, offerIDs[i])
+		require.NoError(t, err)
+		require.Equal(t, string(domain.OfferExpired), offer.Status)
+
+		ride, err := rides.Get(ctx, rideID)
+		require.NoError(t, err)
+		require.Equal(t, string(domain.StatusMatching), ride.Status)
+		require.Equal(t, int64(2), ride.Version)
+
+		events, err := rides.ListEvents(ctx, rideID)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, "offer_expired", events[0].Reason)
+
+		var enqueued int64
+		require.NoError(t, conn.Table("outbox").Where("aggregate_id = ? AND topic = ?", rideID, eventsv1.TopicRideOfferExpired).Count(&enqueued).Error)
+		require.Equal(t, int64(1), enqueued)
+	}
 }
 
 func toOutboundOffer(offer domain.RideOffer) outbound.RideOffer {
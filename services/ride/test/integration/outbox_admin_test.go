@@ -0,0 +1,101 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/adapters/db"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/usecase"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+func TestOutboxAdminSkipReplayPurge(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN not set")
+	}
+
+	conn, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	outbox := db.NewOutboxRepo(conn)
+	admin := &usecase.OutboxAdmin{Repo: outbox, Clock: usecase.SystemClock{}}
+	ctx := context.Background()
+
+	rideID := uuid.NewString()
+	topic := "ride.test.admin." + rideID
+	ids := make([]string, 0, 2)
+	for i := 0; i < 2; i++ {
+		id := uuid.NewString()
+		ids = append(ids, id)
+		require.NoError(t, outbox.Enqueue(ctx, outbound.OutboxMessage{
+			ID:          id,
+			Topic:       topic,
+			Payload:     fmt.Sprintf("{\"data\":{\"ride_id\":%q}}", rideID),
+			AggregateID: rideID,
+		}))
+	}
+	poison, next := ids[0], ids[1]
+	require.NoError(t, conn.Table("outbox").Where("id = ?", poison).
+		Updates(map[string]any{"status": "FAILED", "last_error": "bad payload", "attempt_count": 10}).Error)
+
+	// A FAILED row holds back the rest of its ride until it is skipped.
+	claimed := func() map[string]bool {
+		msgs, err := outbox.Claim(ctx, 100, 10, time.Minute)
+		require.NoError(t, err)
+		got := map[string]bool{}
+		for _, msg := range msgs {
+			got[msg.ID] = true
+			require.NoError(t, outbox.MarkSent(ctx, msg))
+		}
+		return got
+	}
+	require.False(t, claimed()[next])
+
+	page, err := admin.List(ctx, usecase.ListOutboxQuery{Topic: topic, Status: "FAILED"})
+	require.NoError(t, err)
+	require.Len(t, page.Messages, 1)
+	require.Equal(t, "bad payload", page.Messages[0].LastError)
+
+	skipped, err := admin.Skip(ctx, usecase.OutboxActionCmd{IDs: []string{poison}, Actor: "ops", Note: "poison message"})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), skipped)
+	require.True(t, claimed()[next])
+
+	replayed, err := admin.Replay(ctx, usecase.OutboxActionCmd{Topic: topic, Status: "SKIPPED", Actor: "ops"})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), replayed)
+	record, err := admin.Get(ctx, poison)
+	require.NoError(t, err)
+	require.Equal(t, "PENDING", record.Status)
+	require.Zero(t, record.AttemptCount)
+
+	purged, err := admin.Purge(ctx, usecase.OutboxActionCmd{IDs: []string{poison}, Actor: "ops", Note: "not needed"})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), purged)
+	_, err = admin.Get(ctx, poison)
+	require.ErrorIs(t, err, outbound.ErrNotFound)
+
+	var audits []struct {
+		Action     string
+		FromStatus string
+		Payload    *string
+	}
+	require.NoError(t, conn.Table("outbox_audit").Select("action, from_status, payload").
+		Where("outbox_id = ?", poison).Order("id").Find(&audits).Error)
+	require.Len(t, audits, 3)
+	require.Equal(t, "SKIP", audits[0].Action)
+	require.Equal(t, "FAILED", audits[0].FromStatus)
+	require.Equal(t, "REPLAY", audits[1].Action)
+	require.Equal(t, "PURGE", audits[2].Action)
+	require.NotNil(t, audits[2].Payload)
+}
@@ -0,0 +1,204 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/adapters/broker"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/adapters/db"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/metrics"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/workers"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+	"go.uber.org/zap"
+)
+
+// crashingPublisher publishes the first message of a batch and then kills
+// its worker, so the rest of the batch is left claimed but never marked.
+type crashingPublisher struct {
+	next    outbound.OutboxPublisher
+	kill    context.CancelFunc
+	once    sync.Once
+	crashed chan struct{}
+}
+
+func (p *crashingPublisher) PublishMsg(ctx context.Context, subject string, msgID string, payload []byte) error {
+	published := false
+	p.once.Do(func() {
+		_ = p.next.PublishMsg(context.Background(), subject, msgID, payload)
+		published = true
+		p.kill()
+		close(p.crashed)
+	})
+	if published {
+		return nil
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestOutboxReaperRecoversCrashedBatch(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN not set")
+	}
+	natsURL := os.Getenv("TEST_NATS_URL")
+	if natsURL == "" {
+		t.Skip("TEST_NATS_URL not set")
+	}
+
+	conn, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	nc, err := nats.Connect(natsURL)
+	require.NoError(t, err)
+	defer nc.Close()
+	js, err := nc.JetStream()
+	require.NoError(t, err)
+
+	outbox := db.NewOutboxRepo(conn)
+	const total = 5
+	ids := make([]string, 0, total)
+	for i := 0; i < total; i++ {
+		id := uuid.NewString()
+		ids = append(ids, id)
+		require.NoError(t, outbox.Enqueue(context.Background(), outbound.OutboxMessage{
+			ID:      id,
+			Topic:   "ride.test.lease",
+			Payload: fmt.Sprintf("{\"id\":%q}", id),
+		}))
+	}
+
+	logger, _ := zap.NewDevelopment()
+	lease := 200 * time.Millisecond
+
+	crashCtx, kill := context.WithCancel(context.Background())
+	crashing := &crashingPublisher{next: broker.NewPublisher(js), kill: kill, crashed: make(chan struct{})}
+	doomed := &workers.OutboxWorker{
+		Repo:        outbox,
+		Publisher:   crashing,
+		Logger:      logger,
+		BatchSize:   100,
+		MaxAttempts: 3,
+		Interval:    20 * time.Millisecond,
+		Lease:       lease,
+	}
+	go doomed.Run(crashCtx)
+
+	select {
+	case <-crashing.crashed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("worker never claimed the batch")
+	}
+
+	var stuck int64
+	require.NoError(t, conn.Table("outbox").Where("id IN ? AND status = ?", ids, "PROCESSING").Count(&stuck).Error)
+	require.Equal(t, int64(total), stuck, "crashed batch should be left in PROCESSING")
+
+	sub, err := js.SubscribeSync("ride.test.lease")
+	require.NoError(t, err)
+	defer func() { _ = sub.Unsubscribe() }()
+
+	time.Sleep(lease + 100*time.Millisecond)
+	outboxMetrics := &metrics.OutboxMetrics{}
+	reaper := &workers.OutboxReaper{Repo: outbox, Logger: logger, Metrics: outboxMetrics, MaxAttempts: 3}
+	reaper.Reap(context.Background())
+	require.GreaterOrEqual(t, outboxMetrics.Reclaimed(), int64(total))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	survivor := &workers.OutboxWorker{
+		Repo:        outbox,
+		Publisher:   broker.NewPublisher(js),
+		Logger:      logger,
+		Metrics:     outboxMetrics,
+		BatchSize:   100,
+		MaxAttempts: 3,
+		Interval:    20 * time.Millisecond,
+		Lease:       time.Minute,
+	}
+	go survivor.Run(ctx)
+
+	want := make(map[string]bool, total)
+	for _, id := range ids {
+		want[fmt.Sprintf("{\"id\":%q}", id)] = true
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for len(want) > 0 && time.Now().Before(deadline) {
+		msg, err := sub.NextMsg(time.Until(deadline))
+		if err != nil {
+			break
+		}
+		delete(want, string(msg.Data))
+	}
+	require.Empty(t, want, "every message of the crashed batch should be published")
+
+	require.Eventually(t, func() bool {
+		var sent int64
+		if err := conn.Table("outbox").Where("id IN ? AND status = ?", ids, "SENT").Count(&sent).Error; err != nil {
+			return false
+		}
+		return sent == total
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestOutboxStaleClaimCannotSettleReclaimedRow(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN not set")
+	}
+	conn, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	outbox := db.NewOutboxRepo(conn)
+	id := uuid.NewString()
+	require.NoError(t, outbox.Enqueue(ctx, outbound.OutboxMessage{
+		ID:          id,
+		Topic:       "ride.test.stale_lease",
+		Payload:     fmt.Sprintf("{\"id\":%q}", id),
+		AggregateID: uuid.NewString(),
+	}))
+
+	claimOwn := func() outbound.OutboxMessage {
+		msgs, err := outbox.Claim(ctx, 100, 5, time.Minute)
+		require.NoError(t, err)
+		for _, msg := range msgs {
+			if msg.ID == id {
+				return msg
+			}
+			require.NoError(t, outbox.Unclaim(ctx, msg))
+		}
+		t.Fatalf("row %s was not claimed", id)
+		return outbound.OutboxMessage{}
+	}
+
+	stale := claimOwn()
+	// The slow worker's lease runs out and the reaper hands the row on.
+	require.NoError(t, conn.Table("outbox").Where("id = ?", id).Update("lease_until", time.Now().UTC().Add(-time.Second)).Error)
+	_, err = outbox.ReclaimExpired(ctx, 100, 5)
+	require.NoError(t, err)
+	current := claimOwn()
+
+	require.ErrorIs(t, outbox.MarkFailed(ctx, stale, "slow worker", time.Now().UTC().Add(time.Minute)), outbound.ErrLeaseLost)
+	require.ErrorIs(t, outbox.Unclaim(ctx, stale), outbound.ErrLeaseLost)
+	require.ErrorIs(t, outbox.MarkSent(ctx, stale), outbound.ErrLeaseLost)
+
+	var status string
+	require.NoError(t, conn.Table("outbox").Select("status").Where("id = ?", id).Scan(&status).Error)
+	require.Equal(t, "PROCESSING", status, "a stale claim must not touch the new holder's row")
+
+	require.NoError(t, outbox.MarkSent(ctx, current))
+	require.NoError(t, conn.Table("outbox").Select("status").Where("id = ?", id).Scan(&status).Error)
+	require.Equal(t, "SENT", status)
+}
@@ -0,0 +1,73 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/adapters/db"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/metrics"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/workers"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+	"go.uber.org/zap"
+)
+
+func TestOutboxWorkerWakesOnNotify(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN not set")
+	}
+
+	conn, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	outbox := db.NewOutboxRepo(conn)
+
+	logger, _ := zap.NewDevelopment()
+	publisher := &flakyPublisher{}
+	outboxMetrics := &metrics.OutboxMetrics{}
+	wake := make(chan struct{}, 1)
+	worker := &workers.OutboxWorker{
+		Repo:        outbox,
+		Publisher:   publisher,
+		Logger:      logger,
+		Metrics:     outboxMetrics,
+		BatchSize:   100,
+		MaxAttempts: 3,
+		// Far longer than the test, so only a notification can trigger it.
+		Interval: time.Hour,
+		Lease:    time.Minute,
+		Wake:     wake,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go db.NewOutboxListener(dsn, logger).Run(ctx, wake)
+	go worker.Run(ctx)
+	// Let the connect wake-up drain whatever is already pending.
+	time.Sleep(500 * time.Millisecond)
+
+	id := uuid.NewString()
+	require.NoError(t, outbox.Enqueue(context.Background(), outbound.OutboxMessage{
+		ID:      id,
+		Topic:   "ride.test.notify",
+		Payload: "{\"ok\":true}",
+	}))
+
+	require.Eventually(t, func() bool {
+		for _, published := range publisher.snapshot() {
+			if published == id {
+				return true
+			}
+		}
+		return false
+	}, time.Second, 10*time.Millisecond)
+	require.Positive(t, outboxMetrics.LatencyCount())
+}
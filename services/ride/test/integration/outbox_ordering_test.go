@@ -0,0 +1,115 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/adapters/db"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/app/workers"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+	"go.uber.org/zap"
+)
+
+// flakyPublisher records what it publishes and fails the first attempt of
+// one message.
+type flakyPublisher struct {
+	mu        sync.Mutex
+	failID    string
+	failed    bool
+	published []string
+}
+
+func (p *flakyPublisher) PublishMsg(ctx context.Context, subject string, msgID string, payload []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if msgID == p.failID && !p.failed {
+		p.failed = true
+		return errors.New("broker unavailable")
+	}
+	p.published = append(p.published, msgID)
+	return nil
+}
+
+func (p *flakyPublisher) snapshot() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.published...)
+}
+
+func TestOutboxPublishesEachRideInOrder(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN not set")
+	}
+
+	conn, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	outbox := db.NewOutboxRepo(conn)
+
+	enqueue := func(rideID string, n int) []string {
+		ids := make([]string, 0, n)
+		for i := 0; i < n; i++ {
+			id := uuid.NewString()
+			ids = append(ids, id)
+			require.NoError(t, outbox.Enqueue(context.Background(), outbound.OutboxMessage{
+				ID:          id,
+				Topic:       "ride.test.order",
+				Payload:     fmt.Sprintf("{\"data\":{\"ride_id\":%q}}", rideID),
+				AggregateID: rideID,
+			}))
+		}
+		return ids
+	}
+	blocked := enqueue(uuid.NewString(), 3)
+	other := enqueue(uuid.NewString(), 3)
+
+	publisher := &flakyPublisher{failID: blocked[0]}
+	logger, _ := zap.NewDevelopment()
+	worker := &workers.OutboxWorker{
+		Repo:        outbox,
+		Publisher:   publisher,
+		Logger:      logger,
+		BatchSize:   100,
+		MaxAttempts: 5,
+		Interval:    20 * time.Millisecond,
+		Lease:       time.Minute,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go worker.Run(ctx)
+
+	// The other ride goes out while the first waits out its backoff.
+	require.Eventually(t, func() bool {
+		return containsInOrder(publisher.snapshot(), other)
+	}, 2*time.Second, 20*time.Millisecond)
+	for _, id := range blocked {
+		require.NotContains(t, publisher.snapshot(), id, "rows behind a failed row must wait")
+	}
+
+	// Once the retry succeeds the held rows follow it in order.
+	require.Eventually(t, func() bool {
+		return containsInOrder(publisher.snapshot(), blocked)
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func containsInOrder(published []string, want []string) bool {
+	next := 0
+	for _, id := range published {
+		if next < len(want) && id == want[next] {
+			next++
+		}
+	}
+	return next == len(want)
+}
@@ -0,0 +1,75 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/adapters/db"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/domain"
+	"github.com/daffahilmyf/ride-hailing/services/ride/internal/ports/outbound"
+)
+
+func TestRideWritesCompareAndSwapVersion(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN not set")
+	}
+
+	conn, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+
+	rides := db.NewRideRepo(conn)
+	now := time.Now().UTC()
+	rideID := uuid.NewString()
+	require.NoError(t, rides.Create(context.Background(), outbound.Ride{
+		ID:        rideID,
+		RiderID:   uuid.NewString(),
+		Status:    string(domain.StatusRequested),
+		Version:   1,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}))
+
+	// Both writers read version 1; only one of them may win.
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	next := []domain.RideStatus{domain.StatusMatching, domain.StatusCancelled}
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = rides.UpdateStatusIfCurrent(context.Background(), rideID, 1, string(domain.StatusRequested), string(next[i]), now)
+		}(i)
+	}
+	wg.Wait()
+
+	var conflicts int
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		var conflict *outbound.VersionConflictError
+		require.True(t, errors.As(err, &conflict), "unexpected error: %v", err)
+		require.Equal(t, int64(2), conflict.Current)
+		conflicts++
+	}
+	require.Equal(t, 1, conflicts)
+
+	ride, err := rides.Get(context.Background(), rideID)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), ride.Version)
+
+	err = rides.CancelIfCurrent(context.Background(), uuid.NewString(), 1, string(domain.StatusRequested), outbound.RideCancellation{Reason: "OTHER"}, now)
+	require.ErrorIs(t, err, outbound.ErrNotFound)
+}
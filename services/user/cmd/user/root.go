@@ -50,6 +50,9 @@ func init() {
 	rootCmd.PersistentFlags().Int("session_limits.driver", 1, "max driver device sessions")
 	rootCmd.PersistentFlags().Bool("observability.metrics_enabled", true, "enable metrics")
 	rootCmd.PersistentFlags().String("observability.metrics_addr", ":9096", "metrics listen addr")
+	rootCmd.PersistentFlags().String("nats.url", "", "NATS URL")
+	rootCmd.PersistentFlags().Bool("events.enabled", true, "enable event consumption")
+	rootCmd.PersistentFlags().Int("events.rating_window", 100, "number of recent ratings averaged into a profile rating")
 
 	_ = viper.BindPFlag("config", rootCmd.PersistentFlags().Lookup("config"))
 	_ = viper.BindPFlag("http.addr", rootCmd.PersistentFlags().Lookup("http.addr"))
@@ -75,6 +78,9 @@ func init() {
 	_ = viper.BindPFlag("session_limits.driver", rootCmd.PersistentFlags().Lookup("session_limits.driver"))
 	_ = viper.BindPFlag("observability.metrics_enabled", rootCmd.PersistentFlags().Lookup("observability.metrics_enabled"))
 	_ = viper.BindPFlag("observability.metrics_addr", rootCmd.PersistentFlags().Lookup("observability.metrics_addr"))
+	_ = viper.BindPFlag("nats.url", rootCmd.PersistentFlags().Lookup("nats.url"))
+	_ = viper.BindPFlag("events.enabled", rootCmd.PersistentFlags().Lookup("events.enabled"))
+	_ = viper.BindPFlag("events.rating_window", rootCmd.PersistentFlags().Lookup("events.rating_window"))
 }
 
 func initConfig() {
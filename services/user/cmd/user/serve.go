@@ -8,14 +8,17 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/daffahilmyf/ride-hailing/services/user/internal/adapters/broker"
 	dbadapter "github.com/daffahilmyf/ride-hailing/services/user/internal/adapters/db"
 	grpcadapter "github.com/daffahilmyf/ride-hailing/services/user/internal/adapters/grpc"
 	redisadapter "github.com/daffahilmyf/ride-hailing/services/user/internal/adapters/redis"
 	"github.com/daffahilmyf/ride-hailing/services/user/internal/app/handlers"
 	"github.com/daffahilmyf/ride-hailing/services/user/internal/app/metrics"
 	"github.com/daffahilmyf/ride-hailing/services/user/internal/app/usecase"
+	"github.com/daffahilmyf/ride-hailing/services/user/internal/app/workers"
 	"github.com/daffahilmyf/ride-hailing/services/user/internal/infra"
 	"github.com/gin-gonic/gin"
+	"github.com/nats-io/nats.go"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/redis/go-redis/v9"
@@ -46,6 +49,7 @@ var serveCmd = &cobra.Command{
 				Rider:  cfg.SessionLimits.Rider,
 				Driver: cfg.SessionLimits.Driver,
 			},
+			RatingWindow: cfg.Events.RatingWindow,
 		}
 
 		redisClient := redis.NewClient(&redis.Options{
@@ -95,6 +99,34 @@ var serveCmd = &cobra.Command{
 			}
 		}()
 
+		eventsCtx, cancelEvents := context.WithCancel(ctx)
+		defer cancelEvents()
+		if cfg.Events.Enabled {
+			nc, err := nats.Connect(cfg.Events.NATSURL)
+			if err != nil {
+				logger.Fatal("nats.connect_failed", zap.Error(err))
+			}
+			logger.Info("nats.connected", zap.String("url", cfg.Events.NATSURL))
+			defer nc.Close()
+			js, err := nc.JetStream()
+			if err != nil {
+				logger.Fatal("nats.jetstream_failed", zap.Error(err))
+			}
+			ratedConsumer := &workers.EventConsumer{
+				Consumer: broker.NewConsumer(js),
+				Subject:  "ride.rated",
+				Durable:  "user-ride-rated",
+				Batch:    50,
+				Logger:   logger,
+				Handler:  uc.HandleRideRated,
+			}
+			go func() {
+				if err := ratedConsumer.Run(eventsCtx); err != nil && err != context.Canceled {
+					logger.Warn("event.consumer_stopped", zap.String("subject", "ride.rated"), zap.Error(err))
+				}
+			}()
+		}
+
 		router := gin.New()
 		router.Use(gin.Recovery())
 		handlers.RegisterRoutes(router, uc, logger, authMetrics, limiter, cfg.InternalAuth.Enabled, cfg.InternalAuth.Token)
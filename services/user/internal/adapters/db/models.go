@@ -22,6 +22,7 @@ func (User) TableName() string { return "users" }
 type RiderProfile struct {
 	UserID            string    `gorm:"column:user_id;type:uuid;primaryKey"`
 	Rating            float64   `gorm:"column:rating"`
+	RatingCount       int       `gorm:"column:rating_count"`
 	PreferredLanguage string    `gorm:"column:preferred_language"`
 	CreatedAt         time.Time `gorm:"column:created_at"`
 }
@@ -35,11 +36,22 @@ type DriverProfile struct {
 	LicenseNumber string    `gorm:"column:license_number"`
 	Verified      bool      `gorm:"column:verified"`
 	Rating        float64   `gorm:"column:rating"`
+	RatingCount   int       `gorm:"column:rating_count"`
 	CreatedAt     time.Time `gorm:"column:created_at"`
 }
 
 func (DriverProfile) TableName() string { return "driver_profiles" }
 
+type AppliedRating struct {
+	RideID    string    `gorm:"column:ride_id;type:uuid;primaryKey"`
+	RaterRole string    `gorm:"column:rater_role;primaryKey"`
+	RateeID   string    `gorm:"column:ratee_id;type:uuid"`
+	Stars     int       `gorm:"column:stars"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+func (AppliedRating) TableName() string { return "applied_ratings" }
+
 type RefreshToken struct {
 	ID        string     `gorm:"column:id;type:uuid;primaryKey"`
 	UserID    string     `gorm:"column:user_id;type:uuid"`
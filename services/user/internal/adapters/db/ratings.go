@@ -0,0 +1,52 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ApplyRating folds one rating into the ratee's profile as a rolling average
+// over at most window ratings, so recent rides outweigh old ones once a user
+// has that many. It reports false when the rating was already applied.
+func (r *Repo) ApplyRating(ctx context.Context, rating AppliedRating, rateeRole string, window int) (bool, error) {
+	var table string
+	switch rateeRole {
+	case "rider":
+		table = RiderProfile{}.TableName()
+	case "driver":
+		table = DriverProfile{}.TableName()
+	default:
+		return false, errors.New("invalid role")
+	}
+	if window <= 0 {
+		window = 1
+	}
+	applied := false
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&rating)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		update := tx.Table(table).
+			Where("user_id = ?", rating.RateeID).
+			Updates(map[string]interface{}{
+				"rating":       gorm.Expr("(rating * LEAST(rating_count, ?) + ?) / (LEAST(rating_count, ?) + 1)", window, rating.Stars, window),
+				"rating_count": gorm.Expr("rating_count + 1"),
+			})
+		if update.Error != nil {
+			return update.Error
+		}
+		if update.RowsAffected == 0 {
+			return ErrNotFound
+		}
+		applied = true
+		return nil
+	})
+	return applied, err
+}
@@ -386,6 +386,7 @@ func userResponse(user db.User, rider *db.RiderProfile, driver *db.DriverProfile
 	if rider != nil {
 		resp["rider_profile"] = gin.H{
 			"rating":             rider.Rating,
+			"rating_count":       rider.RatingCount,
 			"preferred_language": rider.PreferredLanguage,
 		}
 	}
@@ -396,6 +397,7 @@ func userResponse(user db.User, rider *db.RiderProfile, driver *db.DriverProfile
 			"license_number": driver.LicenseNumber,
 			"verified":       driver.Verified,
 			"rating":         driver.Rating,
+			"rating_count":   driver.RatingCount,
 		}
 	}
 	return resp
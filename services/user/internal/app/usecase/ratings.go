@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
+	"github.com/daffahilmyf/ride-hailing/services/user/internal/adapters/db"
+)
+
+// ErrInvalidEvent marks an event no redelivery can fix: it does not decode or
+// carries fields the handler cannot use.
+var ErrInvalidEvent = errors.New("invalid event")
+
+// HandleRideRated consumes ride.rated and updates the ratee's profile rating.
+// Redelivered events are ignored, so the consumer can ack them safely.
+func (s *Service) HandleRideRated(ctx context.Context, payload []byte) error {
+	var event eventsv1.RideRated
+	if _, err := eventsv1.Unmarshal(payload, &event); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidEvent, err)
+	}
+	stars := int(event.GetStars())
+	if event.GetRideId() == "" || event.GetRateeId() == "" || stars < 1 || stars > 5 {
+		return ErrInvalidEvent
+	}
+	if event.GetRateeRole() != "rider" && event.GetRateeRole() != "driver" {
+		return ErrInvalidEvent
+	}
+	createdAt := s.now()
+	if event.GetCreatedAt() > 0 {
+		createdAt = time.Unix(event.GetCreatedAt(), 0).UTC()
+	}
+	_, err := s.Repo.ApplyRating(ctx, db.AppliedRating{
+		RideID:    event.GetRideId(),
+		RaterRole: event.GetRaterRole(),
+		RateeID:   event.GetRateeId(),
+		Stars:     stars,
+		CreatedAt: createdAt,
+	}, event.GetRateeRole(), s.RatingWindow)
+	if errors.Is(err, db.ErrNotFound) {
+		// The ratee has no profile of that role; there is nothing to update and
+		// retrying will not change that.
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
+)
+
+func TestHandleRideRatedRejectsInvalidEvents(t *testing.T) {
+	rated := func(mutate func(*eventsv1.RideRated)) []byte {
+		event := &eventsv1.RideRated{
+			RideId:    "ride-1",
+			RaterRole: "rider",
+			RateeRole: "driver",
+			RateeId:   "driver-1",
+			Stars:     5,
+		}
+		mutate(event)
+		payload, err := eventsv1.Marshal(eventsv1.TopicRideRated, "ride-service", "", "", event, time.Now(), "evt-1")
+		if err != nil {
+			t.Fatalf("marshal: %v", err)
+		}
+		return payload
+	}
+	cases := []struct {
+		name    string
+		payload []byte
+	}{
+		{name: "not_json", payload: []byte("{")},
+		{name: "no_data", payload: []byte(`{"topic":"ride.rated"}`)},
+		{name: "missing_ride", payload: rated(func(e *eventsv1.RideRated) { e.RideId = "" })},
+		{name: "missing_ratee", payload: rated(func(e *eventsv1.RideRated) { e.RateeId = "" })},
+		{name: "zero_stars", payload: rated(func(e *eventsv1.RideRated) { e.Stars = 0 })},
+		{name: "six_stars", payload: rated(func(e *eventsv1.RideRated) { e.Stars = 6 })},
+		{name: "unknown_role", payload: rated(func(e *eventsv1.RideRated) { e.RateeRole = "admin" })},
+	}
+	// Validation runs before the repo is touched, so no database is needed.
+	svc := &Service{}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := svc.HandleRideRated(context.Background(), tc.payload)
+			if !errors.Is(err, ErrInvalidEvent) {
+				t.Fatalf("expected ErrInvalidEvent, got %v", err)
+			}
+		})
+	}
+}
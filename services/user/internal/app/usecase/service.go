@@ -32,6 +32,7 @@ type Service struct {
 	Repo          *db.Repo
 	AuthConfig    infra.AuthConfig
 	SessionLimits SessionLimitConfig
+	RatingWindow  int
 	Now           func() time.Time
 }
 
@@ -320,7 +321,7 @@ func (s *Service) signJWT(user db.User, exp time.Time) (string, error) {
 	if secret == "" {
 		return "", errors.New("jwt secret required")
 	}
-	scopes := []string{"notify:read", "users:read"}
+	scopes := []string{"notify:read", "users:read", "rides:read"}
 	if user.Role == "rider" {
 		scopes = append(scopes, "rides:write")
 	}
@@ -16,6 +16,15 @@ type Config struct {
 	RateLimit               RateLimitConfig
 	SessionLimits           SessionLimitConfig
 	Observability           ObservabilityConfig
+	Events                  EventsConfig
+}
+
+// EventsConfig controls consumption of ride events from NATS. RatingWindow is
+// how many recent ratings the profile average is taken over.
+type EventsConfig struct {
+	Enabled      bool
+	NATSURL      string
+	RatingWindow int
 }
 
 type AuthConfig struct {
@@ -83,5 +92,10 @@ func DefaultConfig() Config {
 			MetricsEnabled: true,
 			MetricsAddr:    ":9096",
 		},
+		Events: EventsConfig{
+			Enabled:      true,
+			NATSURL:      "nats://nats:4222",
+			RatingWindow: 100,
+		},
 	}
 }
@@ -28,5 +28,8 @@ func LoadConfig() Config {
 	cfg.SessionLimits.Driver = viper.GetInt("session_limits.driver")
 	cfg.Observability.MetricsEnabled = viper.GetBool("observability.metrics_enabled")
 	cfg.Observability.MetricsAddr = viper.GetString("observability.metrics_addr")
+	cfg.Events.Enabled = viper.GetBool("events.enabled")
+	cfg.Events.NATSURL = viper.GetString("nats.url")
+	cfg.Events.RatingWindow = viper.GetInt("events.rating_window")
 	return cfg
 }
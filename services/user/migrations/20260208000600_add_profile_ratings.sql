@@ -0,0 +1,26 @@
+-- +goose Up
+ALTER TABLE rider_profiles
+  ADD COLUMN IF NOT EXISTS rating_count INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE driver_profiles
+  ADD COLUMN IF NOT EXISTS rating_count INTEGER NOT NULL DEFAULT 0;
+
+-- applied_ratings remembers which ride.rated events were folded into a
+-- profile so redelivered events do not count twice.
+CREATE TABLE IF NOT EXISTS applied_ratings (
+  ride_id UUID NOT NULL,
+  rater_role TEXT NOT NULL,
+  ratee_id UUID NOT NULL,
+  stars SMALLINT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (ride_id, rater_role)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS applied_ratings;
+
+ALTER TABLE driver_profiles
+  DROP COLUMN IF EXISTS rating_count;
+
+ALTER TABLE rider_profiles
+  DROP COLUMN IF EXISTS rating_count;
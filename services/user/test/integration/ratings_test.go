@@ -0,0 +1,150 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	eventsv1 "github.com/daffahilmyf/ride-hailing/proto/events/v1"
+	"github.com/daffahilmyf/ride-hailing/services/user/internal/adapters/db"
+	"github.com/daffahilmyf/ride-hailing/services/user/internal/app/usecase"
+)
+
+func openRepo(t *testing.T) *db.Repo {
+	t.Helper()
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN not set")
+	}
+	conn, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	return db.NewRepo(conn)
+}
+
+func createUser(t *testing.T, repo *db.Repo, role string) string {
+	t.Helper()
+	now := time.Now().UTC()
+	user := db.User{ID: uuid.NewString(), Role: role, CreatedAt: now, UpdatedAt: now}
+	if err := repo.CreateUserWithProfile(context.Background(), user, role); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	return user.ID
+}
+
+func rating(rateeID string, stars int) db.AppliedRating {
+	return db.AppliedRating{
+		RideID:    uuid.NewString(),
+		RaterRole: "rider",
+		RateeID:   rateeID,
+		Stars:     stars,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+func TestApplyRatingRollingAverage(t *testing.T) {
+	repo := openRepo(t)
+	ctx := context.Background()
+	driverID := createUser(t, repo, "driver")
+
+	// With a window of 2 the first ratings average normally; once the
+	// driver has two, the stored rating counts as two and the new one as one.
+	steps := []struct {
+		stars  int
+		rating float64
+	}{
+		{stars: 5, rating: 5},
+		{stars: 3, rating: 4},
+		{stars: 1, rating: 3},
+		{stars: 5, rating: 11.0 / 3},
+	}
+	for i, step := range steps {
+		applied, err := repo.ApplyRating(ctx, rating(driverID, step.stars), "driver", 2)
+		if err != nil || !applied {
+			t.Fatalf("step %d: applied=%v err=%v", i, applied, err)
+		}
+		profile, err := repo.GetDriverProfile(ctx, driverID)
+		if err != nil {
+			t.Fatalf("step %d: profile: %v", i, err)
+		}
+		if math.Abs(profile.Rating-step.rating) > 1e-9 {
+			t.Fatalf("step %d: expected rating %v, got %v", i, step.rating, profile.Rating)
+		}
+		if profile.RatingCount != i+1 {
+			t.Fatalf("step %d: expected count %d, got %d", i, i+1, profile.RatingCount)
+		}
+	}
+}
+
+func TestApplyRatingIgnoresRedelivery(t *testing.T) {
+	repo := openRepo(t)
+	ctx := context.Background()
+	riderID := createUser(t, repo, "rider")
+	first := rating(riderID, 2)
+
+	applied, err := repo.ApplyRating(ctx, first, "rider", 10)
+	if err != nil || !applied {
+		t.Fatalf("first apply: applied=%v err=%v", applied, err)
+	}
+	again := first
+	again.Stars = 5
+	applied, err = repo.ApplyRating(ctx, again, "rider", 10)
+	if err != nil {
+		t.Fatalf("redelivery: %v", err)
+	}
+	if applied {
+		t.Fatalf("expected redelivered rating to be skipped")
+	}
+	profile, err := repo.GetRiderProfile(ctx, riderID)
+	if err != nil {
+		t.Fatalf("profile: %v", err)
+	}
+	if profile.RatingCount != 1 || profile.Rating != 2 {
+		t.Fatalf("expected one rating of 2, got count=%d rating=%v", profile.RatingCount, profile.Rating)
+	}
+}
+
+func TestApplyRatingWithoutProfile(t *testing.T) {
+	repo := openRepo(t)
+	ctx := context.Background()
+	// A rider has no driver profile.
+	riderID := createUser(t, repo, "rider")
+	missing := rating(riderID, 4)
+
+	if _, err := repo.ApplyRating(ctx, missing, "driver", 10); !errors.Is(err, db.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	var rows int64
+	if err := repo.DB.WithContext(ctx).Model(&db.AppliedRating{}).Where("ride_id = ?", missing.RideID).Count(&rows).Error; err != nil {
+		t.Fatalf("count: %v", err)
+	}
+	if rows != 0 {
+		t.Fatalf("expected the applied rating to roll back, found %d rows", rows)
+	}
+
+	// The handler acks the event: retrying cannot create the profile.
+	svc := &usecase.Service{Repo: repo, RatingWindow: 10}
+	payload, err := eventsv1.Marshal(eventsv1.TopicRideRated, "ride-service", "", "", &eventsv1.RideRated{
+		RideId:    missing.RideID,
+		RaterRole: "rider",
+		RateeRole: "driver",
+		RateeId:   riderID,
+		Stars:     4,
+	}, time.Now(), uuid.NewString())
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if err := svc.HandleRideRated(ctx, payload); err != nil {
+		t.Fatalf("expected missing profile to be ignored, got %v", err)
+	}
+}